@@ -0,0 +1,3 @@
+data "genesyscloud_outbound_digitalruleset" "example_outbound_digitalruleset" {
+  name = "Outbound Digital Ruleset"
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+data "genesyscloud_routing_skill_group_members_preview" "preview" {
+  skill_conditions = jsonencode(
+    [
+      {
+        "routingSkillConditions" : [
+          {
+            "routingSkill" : "Series 6",
+            "comparator" : "GreaterThan",
+            "proficiency" : 2,
+            "childConditions" : []
+          }
+        ],
+        "languageSkillConditions" : [],
+        "operation" : "And"
+    }]
+  )
+  member_division_ids = ["*"]
+}
@@ -0,0 +1,5 @@
+resource "genesyscloud_architect_datatable_rows" "customers" {
+  datatable_id      = genesyscloud_architect_datatable.customer-table.id
+  rows_csv_filepath = "${path.module}/customers.csv"
+  file_content_hash = filesha256("${path.module}/customers.csv")
+}
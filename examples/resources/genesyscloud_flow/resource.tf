@@ -28,4 +28,29 @@ resource "genesyscloud_flow" "flow" {
     greeting             = "Hello World"
     menu_disconnect_name = "Disconnect"
   }
-}
\ No newline at end of file
+}
+resource "genesyscloud_flow" "regional_flow" {
+  filepath          = "the flow template file path"
+  file_content_hash = filesha256("the flow template file path")
+  // Example flow template rendered before upload:
+  /*
+  inboundCall:
+    name: "{{ .flow_name }}"
+    defaultLanguage: "{{ index .languages 0 }}"
+    supportedLanguages:
+  {{- range .languages }}
+      {{ . }}:
+        none: true
+  {{- end }}
+    initialGreeting:
+  {{ include "greeting" . | indent 4 }}
+  */
+  template {
+    variables_json = jsonencode({
+      flow_name = "EMEA Inbound"
+      languages = ["en-us", "fr-fr"]
+    })
+    includes = ["the shared greeting.yaml fragment file path"]
+    strict   = true
+  }
+}
@@ -10,4 +10,8 @@ resource "genesyscloud_outbound_contact_list" "contact-list" {
     column_name = "Home"
     type        = "home"
   }
+  contacts_filepath          = "contacts.csv"
+  contacts_file_content_hash = filesha256("contacts.csv")
+  contacts_id_name           = "Cell"
+  contacts_upload_mode       = "replace"
 }
@@ -0,0 +1,71 @@
+resource "genesyscloud_outbound_digitalruleset" "example_outbound_digitalruleset" {
+  name            = "Example Digital Ruleset"
+  contact_list_id = genesyscloud_outbound_contact_list.contact_list.id
+  rules {
+    name     = "Skip opted out contacts"
+    order    = 0
+    category = "PreContact" // Possible values: PreContact, PostContact
+    conditions {
+      inverted = false
+      contact_column_condition_settings {
+        column_name = "OptedOut"
+        operator    = "Equals" // Possible values: Equals, LessThan, LessThanEquals, GreaterThan, GreaterThanEquals, Contains, BeginsWith, EndsWith, Before, After
+        value       = "true"
+        value_type  = "String" // Possible values: DateTime, Numeric, Period, String
+      }
+    }
+    conditions {
+      data_action_condition_settings {
+        data_action_id            = genesyscloud_integration_action.data_action.id
+        contact_id_field          = "contactId"
+        data_not_found_resolution = true
+        predicates {
+          output_field                    = "status"
+          output_operator                 = "Equals"
+          comparison_value                = "blocked"
+          inverted                        = false
+          output_field_missing_resolution = true
+        }
+        contact_column_to_data_action_field_mappings {
+          contact_column_name = "Email"
+          data_action_field   = "email"
+        }
+      }
+    }
+    actions {
+      do_not_send_action_settings = jsonencode({})
+    }
+    actions {
+      set_content_template_action_settings {
+        sms_content_template_id   = genesyscloud_responsemanagement_response.sms_template.id
+        email_content_template_id = genesyscloud_responsemanagement_response.email_template.id
+      }
+    }
+  }
+  rules {
+    name     = "Add to DNC after opt out wrapup"
+    order    = 1
+    category = "PostContact"
+    conditions {
+      last_result_overall_condition_settings {
+        email_wrapup_codes = [genesyscloud_routing_wrapupcode.opt_out.id]
+        sms_wrapup_codes   = [genesyscloud_routing_wrapupcode.opt_out.id]
+      }
+    }
+    actions {
+      append_to_dnc_action_settings {
+        expire              = true
+        expiration_duration = "P30D"
+        list_type           = "Rds" // Possible values: Rds, RdsCustom
+      }
+    }
+    actions {
+      update_contact_column_action_settings {
+        properties = {
+          OptedOut = "true"
+        }
+        update_option = "Set" // Possible values: Set, Increment, Decrement, CurrentTime
+      }
+    }
+  }
+}
\ No newline at end of file
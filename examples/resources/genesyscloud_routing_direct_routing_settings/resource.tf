@@ -0,0 +1,7 @@
+resource "genesyscloud_routing_direct_routing_settings" "example_settings" {
+  queue_id                         = genesyscloud_routing_queue.example_queue.id
+  backup_queue_id                  = genesyscloud_routing_queue.example_backup_queue.id
+  agent_wait_seconds               = 120
+  wait_for_agent                   = true
+  email_use_agent_address_outbound = false
+}
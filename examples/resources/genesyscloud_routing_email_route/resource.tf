@@ -17,4 +17,9 @@ resource "genesyscloud_routing_email_route" "support-route" {
     name  = "Example Support"
     email = "support@example.domain.com"
   }
+  signature {
+    enabled            = true
+    canned_response_id = genesyscloud_responsemanagement_response.signature.id
+    inclusion_type     = "Draft"
+  }
 }
@@ -0,0 +1,8 @@
+resource "genesyscloud_routing_message_address_instagram" "example_instagram" {
+  name              = "Example Instagram"
+  page_id           = "123456789012345"
+  app_id            = "987654321098765"
+  app_secret        = var.meta_app_secret
+  page_access_token = var.meta_page_access_token
+  inbound_flow_id   = genesyscloud_flow.inbound_message_flow.id
+}
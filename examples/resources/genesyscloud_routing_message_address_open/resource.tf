@@ -0,0 +1,9 @@
+resource "genesyscloud_routing_message_address_open" "example_open_messaging" {
+  name                                                 = "Example Open Messaging"
+  outbound_notification_webhook_url                    = "https://example.com/genesys/messages"
+  outbound_notification_webhook_signature_secret_token = var.open_messaging_secret_token
+  inbound_flow_id                                      = genesyscloud_flow.inbound_message_flow.id
+  webhook_headers = {
+    "X-Api-Key" = var.open_messaging_api_key
+  }
+}
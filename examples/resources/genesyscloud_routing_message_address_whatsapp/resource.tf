@@ -0,0 +1,6 @@
+resource "genesyscloud_routing_message_address_whatsapp" "example_whatsapp" {
+  name             = "Example WhatsApp"
+  phone_number     = "+13175550100"
+  waba_certificate = var.waba_certificate
+  inbound_flow_id  = genesyscloud_flow.inbound_message_flow.id
+}
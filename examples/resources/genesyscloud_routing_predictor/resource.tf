@@ -0,0 +1,10 @@
+resource "genesyscloud_routing_predictor" "example_predictor" {
+  queue_ids               = [genesyscloud_routing_queue.example_queue.id]
+  kpi                     = "Handle Time"
+  routing_timeout_seconds = 60
+  workload_balancing_config {
+    enabled           = true
+    minimum_occupancy = 20
+    maximum_occupancy = 80
+  }
+}
@@ -0,0 +1,11 @@
+resource "genesyscloud_routing_queue_member" "support_team" {
+  queue_id = genesyscloud_routing_queue.example_queue.id
+  members {
+    user_id  = genesyscloud_user.example_user.id
+    ring_num = 1
+  }
+  members {
+    user_id  = genesyscloud_user.example_user2.id
+    ring_num = 2
+  }
+}
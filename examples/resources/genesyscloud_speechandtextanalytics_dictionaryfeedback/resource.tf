@@ -0,0 +1,11 @@
+resource "genesyscloud_speechandtextanalytics_dictionaryfeedback" "example_dictionary_feedback" {
+  term        = "Genesys"
+  dialect     = "en-US"
+  boost_value = 2.5
+  example_phrases = [
+    "welcome to Genesys",
+    "Genesys Cloud is down",
+    "I need help with my Genesys account",
+  ]
+  sounds_like = ["genesis"]
+}
@@ -0,0 +1,9 @@
+resource "genesyscloud_speechandtextanalytics_program" "example_program" {
+  name        = "Retention"
+  description = "Detects customers at risk of leaving"
+  tags        = ["retention"]
+  topic_ids   = [genesyscloud_speechandtextanalytics_topic.example_topic.id]
+  queue_ids   = [genesyscloud_routing_queue.example_queue.id]
+  flow_ids    = [genesyscloud_flow.example_flow.id]
+  published   = true
+}
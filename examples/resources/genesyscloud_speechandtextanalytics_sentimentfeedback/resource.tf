@@ -0,0 +1,5 @@
+resource "genesyscloud_speechandtextanalytics_sentimentfeedback" "example_sentiment_feedback" {
+  phrase         = "that was sick"
+  dialect        = "en-US"
+  feedback_value = "Positive"
+}
@@ -0,0 +1,6 @@
+resource "genesyscloud_speechandtextanalytics_settings" "settings" {
+  default_program_id     = genesyscloud_speechandtextanalytics_program.example_program.id
+  expected_dialects      = ["en-US", "es-US"]
+  text_analytics_enabled = true
+  agent_empathy_enabled  = true
+}
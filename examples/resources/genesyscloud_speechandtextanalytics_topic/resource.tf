@@ -0,0 +1,17 @@
+resource "genesyscloud_speechandtextanalytics_topic" "example_topic" {
+  name         = "Cancellation"
+  description  = "Customers asking to cancel their subscription"
+  dialect      = "en-US"
+  strictness   = "72"
+  participants = "External"
+  tags         = ["retention"]
+  phrases {
+    text = "cancel my subscription"
+  }
+  phrases {
+    text       = "close my account"
+    strictness = "85"
+    sentiment  = "Negative"
+  }
+  published = true
+}
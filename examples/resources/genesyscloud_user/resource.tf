@@ -65,4 +65,19 @@ resource "genesyscloud_user" "example_user" {
       interruptible_media_types = ["call", "chat"]
     }
   }
+  biography {
+    biography = "John has been with the development team since 2019."
+    interests = ["Cycling", "Chess"]
+    hobbies   = ["Gardening"]
+  }
+  profile_image_filepath          = "${path.module}/profile.png"
+  profile_image_file_content_hash = filesha256("${path.module}/profile.png")
+  voicemail_userpolicies {
+    enabled                  = true
+    alert_timeout_seconds    = 30
+    send_email_notifications = true
+  }
+  station {
+    default_station_id = data.genesyscloud_station.example-station.id
+  }
 }
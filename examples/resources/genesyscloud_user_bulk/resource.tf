@@ -0,0 +1,4 @@
+resource "genesyscloud_user_bulk" "agents" {
+  users_filepath    = "${path.module}/agents.csv"
+  file_content_hash = filesha256("${path.module}/agents.csv")
+}
@@ -0,0 +1,9 @@
+resource "genesyscloud_wfm_activity_code" "example_activity_code" {
+  business_unit_id    = genesyscloud_wfm_business_unit.example_business_unit.id
+  name                = "Coaching"
+  category            = "Training"
+  length_in_minutes   = 30
+  counts_as_paid_time = true
+  counts_as_work_time = true
+  interruptible       = false
+}
@@ -0,0 +1,8 @@
+resource "genesyscloud_wfm_business_unit" "example_business_unit" {
+  name                     = "Example Business Unit"
+  division_id              = genesyscloud_auth_division.example_division.id
+  start_day_of_week        = "Monday"
+  time_zone                = "America/New_York"
+  default_history_weeks    = 8
+  sync_time_off_properties = ["PayableMinutes"]
+}
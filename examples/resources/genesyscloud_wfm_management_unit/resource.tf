@@ -0,0 +1,14 @@
+resource "genesyscloud_wfm_management_unit" "example_management_unit" {
+  name             = "Example Management Unit"
+  business_unit_id = genesyscloud_wfm_business_unit.example_business_unit.id
+  division_id      = genesyscloud_auth_division.example_division.id
+  agent_ids        = [genesyscloud_user.example_user.id]
+  adherence_settings {
+    severe_alert_threshold_minutes        = 15
+    adherence_target_percent              = 90
+    adherence_exception_threshold_seconds = 60
+    non_on_queue_activities_equivalent    = false
+    track_on_queue_activity               = true
+    ignored_activity_categories           = ["Meal", "Break"]
+  }
+}
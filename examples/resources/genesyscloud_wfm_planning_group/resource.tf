@@ -0,0 +1,15 @@
+resource "genesyscloud_wfm_planning_group" "example_planning_group" {
+  business_unit_id         = genesyscloud_wfm_business_unit.example_business_unit.id
+  name                     = "Example Planning Group"
+  service_goal_template_id = genesyscloud_wfm_service_goal_template.example_service_goal_template.id
+  route_paths {
+    queue_id   = genesyscloud_routing_queue.example_queue.id
+    media_type = "Voice"
+  }
+  route_paths {
+    queue_id    = genesyscloud_routing_queue.example_queue.id
+    media_type  = "Chat"
+    language_id = genesyscloud_routing_language.example_language.id
+    skill_ids   = [genesyscloud_routing_skill.example_skill.id]
+  }
+}
@@ -0,0 +1,17 @@
+resource "genesyscloud_wfm_service_goal_template" "example_service_goal_template" {
+  business_unit_id = genesyscloud_wfm_business_unit.example_business_unit.id
+  name             = "Example Service Goals"
+  service_level {
+    include = true
+    percent = 80
+    seconds = 20
+  }
+  average_speed_of_answer {
+    include = true
+    seconds = 30
+  }
+  abandon_rate {
+    include = true
+    percent = 5
+  }
+}
@@ -0,0 +1,204 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func getAllArchitectDatatableRows(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	proxy := getArchitectDatatableRowsProxy(clientConfig)
+
+	tables, err := proxy.getAllArchitectDatatable(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get datatables: %s", err)
+	}
+
+	for _, table := range *tables {
+		resources[*table.Id] = &resourceExporter.ResourceMeta{Name: *table.Name + "_rows"}
+	}
+	return resources, nil
+}
+
+// importArchitectDatatableRows sets the datatable from the ID of the imported resource. The rows file is not known,
+// so the first apply after an import applies the file from the configuration.
+func importArchitectDatatableRows(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("datatable_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func createArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Get("datatable_id").(string)
+
+	log.Printf("Creating rows of datatable %s", tableId)
+	if diagErr := applyArchitectDatatableRows(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(tableId)
+	log.Printf("Created rows of datatable %s", tableId)
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func readArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	log.Printf("Reading rows of datatable %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		datatable, resp, getErr := proxy.getArchitectDatatable(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read datatable %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read datatable %s: %s", d.Id(), getErr))
+		}
+
+		rows, _, getErr := proxy.getAllArchitectDatatableRows(ctx, d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read rows of datatable %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceArchitectDatatableRows())
+		_ = d.Set("datatable_id", d.Id())
+		_ = d.Set("row_count", len(*rows))
+
+		// Rows changed outside of Terraform do not change the hash of the file, so clearing it makes Terraform plan an
+		// update that applies the file again
+		if !rowsMatchFile(d.Get("rows_csv_filepath").(string), getDatatableColumns(datatable), *rows) {
+			log.Printf("Rows of datatable %s differ from the rows file", d.Id())
+			_ = d.Set("file_content_hash", nil)
+		}
+
+		log.Printf("Read %d rows of datatable %s", len(*rows), d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating rows of datatable %s", d.Id())
+	if diagErr := applyArchitectDatatableRows(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated rows of datatable %s", d.Id())
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func deleteArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	datatable, resp, err := proxy.getArchitectDatatable(ctx, d.Id())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			// The datatable was probably deleted which caused its rows to be deleted
+			log.Printf("Datatable %s already deleted", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read datatable %s: %s", d.Id(), err)
+	}
+
+	// Replacing the rows with a file holding only the header removes every row in a single job
+	content, err := rowsToCsv(getDatatableColumns(datatable), nil)
+	if err != nil {
+		return diag.Errorf("Failed to build CSV for datatable %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Deleting rows of datatable %s", d.Id())
+	if diagErr := runDatatableImportJob(ctx, proxy, d.Id(), importModeReplaceAll, content); diagErr != nil {
+		return diagErr
+	}
+
+	return gcloud.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+		rows, resp, err := proxy.getAllArchitectDatatableRows(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting rows of datatable %s: %s", d.Id(), err))
+		}
+		if len(*rows) > 0 {
+			return retry.RetryableError(fmt.Errorf("Datatable %s still has %d rows", d.Id(), len(*rows)))
+		}
+		log.Printf("Deleted rows of datatable %s", d.Id())
+		return nil
+	})
+}
+
+// applyArchitectDatatableRows compares the CSV file with the current rows of the datatable and applies the inserts,
+// updates and deletes needed to make them match
+func applyArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	tableId := d.Get("datatable_id").(string)
+	csvFilepath := d.Get("rows_csv_filepath").(string)
+
+	datatable, _, err := proxy.getArchitectDatatable(ctx, tableId)
+	if err != nil {
+		return diag.Errorf("Failed to read datatable %s: %s", tableId, err)
+	}
+	columns := getDatatableColumns(datatable)
+
+	reader, file, err := files.DownloadOrOpenFile(csvFilepath)
+	if err != nil {
+		return diag.Errorf("Failed to open rows file %s: %s", csvFilepath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	desired, err := parseDatatableRowsCsv(reader, columns)
+	if err != nil {
+		return diag.Errorf("Invalid rows file %s: %s", csvFilepath, err)
+	}
+
+	currentRows, _, err := proxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		return diag.Errorf("Failed to read rows of datatable %s: %s", tableId, err)
+	}
+	current := rowsByKey(*currentRows)
+
+	upserts, deletes := diffDatatableRows(columns, desired, current)
+	log.Printf("Datatable %s has %d rows to insert or update and %d rows to delete", tableId, len(upserts), len(deletes))
+
+	if len(deletes) > maxIndividualRowDeletes {
+		content, err := rowsToCsv(columns, allRows(desired))
+		if err != nil {
+			return diag.Errorf("Failed to build CSV for datatable %s: %s", tableId, err)
+		}
+		return runDatatableImportJob(ctx, proxy, tableId, importModeReplaceAll, content)
+	}
+
+	if len(upserts) > 0 {
+		content, err := rowsToCsv(columns, selectRows(desired, upserts))
+		if err != nil {
+			return diag.Errorf("Failed to build CSV for datatable %s: %s", tableId, err)
+		}
+		if diagErr := runDatatableImportJob(ctx, proxy, tableId, importModeAppend, content); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	for _, key := range deletes {
+		resp, err := proxy.deleteArchitectDatatableRow(ctx, tableId, key)
+		if err != nil && !gcloud.IsStatus404(resp) {
+			return diag.Errorf("Failed to delete row %s of datatable %s: %s", key, tableId, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *architectDatatableRowsProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error)
+type getAllArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowsProxy) (*[]platformclientv2.Datatable, error)
+type getAllArchitectDatatableRowsFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error)
+type deleteArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, key string) (*platformclientv2.APIResponse, error)
+type createArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
+type getArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
+type uploadArchitectDatatableImportFileFunc func(ctx context.Context, p *architectDatatableRowsProxy, uploadUri string, content []byte) error
+type createArchitectDatatableExportJobFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error)
+type getArchitectDatatableExportJobFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error)
+type downloadArchitectDatatableExportFileFunc func(ctx context.Context, p *architectDatatableRowsProxy, downloadUri string) ([]byte, error)
+
+type architectDatatableRowsProxy struct {
+	clientConfig                             *platformclientv2.Configuration
+	architectApi                             *platformclientv2.ArchitectApi
+	getArchitectDatatableAttr                getArchitectDatatableFunc
+	getAllArchitectDatatableAttr             getAllArchitectDatatableFunc
+	getAllArchitectDatatableRowsAttr         getAllArchitectDatatableRowsFunc
+	deleteArchitectDatatableRowAttr          deleteArchitectDatatableRowFunc
+	createArchitectDatatableImportJobAttr    createArchitectDatatableImportJobFunc
+	getArchitectDatatableImportJobAttr       getArchitectDatatableImportJobFunc
+	uploadArchitectDatatableImportFileAttr   uploadArchitectDatatableImportFileFunc
+	createArchitectDatatableExportJobAttr    createArchitectDatatableExportJobFunc
+	getArchitectDatatableExportJobAttr       getArchitectDatatableExportJobFunc
+	downloadArchitectDatatableExportFileAttr downloadArchitectDatatableExportFileFunc
+}
+
+func newArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &architectDatatableRowsProxy{
+		clientConfig:                             clientConfig,
+		architectApi:                             api,
+		getArchitectDatatableAttr:                getArchitectDatatableFn,
+		getAllArchitectDatatableAttr:             getAllArchitectDatatableFn,
+		getAllArchitectDatatableRowsAttr:         getAllArchitectDatatableRowsFn,
+		deleteArchitectDatatableRowAttr:          deleteArchitectDatatableRowFn,
+		createArchitectDatatableImportJobAttr:    createArchitectDatatableImportJobFn,
+		getArchitectDatatableImportJobAttr:       getArchitectDatatableImportJobFn,
+		uploadArchitectDatatableImportFileAttr:   uploadArchitectDatatableImportFileFn,
+		createArchitectDatatableExportJobAttr:    createArchitectDatatableExportJobFn,
+		getArchitectDatatableExportJobAttr:       getArchitectDatatableExportJobFn,
+		downloadArchitectDatatableExportFileAttr: downloadArchitectDatatableExportFileFn,
+	}
+}
+
+func getArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	if internalProxy == nil {
+		internalProxy = newArchitectDatatableRowsProxy(clientConfig)
+	}
+
+	return internalProxy
+}
+
+func (p *architectDatatableRowsProxy) getArchitectDatatable(ctx context.Context, id string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableAttr(ctx, p, id)
+}
+
+func (p *architectDatatableRowsProxy) getAllArchitectDatatable(ctx context.Context) (*[]platformclientv2.Datatable, error) {
+	return p.getAllArchitectDatatableAttr(ctx, p)
+}
+
+func (p *architectDatatableRowsProxy) getAllArchitectDatatableRows(ctx context.Context, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	return p.getAllArchitectDatatableRowsAttr(ctx, p, tableId)
+}
+
+func (p *architectDatatableRowsProxy) deleteArchitectDatatableRow(ctx context.Context, tableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.deleteArchitectDatatableRowAttr(ctx, p, tableId, key)
+}
+
+func (p *architectDatatableRowsProxy) createArchitectDatatableImportJob(ctx context.Context, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.createArchitectDatatableImportJobAttr(ctx, p, tableId, importMode)
+}
+
+func (p *architectDatatableRowsProxy) getArchitectDatatableImportJob(ctx context.Context, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableImportJobAttr(ctx, p, tableId, jobId)
+}
+
+func (p *architectDatatableRowsProxy) uploadArchitectDatatableImportFile(ctx context.Context, uploadUri string, content []byte) error {
+	return p.uploadArchitectDatatableImportFileAttr(ctx, p, uploadUri, content)
+}
+
+func (p *architectDatatableRowsProxy) createArchitectDatatableExportJob(ctx context.Context, tableId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+	return p.createArchitectDatatableExportJobAttr(ctx, p, tableId)
+}
+
+func (p *architectDatatableRowsProxy) getArchitectDatatableExportJob(ctx context.Context, tableId string, jobId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableExportJobAttr(ctx, p, tableId, jobId)
+}
+
+func (p *architectDatatableRowsProxy) downloadArchitectDatatableExportFile(ctx context.Context, downloadUri string) ([]byte, error) {
+	return p.downloadArchitectDatatableExportFileAttr(ctx, p, downloadUri)
+}
+
+func getArchitectDatatableFn(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+	apiClient := &p.architectApi.Configuration.APIClient
+
+	// create path and map variables
+	path := p.architectApi.Configuration.BasePath + "/api/v2/flows/datatables/" + datatableId
+
+	headerParams := make(map[string]string)
+	queryParams := make(map[string]string)
+
+	// oauth required
+	if p.architectApi.Configuration.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + p.architectApi.Configuration.AccessToken
+	}
+	// add default headers if any
+	for key := range p.architectApi.Configuration.DefaultHeader {
+		headerParams[key] = p.architectApi.Configuration.DefaultHeader[key]
+	}
+
+	queryParams["expand"] = apiClient.ParameterToString("schema", "")
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	var successPayload *dt.Datatable
+	response, err := apiClient.CallAPI(path, http.MethodGet, nil, headerParams, queryParams, nil, "", nil)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+func getAllArchitectDatatableFn(ctx context.Context, p *architectDatatableRowsProxy) (*[]platformclientv2.Datatable, error) {
+	var totalRecords []platformclientv2.Datatable
+
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		tables, _, getErr := p.architectApi.GetFlowsDatatables("", pageNum, pageSize, "", "", nil, "")
+		if getErr != nil {
+			return &totalRecords, getErr
+		}
+
+		if tables.Entities == nil || len(*tables.Entities) == 0 {
+			break
+		}
+
+		totalRecords = append(totalRecords, *tables.Entities...)
+
+		if tables.PageCount == nil || pageNum >= *tables.PageCount {
+			break
+		}
+	}
+
+	return &totalRecords, nil
+}
+
+func getAllArchitectDatatableRowsFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	var resources []map[string]interface{}
+
+	const pageSize = 500
+	for pageNum := 1; ; pageNum++ {
+		rows, resp, getErr := p.architectApi.GetFlowsDatatableRows(tableId, pageNum, pageSize, false, "")
+		if getErr != nil {
+			return nil, resp, getErr
+		}
+
+		if rows.Entities == nil || len(*rows.Entities) == 0 {
+			break
+		}
+
+		resources = append(resources, *rows.Entities...)
+
+		if rows.PageCount == nil || pageNum >= *rows.PageCount {
+			break
+		}
+	}
+
+	return &resources, nil, nil
+}
+
+func deleteArchitectDatatableRowFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.architectApi.DeleteFlowsDatatableRow(tableId, key)
+}
+
+func createArchitectDatatableImportJobFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.PostFlowsDatatableImportJobs(tableId, platformclientv2.Datatableimportjob{ImportMode: &importMode})
+}
+
+func getArchitectDatatableImportJobFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetFlowsDatatableImportJob(tableId, jobId)
+}
+
+// uploadArchitectDatatableImportFileFn uploads the CSV content of an import job to the upload URI returned when the job was created
+func uploadArchitectDatatableImportFileFn(ctx context.Context, p *architectDatatableRowsProxy, uploadUri string, content []byte) error {
+	// The uploader only sends readers backed by a file as a multipart file, so the content is staged in a temporary file
+	tempFile, err := os.CreateTemp("", "datatable-rows-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(content); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		tempFile.Close()
+		return err
+	}
+
+	formData := make(map[string]io.Reader, 0)
+	formData["file"] = tempFile
+
+	headers := make(map[string]string, 0)
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, http.MethodPost, uploadUri)
+	_, err = s3Uploader.Upload()
+	return err
+}
+
+func createArchitectDatatableExportJobFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.PostFlowsDatatableExportJobs(tableId)
+}
+
+func getArchitectDatatableExportJobFn(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetFlowsDatatableExportJob(tableId, jobId)
+}
+
+// downloadArchitectDatatableExportFileFn downloads the CSV file written by an export job. The download URI requires
+// authentication and redirects to the file itself.
+func downloadArchitectDatatableExportFileFn(ctx context.Context, p *architectDatatableRowsProxy, downloadUri string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.clientConfig.AccessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("HTTP Error downloading file: %v", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
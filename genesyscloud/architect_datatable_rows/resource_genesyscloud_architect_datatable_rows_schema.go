@@ -0,0 +1,80 @@
+package architect_datatable_rows
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+Defines the resource schema and the exporter for the architect_datatable_rows package. Unlike
+genesyscloud_architect_datatable_row, which manages a single row, this resource manages every row of a datatable
+from one CSV file.
+*/
+const resourceName = "genesyscloud_architect_datatable_rows"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceArchitectDatatableRows())
+	//No Datasource defined
+	regInstance.RegisterExporter(resourceName, ArchitectDatatableRowsExporter())
+}
+
+// ArchitectDatatableRowsExporter returns the exporter configuration for this resource
+func ArchitectDatatableRowsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllArchitectDatatableRows),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"datatable_id": {RefType: "genesyscloud_architect_datatable"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: DatatableRowsResolver,
+			SubDirectory:              "datatables",
+		},
+		ExcludedAttributes: []string{"row_count"},
+	}
+}
+
+// ResourceArchitectDatatableRows returns the resource schema definition
+func ResourceArchitectDatatableRows() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Architect Datatable Rows. Manages all rows of a datatable from a CSV file. The first row of the file is a header naming the datatable properties; the key column may be named "key" or after the title of the key property.
+Rows are compared with the current rows of the datatable by key and only the rows that were added, changed or removed are sent to Genesys Cloud. Do not use this resource together with genesyscloud_architect_datatable_row for the same datatable.
+When the rows of the datatable no longer match the file, the next apply applies the file again. The ID of an imported resource is the ID of the datatable; the first apply after an import applies the file.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createArchitectDatatableRows),
+		ReadContext:   gcloud.ReadWithPooledClient(readArchitectDatatableRows),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateArchitectDatatableRows),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteArchitectDatatableRows),
+		Importer: &schema.ResourceImporter{
+			StateContext: importArchitectDatatableRows,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"datatable_id": {
+				Description: "ID of the datatable whose rows are managed. If this is changed, the rows of the old datatable are removed.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rows_csv_filepath": {
+				Description:  "Path to the CSV file containing the rows of the datatable.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: gcloud.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the CSV file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"row_count": {
+				Description: "Number of rows in the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
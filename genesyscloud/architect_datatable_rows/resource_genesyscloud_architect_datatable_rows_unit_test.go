@@ -0,0 +1,298 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestDatatable() *dt.Datatable {
+	var boolDefault interface{} = true
+	properties := map[string]dt.Datatableproperty{
+		"key":      {VarType: platformclientv2.String("string"), Title: platformclientv2.String("Name"), DisplayOrder: platformclientv2.Int(0)},
+		"Enabled":  {VarType: platformclientv2.String("boolean"), Title: platformclientv2.String("Enabled"), DisplayOrder: platformclientv2.Int(2), Default: &boolDefault},
+		"Priority": {VarType: platformclientv2.String("integer"), Title: platformclientv2.String("Priority"), DisplayOrder: platformclientv2.Int(1)},
+		"Ratio":    {VarType: platformclientv2.String("number"), Title: platformclientv2.String("Ratio"), DisplayOrder: platformclientv2.Int(3)},
+		"Queue":    {VarType: platformclientv2.String("string"), Title: platformclientv2.String("Queue"), DisplayOrder: platformclientv2.Int(4)},
+	}
+	return &dt.Datatable{
+		Id:     platformclientv2.String("table-1"),
+		Name:   platformclientv2.String("Routing Table"),
+		Schema: &dt.Jsonschemadocument{Properties: &properties},
+	}
+}
+
+func TestUnitParseDatatableRowsCsv(t *testing.T) {
+	columns := getDatatableColumns(buildTestDatatable())
+
+	testCases := []struct {
+		name        string
+		csv         string
+		expected    datatableRows
+		expectedErr string
+	}{
+		{
+			name: "converts values and applies defaults",
+			csv:  "Name,Priority,Enabled,Ratio\nsales,5,false,0.5\nsupport,,,\n",
+			expected: datatableRows{
+				"sales":   {"key": "sales", "Priority": float64(5), "Enabled": false, "Ratio": 0.5, "Queue": ""},
+				"support": {"key": "support", "Priority": float64(0), "Enabled": true, "Ratio": float64(0), "Queue": ""},
+			},
+		},
+		{
+			name: "accepts key as the key column header",
+			csv:  "Queue,key\nQueue A,sales\n",
+			expected: datatableRows{
+				"sales": {"key": "sales", "Priority": float64(0), "Enabled": true, "Ratio": float64(0), "Queue": "Queue A"},
+			},
+		},
+		{name: "empty file", csv: "", expectedErr: "file is empty"},
+		{name: "missing key column", csv: "Priority\n1\n", expectedErr: "header does not contain the key column"},
+		{name: "unknown column", csv: "Name,Region\nsales,emea\n", expectedErr: "column Region is not a property of the datatable"},
+		{name: "empty key", csv: "Name,Priority\n,1\n", expectedErr: "row 2: key is empty"},
+		{name: "duplicate key", csv: "Name\nsales\nsales\n", expectedErr: "row 3: duplicate key sales"},
+		{name: "invalid integer", csv: "Name,Priority\nsales,high\n", expectedErr: "row 2: value high of column Priority is not an integer"},
+		{name: "invalid boolean", csv: "Name,Enabled\nsales,maybe\n", expectedErr: "row 2: value maybe of column Enabled is not a boolean"},
+		{name: "wrong number of fields", csv: "Name,Priority\nsales\n", expectedErr: "row 2:"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rows, err := parseDatatableRowsCsv(strings.NewReader(testCase.csv), columns)
+			if testCase.expectedErr != "" {
+				assert.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, rows)
+		})
+	}
+}
+
+func TestUnitDiffDatatableRows(t *testing.T) {
+	columns := getDatatableColumns(buildTestDatatable())
+
+	desired := datatableRows{
+		"billing": {"key": "billing", "Priority": float64(1), "Enabled": true, "Ratio": float64(0), "Queue": ""},
+		"sales":   {"key": "sales", "Priority": float64(5), "Enabled": false, "Ratio": 0.5, "Queue": ""},
+		"support": {"key": "support", "Priority": float64(2), "Enabled": true, "Ratio": float64(0), "Queue": ""},
+	}
+	// Rows as returned by the API, which omits properties left at their default
+	current := rowsByKey([]map[string]interface{}{
+		{"key": "sales", "Priority": float64(5), "Enabled": false, "Ratio": 0.5},
+		{"key": "support", "Priority": float64(3), "Enabled": true},
+		{"key": "archive", "Priority": float64(9)},
+	})
+
+	upserts, deletes := diffDatatableRows(columns, desired, current)
+	assert.Equal(t, []string{"billing", "support"}, upserts)
+	assert.Equal(t, []string{"archive"}, deletes)
+}
+
+func TestUnitRowsToCsv(t *testing.T) {
+	columns := getDatatableColumns(buildTestDatatable())
+
+	content, err := rowsToCsv(columns, []map[string]interface{}{
+		{"key": "support", "Priority": float64(2), "Enabled": true, "Queue": "Tier, 2"},
+		{"key": "sales", "Priority": float64(5), "Enabled": false, "Ratio": 0.25},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Name,Priority,Enabled,Ratio,Queue\nsales,5,false,0.25,\nsupport,2,true,0,\"Tier, 2\"\n", string(content))
+
+	// Writing and parsing the same rows must not produce a diff
+	parsed, err := parseDatatableRowsCsv(strings.NewReader(string(content)), columns)
+	assert.NoError(t, err)
+	upserts, deletes := diffDatatableRows(columns, parsed, rowsByKey([]map[string]interface{}{
+		{"key": "support", "Priority": float64(2), "Enabled": true, "Queue": "Tier, 2"},
+		{"key": "sales", "Priority": float64(5), "Enabled": false, "Ratio": 0.25},
+	}))
+	assert.Empty(t, upserts)
+	assert.Empty(t, deletes)
+}
+
+func TestUnitApplyArchitectDatatableRows(t *testing.T) {
+	tableId := "table-1"
+	csvPath := filepath.Join(t.TempDir(), "rows.csv")
+	if err := os.WriteFile(csvPath, []byte("Name,Priority\nsales,5\nsupport,3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var importModes []string
+	var uploaded []string
+	var deletedKeys []string
+
+	proxy := &architectDatatableRowsProxy{}
+	proxy.getArchitectDatatableAttr = func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tableId, datatableId)
+		return buildTestDatatable(), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getAllArchitectDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+		return &[]map[string]interface{}{
+			{"key": "sales", "Priority": float64(5), "Enabled": true},
+			{"key": "support", "Priority": float64(2), "Enabled": true},
+			{"key": "archive", "Priority": float64(1), "Enabled": true},
+		}, nil, nil
+	}
+	proxy.createArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		importModes = append(importModes, importMode)
+		return &platformclientv2.Datatableimportjob{Id: platformclientv2.String("job-1"), UploadURI: platformclientv2.String("https://upload")}, nil, nil
+	}
+	proxy.uploadArchitectDatatableImportFileAttr = func(ctx context.Context, p *architectDatatableRowsProxy, uploadUri string, content []byte) error {
+		uploaded = append(uploaded, string(content))
+		return nil
+	}
+	proxy.getArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Datatableimportjob{Id: &jobId, Status: platformclientv2.String("Succeeded"), CountRecordsFailed: platformclientv2.Int(0)}, nil, nil
+	}
+	proxy.deleteArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, key string) (*platformclientv2.APIResponse, error) {
+		deletedKeys = append(deletedKeys, key)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceArchitectDatatableRows().Schema, map[string]interface{}{
+		"datatable_id":      tableId,
+		"rows_csv_filepath": csvPath,
+		"file_content_hash": "hash",
+	})
+	d.SetId(tableId)
+
+	diagErr := applyArchitectDatatableRows(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diagErr.HasError(), "unexpected error: %v", diagErr)
+
+	// Only the changed row is imported and the row missing from the file is deleted
+	assert.Equal(t, []string{importModeAppend}, importModes)
+	assert.Equal(t, []string{"Name,Priority,Enabled,Ratio,Queue\nsupport,3,true,0,\n"}, uploaded)
+	assert.Equal(t, []string{"archive"}, deletedKeys)
+}
+
+func TestUnitReadArchitectDatatableRows(t *testing.T) {
+	tableId := "table-1"
+	csvPath := filepath.Join(t.TempDir(), "rows.csv")
+	if err := os.WriteFile(csvPath, []byte("Name,Priority\nsales,5\nsupport,3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name         string
+		filepath     string
+		rows         []map[string]interface{}
+		expectedHash string
+	}{
+		{
+			name:     "rows match the file",
+			filepath: csvPath,
+			rows: []map[string]interface{}{
+				{"key": "sales", "Priority": float64(5), "Enabled": true},
+				{"key": "support", "Priority": float64(3), "Enabled": true},
+			},
+			expectedHash: "hash",
+		},
+		{
+			name:     "row changed outside of Terraform",
+			filepath: csvPath,
+			rows: []map[string]interface{}{
+				{"key": "sales", "Priority": float64(5), "Enabled": true},
+				{"key": "support", "Priority": float64(4), "Enabled": true},
+			},
+			expectedHash: "",
+		},
+		{
+			name:     "row added outside of Terraform",
+			filepath: csvPath,
+			rows: []map[string]interface{}{
+				{"key": "sales", "Priority": float64(5), "Enabled": true},
+				{"key": "support", "Priority": float64(3), "Enabled": true},
+				{"key": "archive", "Priority": float64(1), "Enabled": true},
+			},
+			expectedHash: "",
+		},
+		{
+			name:         "imported without a file",
+			filepath:     "",
+			rows:         []map[string]interface{}{{"key": "sales", "Priority": float64(1), "Enabled": true}},
+			expectedHash: "hash",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			proxy := &architectDatatableRowsProxy{}
+			proxy.getArchitectDatatableAttr = func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+				return buildTestDatatable(), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+			}
+			proxy.getAllArchitectDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+				return &testCase.rows, nil, nil
+			}
+			internalProxy = proxy
+			defer func() { internalProxy = nil }()
+
+			// A refresh reads into the current state rather than into a planned diff
+			d := ResourceArchitectDatatableRows().Data(&terraform.InstanceState{
+				ID: tableId,
+				Attributes: map[string]string{
+					"datatable_id":      tableId,
+					"rows_csv_filepath": testCase.filepath,
+					"file_content_hash": "hash",
+				},
+			})
+
+			diagErr := readArchitectDatatableRows(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+			assert.False(t, diagErr.HasError(), "unexpected error: %v", diagErr)
+			assert.Equal(t, tableId, d.Get("datatable_id"))
+			assert.Equal(t, len(testCase.rows), d.Get("row_count"))
+			assert.Equal(t, testCase.expectedHash, d.Get("file_content_hash"))
+		})
+	}
+}
+
+func TestUnitDatatableRowsResolver(t *testing.T) {
+	tableId := "table-1"
+	jobPolls := 0
+
+	proxy := &architectDatatableRowsProxy{}
+	proxy.getArchitectDatatableAttr = func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+		return buildTestDatatable(), nil, nil
+	}
+	proxy.createArchitectDatatableExportJobAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Datatableexportjob{Id: platformclientv2.String("job-1"), Status: platformclientv2.String("Processing")}, nil, nil
+	}
+	proxy.getArchitectDatatableExportJobAttr = func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, jobId string) (*platformclientv2.Datatableexportjob, *platformclientv2.APIResponse, error) {
+		jobPolls++
+		if jobPolls == 1 {
+			return &platformclientv2.Datatableexportjob{Id: &jobId, Status: platformclientv2.String("Processing")}, nil, nil
+		}
+		return &platformclientv2.Datatableexportjob{Id: &jobId, Status: platformclientv2.String("Succeeded"), DownloadURI: platformclientv2.String("https://download")}, nil, nil
+	}
+	proxy.downloadArchitectDatatableExportFileAttr = func(ctx context.Context, p *architectDatatableRowsProxy, downloadUri string) ([]byte, error) {
+		assert.Equal(t, "https://download", downloadUri)
+		// Export files name columns after their titles and are not sorted
+		return []byte("Name,Priority,Enabled,Ratio,Queue\nsupport,2,TRUE,0,Tier 2\nsales,5,FALSE,0.25,\n"), nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	exportDir := t.TempDir()
+	configMap := map[string]interface{}{}
+	err := DatatableRowsResolver(tableId, exportDir, "datatables", configMap, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "datatables/Routing_Table-table-1.csv", configMap["rows_csv_filepath"])
+	assert.Equal(t, `${filesha256("datatables/Routing_Table-table-1.csv")}`, configMap["file_content_hash"])
+
+	content, err := os.ReadFile(filepath.Join(exportDir, "datatables", "Routing_Table-table-1.csv"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Name,Priority,Enabled,Ratio,Queue\nsales,5,false,0.25,\nsupport,2,true,0,Tier 2\n", string(content))
+}
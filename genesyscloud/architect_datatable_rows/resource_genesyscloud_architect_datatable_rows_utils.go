@@ -0,0 +1,507 @@
+package architect_datatable_rows
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	importModeAppend     = "Append"
+	importModeReplaceAll = "ReplaceAll"
+
+	// Deleting rows one at a time costs an API call per row. Beyond this number of deletes the whole table is
+	// replaced by a single import job instead.
+	maxIndividualRowDeletes = 50
+
+	keyPropertyName = "key"
+)
+
+// datatableColumn describes a property of the datatable schema as a CSV column
+type datatableColumn struct {
+	name         string
+	header       string
+	title        string
+	varType      string
+	defaultValue interface{}
+}
+
+// datatableRows holds the rows of a CSV file, keyed by the value of the key column
+type datatableRows map[string]map[string]interface{}
+
+// getDatatableColumns returns the columns of the datatable with the key column first and the other columns in display order
+func getDatatableColumns(datatable *dt.Datatable) []datatableColumn {
+	var columns []datatableColumn
+	if datatable.Schema == nil || datatable.Schema.Properties == nil {
+		return columns
+	}
+
+	type orderedColumn struct {
+		datatableColumn
+		displayOrder int
+	}
+	var ordered []orderedColumn
+	for name, prop := range *datatable.Schema.Properties {
+		column := datatableColumn{
+			name:   name,
+			header: name,
+			title:  name,
+		}
+		if prop.VarType != nil {
+			column.varType = *prop.VarType
+		}
+		if prop.Title != nil && *prop.Title != "" {
+			column.title = *prop.Title
+		}
+		// The key column is written with the title shown in the UI so that exported files can be imported in the UI too
+		if name == keyPropertyName && prop.Title != nil && *prop.Title != "" {
+			column.header = *prop.Title
+		}
+		column.defaultValue = defaultColumnValue(column.varType, prop.Default)
+
+		displayOrder := 0
+		if prop.DisplayOrder != nil {
+			displayOrder = *prop.DisplayOrder
+		}
+		if name == keyPropertyName {
+			displayOrder = -1
+		}
+		ordered = append(ordered, orderedColumn{datatableColumn: column, displayOrder: displayOrder})
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].displayOrder != ordered[j].displayOrder {
+			return ordered[i].displayOrder < ordered[j].displayOrder
+		}
+		return ordered[i].name < ordered[j].name
+	})
+	for _, column := range ordered {
+		columns = append(columns, column.datatableColumn)
+	}
+	return columns
+}
+
+// defaultColumnValue returns the value Genesys Cloud gives a property when a row does not set it
+func defaultColumnValue(varType string, defaultValue *interface{}) interface{} {
+	if defaultValue != nil {
+		if number, ok := (*defaultValue).(int); ok {
+			return float64(number)
+		}
+		return *defaultValue
+	}
+	switch varType {
+	case "boolean":
+		return false
+	case "integer", "number":
+		return float64(0)
+	}
+	return ""
+}
+
+// parseDatatableRowsCsv reads the rows of a CSV file and converts the values to the types of the datatable properties.
+// Unlike contact lists, a datatable must match the file exactly, so any invalid row fails the whole file.
+func parseDatatableRowsCsv(reader io.Reader, columns []datatableColumn) (datatableRows, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, fmt.Errorf("failed to read header: %s", err)
+	}
+
+	headerColumns := make([]datatableColumn, len(header))
+	keyIndex := -1
+	for i, name := range header {
+		column, ok := findColumn(columns, strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("column %s is not a property of the datatable", name)
+		}
+		if column.name == keyPropertyName {
+			keyIndex = i
+		}
+		headerColumns[i] = column
+	}
+	if keyIndex == -1 {
+		return nil, fmt.Errorf("header does not contain the key column")
+	}
+
+	rows := make(datatableRows)
+	for rowNum := 2; ; rowNum++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %s", rowNum, err)
+		}
+
+		key := record[keyIndex]
+		if key == "" {
+			return nil, fmt.Errorf("row %d: key is empty", rowNum)
+		}
+		if _, ok := rows[key]; ok {
+			return nil, fmt.Errorf("row %d: duplicate key %s", rowNum, key)
+		}
+
+		row := make(map[string]interface{}, len(columns))
+		for _, column := range columns {
+			row[column.name] = column.defaultValue
+		}
+		for i, value := range record {
+			column := headerColumns[i]
+			if column.name == keyPropertyName {
+				row[keyPropertyName] = key
+				continue
+			}
+			converted, err := convertCsvValue(column, value)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %s", rowNum, err)
+			}
+			row[column.name] = converted
+		}
+		rows[key] = row
+	}
+	return rows, nil
+}
+
+// findColumn matches a CSV header to a datatable column by property name or by title. Files written by the datatable
+// export job name every column after its title.
+func findColumn(columns []datatableColumn, name string) (datatableColumn, bool) {
+	for _, column := range columns {
+		if column.name == name || column.title == name {
+			return column, true
+		}
+	}
+	return datatableColumn{}, false
+}
+
+// convertCsvValue converts a CSV value to the type of the column. Empty values take the column default.
+func convertCsvValue(column datatableColumn, value string) (interface{}, error) {
+	if value == "" {
+		return column.defaultValue, nil
+	}
+	switch column.varType {
+	case "boolean":
+		boolValue, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("value %s of column %s is not a boolean", value, column.name)
+		}
+		return boolValue, nil
+	case "integer":
+		intValue, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %s of column %s is not an integer", value, column.name)
+		}
+		// Numbers are compared with rows decoded from JSON, where every number is a float64
+		return float64(intValue), nil
+	case "number":
+		floatValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %s of column %s is not a number", value, column.name)
+		}
+		return floatValue, nil
+	}
+	return value, nil
+}
+
+// formatCsvValue converts a row value to its CSV representation
+func formatCsvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// rowsToCsv writes the given rows as a CSV file with a header, sorted by key
+func rowsToCsv(columns []datatableColumn, rows []map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.header
+	}
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+
+	sortedRows := make([]map[string]interface{}, len(rows))
+	copy(sortedRows, rows)
+	sort.SliceStable(sortedRows, func(i, j int) bool {
+		return formatCsvValue(sortedRows[i][keyPropertyName]) < formatCsvValue(sortedRows[j][keyPropertyName])
+	})
+
+	for _, row := range sortedRows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			value, ok := row[column.name]
+			if !ok {
+				value = column.defaultValue
+			}
+			record[i] = formatCsvValue(value)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// rowsByKey indexes the rows returned by the API by their key value
+func rowsByKey(rows []map[string]interface{}) datatableRows {
+	result := make(datatableRows, len(rows))
+	for _, row := range rows {
+		if key, ok := row[keyPropertyName]; ok {
+			result[formatCsvValue(key)] = row
+		}
+	}
+	return result
+}
+
+// diffDatatableRows compares the rows of the CSV file with the current rows of the datatable and returns the keys of
+// the rows that must be inserted or updated and the keys of the rows that must be deleted
+func diffDatatableRows(columns []datatableColumn, desired datatableRows, current datatableRows) ([]string, []string) {
+	var upserts, deletes []string
+	for key, desiredRow := range desired {
+		currentRow, ok := current[key]
+		if !ok || !rowsEqual(columns, desiredRow, currentRow) {
+			upserts = append(upserts, key)
+		}
+	}
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	sort.Strings(upserts)
+	sort.Strings(deletes)
+	return upserts, deletes
+}
+
+// rowsEqual compares two rows by the CSV representation of each column so that numbers compare equal regardless of their Go type
+func rowsEqual(columns []datatableColumn, a map[string]interface{}, b map[string]interface{}) bool {
+	for _, column := range columns {
+		aValue, ok := a[column.name]
+		if !ok {
+			aValue = column.defaultValue
+		}
+		bValue, ok := b[column.name]
+		if !ok {
+			bValue = column.defaultValue
+		}
+		if formatCsvValue(aValue) != formatCsvValue(bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// rowsMatchFile reports whether the rows of the datatable have the same keys and values as the rows of the CSV file.
+// Without a file, as after an import, or with a file that cannot be read there is nothing to compare and the rows match.
+func rowsMatchFile(csvFilepath string, columns []datatableColumn, rows []map[string]interface{}) bool {
+	if csvFilepath == "" {
+		return true
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(csvFilepath)
+	if err != nil {
+		log.Printf("Failed to open rows file %s: %s", csvFilepath, err)
+		return true
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	desired, err := parseDatatableRowsCsv(reader, columns)
+	if err != nil {
+		log.Printf("Invalid rows file %s: %s", csvFilepath, err)
+		return true
+	}
+
+	upserts, deletes := diffDatatableRows(columns, desired, rowsByKey(rows))
+	return len(upserts) == 0 && len(deletes) == 0
+}
+
+// selectRows returns the rows with the given keys
+func selectRows(rows datatableRows, keys []string) []map[string]interface{} {
+	selected := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		selected = append(selected, rows[key])
+	}
+	return selected
+}
+
+// allRows returns every row in rows
+func allRows(rows datatableRows) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row)
+	}
+	return result
+}
+
+// runDatatableImportJob creates an import job, uploads the CSV content and waits for the job to finish
+func runDatatableImportJob(ctx context.Context, proxy *architectDatatableRowsProxy, tableId string, importMode string, content []byte) diag.Diagnostics {
+	job, _, err := proxy.createArchitectDatatableImportJob(ctx, tableId, importMode)
+	if err != nil {
+		return diag.Errorf("Failed to create import job for datatable %s: %s", tableId, err)
+	}
+	if job.Id == nil || job.UploadURI == nil {
+		return diag.Errorf("Import job for datatable %s did not return an upload URI", tableId)
+	}
+
+	log.Printf("Uploading rows to datatable %s with import job %s", tableId, *job.Id)
+	if err := proxy.uploadArchitectDatatableImportFile(ctx, *job.UploadURI, content); err != nil {
+		return diag.Errorf("Failed to upload rows to datatable %s: %s", tableId, err)
+	}
+
+	return gcloud.WithRetries(ctx, 10*time.Minute, func() *retry.RetryError {
+		status, _, err := proxy.getArchitectDatatableImportJob(ctx, tableId, *job.Id)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read import job %s of datatable %s: %s", *job.Id, tableId, err))
+		}
+
+		state := ""
+		if status.Status != nil {
+			state = *status.Status
+		}
+		switch state {
+		case "Succeeded":
+			if status.CountRecordsFailed != nil && *status.CountRecordsFailed > 0 {
+				return retry.NonRetryableError(fmt.Errorf("import job %s of datatable %s failed to import %d rows", *job.Id, tableId, *status.CountRecordsFailed))
+			}
+			log.Printf("Import job %s of datatable %s succeeded", *job.Id, tableId)
+			return nil
+		case "Failed":
+			reason := "unknown reason"
+			if status.ErrorInformation != nil && status.ErrorInformation.Message != nil {
+				reason = *status.ErrorInformation.Message
+			}
+			return retry.NonRetryableError(fmt.Errorf("import job %s of datatable %s failed: %s", *job.Id, tableId, reason))
+		}
+		return retry.RetryableError(fmt.Errorf("import job %s of datatable %s is %s", *job.Id, tableId, strings.ToLower(state)))
+	})
+}
+
+// exportDatatableRowsCsv runs an export job for the datatable and returns the CSV file it produced
+func exportDatatableRowsCsv(ctx context.Context, proxy *architectDatatableRowsProxy, tableId string) ([]byte, error) {
+	job, _, err := proxy.createArchitectDatatableExportJob(ctx, tableId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export job for datatable %s: %s", tableId, err)
+	}
+	if job.Id == nil {
+		return nil, fmt.Errorf("export job for datatable %s did not return an ID", tableId)
+	}
+
+	var downloadUri string
+	diagErr := gcloud.WithRetries(ctx, 10*time.Minute, func() *retry.RetryError {
+		status, _, err := proxy.getArchitectDatatableExportJob(ctx, tableId, *job.Id)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read export job %s of datatable %s: %s", *job.Id, tableId, err))
+		}
+
+		state := ""
+		if status.Status != nil {
+			state = *status.Status
+		}
+		switch state {
+		case "Succeeded":
+			if status.DownloadURI == nil {
+				return retry.NonRetryableError(fmt.Errorf("export job %s of datatable %s did not return a download URI", *job.Id, tableId))
+			}
+			downloadUri = *status.DownloadURI
+			return nil
+		case "Failed":
+			reason := "unknown reason"
+			if status.ErrorInformation != nil && status.ErrorInformation.Message != nil {
+				reason = *status.ErrorInformation.Message
+			}
+			return retry.NonRetryableError(fmt.Errorf("export job %s of datatable %s failed: %s", *job.Id, tableId, reason))
+		}
+		return retry.RetryableError(fmt.Errorf("export job %s of datatable %s is %s", *job.Id, tableId, strings.ToLower(state)))
+	})
+	if diagErr != nil {
+		return nil, fmt.Errorf("%v", diagErr)
+	}
+
+	return proxy.downloadArchitectDatatableExportFile(ctx, downloadUri)
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// DatatableRowsResolver exports the rows of a datatable with an export job and writes them to <name>-<id>.csv in the export
+// directory. The ID keeps tables whose names sanitize to the same string from overwriting each other's file. The file is
+// rewritten with the rows sorted by key so that exports diff cleanly between runs.
+func DatatableRowsResolver(tableId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	ctx := context.Background()
+
+	datatable, _, err := proxy.getArchitectDatatable(ctx, tableId)
+	if err != nil {
+		return fmt.Errorf("failed to read datatable %s: %s", tableId, err)
+	}
+	columns := getDatatableColumns(datatable)
+
+	exported, err := exportDatatableRowsCsv(ctx, proxy, tableId)
+	if err != nil {
+		return err
+	}
+	rows, err := parseDatatableRowsCsv(bytes.NewReader(exported), columns)
+	if err != nil {
+		return fmt.Errorf("failed to read rows exported from datatable %s: %s", tableId, err)
+	}
+	content, err := rowsToCsv(columns, allRows(rows))
+	if err != nil {
+		return fmt.Errorf("failed to write rows of datatable %s: %s", tableId, err)
+	}
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("%s-%s.csv", unsafeFileNameChars.ReplaceAllString(*datatable.Name, "_"), tableId)
+	if err := os.WriteFile(path.Join(fullPath, exportFileName), content, 0644); err != nil {
+		return err
+	}
+
+	// Update the filepath field in configMap to point to the exported CSV file
+	configMap["rows_csv_filepath"] = path.Join(subDirectory, exportFileName)
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+	return nil
+}
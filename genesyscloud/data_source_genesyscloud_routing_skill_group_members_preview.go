@@ -0,0 +1,197 @@
+package genesyscloud
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// skillGroupMemberCandidate holds the proficiencies of a user, keyed by skill and language name
+type skillGroupMemberCandidate struct {
+	routingSkills  map[string]float64
+	languageSkills map[string]float64
+}
+
+func dataSourceRoutingSkillGroupMembersPreview() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source for previewing the members of a Genesys Cloud Skill Group. Evaluates skill conditions against the skills and languages of the active users, so that the members can be reviewed before a skill group is created or changed.
+Each condition joins its routing and language skill conditions with its operation. A skill condition matches when the user has the skill with a matching proficiency, and its child conditions are then joined to it with their operation. Conditions in a list are joined to the ones before them with their operation.`,
+		ReadContext: ReadWithPooledClient(dataSourceRoutingSkillGroupMembersPreviewRead),
+		Schema: map[string]*schema.Schema{
+			"skill_conditions": {
+				Description:  "JSON encoded array of rules to evaluate, in the format of the skill_conditions of the genesyscloud_routing_skill_group resource.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSkillConditions,
+			},
+			"member_division_ids": {
+				Description: "The IDs of the divisions of the users to evaluate. If not set or \"*\", users of all divisions are evaluated.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"member_ids": {
+				Description: "The IDs of the users that match the skill conditions.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"member_count": {
+				Description: "The number of users that match the skill conditions.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceRoutingSkillGroupMembersPreviewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	skillConditions := d.Get("skill_conditions").(string)
+	conditions, err := parseSkillConditions(skillConditions)
+	if err != nil {
+		return diag.Errorf("Invalid skill conditions: %s", err)
+	}
+
+	divisionIds := lists.InterfaceListToStrings(d.Get("member_division_ids").([]interface{}))
+	if allMemberDivisionsSpecified(divisionIds) {
+		divisionIds = nil
+	}
+
+	const pageSize = 100
+	memberIds := make([]string, 0)
+	for pageNum := 1; ; pageNum++ {
+		users, _, getErr := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", []string{"skills", "languages"}, "", "active")
+		if getErr != nil {
+			return diag.Errorf("Failed to get page of users: %s", getErr)
+		}
+		if users.Entities == nil || len(*users.Entities) == 0 {
+			break
+		}
+
+		for _, user := range *users.Entities {
+			if len(divisionIds) > 0 && (user.Division == nil || user.Division.Id == nil || !lists.ItemInSlice(*user.Division.Id, divisionIds)) {
+				continue
+			}
+			if skillConditionsMatch(conditions, buildSkillGroupMemberCandidate(user)) {
+				memberIds = append(memberIds, *user.Id)
+			}
+		}
+
+		if users.PageCount == nil || pageNum >= *users.PageCount {
+			break
+		}
+	}
+	sort.Strings(memberIds)
+
+	d.SetId(strconv.Itoa(schema.HashString(skillConditions)))
+	_ = d.Set("member_ids", memberIds)
+	_ = d.Set("member_count", len(memberIds))
+	return nil
+}
+
+func buildSkillGroupMemberCandidate(user platformclientv2.User) skillGroupMemberCandidate {
+	candidate := skillGroupMemberCandidate{
+		routingSkills:  make(map[string]float64),
+		languageSkills: make(map[string]float64),
+	}
+	if user.Skills != nil {
+		for _, skill := range *user.Skills {
+			if skill.Name != nil && skill.Proficiency != nil {
+				candidate.routingSkills[*skill.Name] = *skill.Proficiency
+			}
+		}
+	}
+	if user.Languages != nil {
+		for _, language := range *user.Languages {
+			if language.Name != nil && language.Proficiency != nil {
+				candidate.languageSkills[*language.Name] = *language.Proficiency
+			}
+		}
+	}
+	return candidate
+}
+
+// skillConditionsMatch evaluates a list of conditions. Conditions without any skill condition are ignored, and a list
+// without any skill condition matches every user.
+func skillConditionsMatch(conditions []SkillGroupCondition, candidate skillGroupMemberCandidate) bool {
+	return joinSkillConditions(nil, conditions, candidate)
+}
+
+// joinSkillConditions joins each condition to the result of the expression before it with the condition's operation
+func joinSkillConditions(previous *bool, conditions []SkillGroupCondition, candidate skillGroupMemberCandidate) bool {
+	result := previous
+	for _, condition := range conditions {
+		matched, ok := skillConditionMatches(condition, candidate)
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = &matched
+			continue
+		}
+		joined := joinSkillConditionResults(*result, matched, condition.Operation)
+		result = &joined
+	}
+	if result == nil {
+		return true
+	}
+	return *result
+}
+
+// skillConditionMatches joins the routing and language skill conditions of a condition with its operation. The second
+// return value is false when the condition has no skill conditions.
+func skillConditionMatches(condition SkillGroupCondition, candidate skillGroupMemberCandidate) (bool, bool) {
+	var results []bool
+	for _, routingCondition := range condition.RoutingSkillConditions {
+		proficiency, hasSkill := candidate.routingSkills[routingCondition.RoutingSkill]
+		matched := hasSkill && compareSkillProficiency(proficiency, routingCondition.Comparator, routingCondition.Proficiency)
+		results = append(results, joinSkillConditions(&matched, routingCondition.ChildConditions, candidate))
+	}
+	for _, languageCondition := range condition.LanguageSkillConditions {
+		proficiency, hasSkill := candidate.languageSkills[languageCondition.LanguageSkill]
+		matched := hasSkill && compareSkillProficiency(proficiency, languageCondition.Comparator, languageCondition.Proficiency)
+		results = append(results, joinSkillConditions(&matched, languageCondition.ChildConditions, candidate))
+	}
+	if len(results) == 0 {
+		return false, false
+	}
+
+	result := results[0]
+	for _, matched := range results[1:] {
+		result = joinSkillConditionResults(result, matched, condition.Operation)
+	}
+	return result, true
+}
+
+func joinSkillConditionResults(left bool, right bool, operation string) bool {
+	if operation == "Or" {
+		return left || right
+	}
+	return left && right
+}
+
+func compareSkillProficiency(proficiency float64, comparator string, target int) bool {
+	value := float64(target)
+	switch comparator {
+	case "GreaterThan":
+		return proficiency > value
+	case "LessThan":
+		return proficiency < value
+	case "EqualTo":
+		return proficiency == value
+	case "GreaterThanOrEqualTo":
+		return proficiency >= value
+	case "LessThanOrEqualTo":
+		return proficiency <= value
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceRoutingSkillGroupMembersPreview(t *testing.T) {
+	t.Parallel()
+	var (
+		previewDataSource      = "preview"
+		routingSkillResourceId = "routing_skill"
+		routingSkillName       = "Terraform Skill " + uuid.NewString()
+		user1ResourceId        = "user_1"
+		user2ResourceId        = "user_2"
+		user1Email             = "terraform-" + uuid.NewString() + "@example.com"
+		user2Email             = "terraform-" + uuid.NewString() + "@example.com"
+	)
+
+	usersConfig := GenerateRoutingSkillResource(routingSkillResourceId, routingSkillName) +
+		generateUserWithRoutingSkill(user1ResourceId, user1Email, routingSkillResourceId, "4") +
+		generateUserWithRoutingSkill(user2ResourceId, user2Email, routingSkillResourceId, "1")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: usersConfig + generateRoutingSkillGroupMembersPreviewDataSource(
+					previewDataSource,
+					routingSkillName,
+					"GreaterThan",
+					"2",
+					"genesyscloud_user."+user1ResourceId+", genesyscloud_user."+user2ResourceId,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_routing_skill_group_members_preview."+previewDataSource, "member_count", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.genesyscloud_routing_skill_group_members_preview."+previewDataSource, "member_ids.*", "genesyscloud_user."+user1ResourceId, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSkillConditionsMatch(t *testing.T) {
+	candidate := skillGroupMemberCandidate{
+		routingSkills:  map[string]float64{"Billing": 4, "Sales": 1.5},
+		languageSkills: map[string]float64{"French": 3},
+	}
+
+	conditions, err := parseSkillConditions(`[{
+		"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "GreaterThan", "proficiency": 2, "childConditions": [{"routingSkillConditions": [], "languageSkillConditions": [], "operation": "And"}]}],
+		"languageSkillConditions": [],
+		"operation": "And"
+	}]`)
+	assert.Nil(t, err)
+	assert.True(t, skillConditionsMatch(conditions, candidate))
+
+	// Skill conditions of a condition are joined with its operation
+	conditions, err = parseSkillConditions(`[{
+		"routingSkillConditions": [{"routingSkill": "Sales", "comparator": "GreaterThanOrEqualTo", "proficiency": 2, "childConditions": []}],
+		"languageSkillConditions": [{"languageSkill": "French", "comparator": "EqualTo", "proficiency": 3, "childConditions": []}],
+		"operation": "Or"
+	}]`)
+	assert.Nil(t, err)
+	assert.True(t, skillConditionsMatch(conditions, candidate))
+	conditions[0].Operation = "And"
+	assert.False(t, skillConditionsMatch(conditions, candidate))
+
+	// Child conditions are joined to their skill condition with their operation
+	conditions, err = parseSkillConditions(`[{
+		"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "LessThan", "proficiency": 2, "childConditions": [{
+			"routingSkillConditions": [{"routingSkill": "Support", "comparator": "GreaterThan", "proficiency": 0, "childConditions": []}],
+			"languageSkillConditions": [{"languageSkill": "French", "comparator": "LessThanOrEqualTo", "proficiency": 3, "childConditions": []}],
+			"operation": "Or"
+		}]}],
+		"languageSkillConditions": [],
+		"operation": "And"
+	}]`)
+	assert.Nil(t, err)
+	assert.True(t, skillConditionsMatch(conditions, candidate))
+
+	// Users without the skill never match it
+	conditions, err = parseSkillConditions(`[{
+		"routingSkillConditions": [{"routingSkill": "Support", "comparator": "LessThan", "proficiency": 5, "childConditions": []}],
+		"languageSkillConditions": [],
+		"operation": "And"
+	}]`)
+	assert.Nil(t, err)
+	assert.False(t, skillConditionsMatch(conditions, candidate))
+}
+
+func TestUnitValidateSkillConditions(t *testing.T) {
+	validConditions := `[{"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "GreaterThan", "proficiency": 2, "childConditions": []}], "languageSkillConditions": [], "operation": "And"}]`
+	_, errs := validateSkillConditions(validConditions, "skill_conditions")
+	assert.Empty(t, errs)
+
+	invalidConditions := map[string]string{
+		"not an array":         `{"operation": "And"}`,
+		"unknown attribute":    `[{"routingSkillConditions": [], "languageSkillConditions": [], "operation": "And", "not": true}]`,
+		"unknown operation":    `[{"routingSkillConditions": [], "languageSkillConditions": [], "operation": "Xor"}]`,
+		"unknown comparator":   `[{"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "Is", "proficiency": 2}], "operation": "And"}]`,
+		"proficiency too high": `[{"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "EqualTo", "proficiency": 6}], "operation": "And"}]`,
+		"missing language":     `[{"languageSkillConditions": [{"comparator": "EqualTo", "proficiency": 2}], "operation": "And"}]`,
+		"invalid child":        `[{"routingSkillConditions": [{"routingSkill": "Billing", "comparator": "EqualTo", "proficiency": 2, "childConditions": [{"operation": "Maybe"}]}], "operation": "And"}]`,
+	}
+	for name, conditions := range invalidConditions {
+		_, errs := validateSkillConditions(conditions, "skill_conditions")
+		assert.NotEmpty(t, errs, name)
+	}
+}
+
+func generateUserWithRoutingSkill(resourceId string, email string, skillResourceId string, proficiency string) string {
+	return fmt.Sprintf(`resource "genesyscloud_user" "%s" {
+	name  = "Terraform Skill Group Preview"
+	email = "%s"
+	routing_skills {
+		skill_id    = genesyscloud_routing_skill.%s.id
+		proficiency = %s
+	}
+}
+`, resourceId, email, skillResourceId, proficiency)
+}
+
+func generateRoutingSkillGroupMembersPreviewDataSource(resourceId string, skillName string, comparator string, proficiency string, dependsOn string) string {
+	return fmt.Sprintf(`data "genesyscloud_routing_skill_group_members_preview" "%s" {
+	skill_conditions = jsonencode([{
+		"routingSkillConditions" : [{
+			"routingSkill" : "%s",
+			"comparator" : "%s",
+			"proficiency" : %s,
+			"childConditions" : []
+		}],
+		"languageSkillConditions" : [],
+		"operation" : "And"
+	}])
+	depends_on = [%s]
+}
+`, resourceId, skillName, comparator, proficiency, dependsOn)
+}
@@ -47,6 +47,7 @@ func OutboundDncListExporter() *resourceExporter.ResourceExporter {
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"division_id": {RefType: "genesyscloud_auth_division"},
 		},
+		ExcludedAttributes: []string{"entry_count"},
 	}
 }
 
@@ -113,13 +114,14 @@ func ResourceOutboundDncList() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{`rds`, `dnc.com`, `gryphon`}, false),
 			},
 			`entries`: {
-				Description: `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
-				Optional:    true,
-				Type:        schema.TypeList,
+				Description:   `Phone numbers and email addresses of the DNC list. Values removed from the entries are removed from the DNC list. Only possible if the dncSourceType is rds.`,
+				Optional:      true,
+				Type:          schema.TypeList,
+				ConflictsWith: []string{"entries_filepath"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						`expiration_date`: {
-							Description:      `Expiration date for DNC phone numbers in yyyy-MM-ddTHH:mmZ format.`,
+							Description:      `Expiration date for DNC phone numbers and email addresses in yyyy-MM-ddTHH:mmZ format.`,
 							Optional:         true,
 							Type:             schema.TypeString,
 							ValidateDiagFunc: gcloud.ValidateDateTime,
@@ -133,9 +135,32 @@ func ResourceOutboundDncList() *schema.Resource {
 								ValidateDiagFunc: gcloud.ValidatePhoneNumber,
 							},
 						},
+						`email_addresses`: {
+							Description: `Email addresses to add to a DNC list. Only possible if the dncSourceType is rds and the contactMethod is Email.`,
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
+			`entries_filepath`: {
+				Description:   `Path or URL of a CSV file containing the entries of the DNC list. The header row must contain a phone_number or email_address column and may contain an expiration_date column in yyyy-MM-ddTHH:mmZ format. When the file changes, the entries of the DNC list are replaced by those in the file. Only possible if the dncSourceType is rds.`,
+				Optional:      true,
+				Type:          schema.TypeString,
+				ValidateFunc:  gcloud.ValidatePath,
+				ConflictsWith: []string{"entries"},
+			},
+			`entries_file_content_hash`: {
+				Description: `Hash value of the entries CSV file content. Used to detect changes and load the entries again.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`entry_count`: {
+				Description: `The number of phone numbers or email addresses in the DNC list. Can be used to detect entries added or removed outside of Terraform.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
 		},
 	}
 }
@@ -148,7 +173,6 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	licenseId := d.Get("license_id").(string)
 	dncSourceType := d.Get("dnc_source_type").(string)
 	dncCodes := lists.InterfaceListToStrings(d.Get("dnc_codes").([]interface{}))
-	entries := d.Get("entries").([]interface{})
 
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
@@ -185,17 +209,8 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(*outboundDncList.Id)
 
-	if len(entries) > 0 {
-		if *sdkDncListCreate.DncSourceType == "rds" {
-			for _, entry := range entries {
-				_, err := uploadPhoneEntriesToDncList(outboundApi, outboundDncList, entry)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			return diag.Errorf("Phone numbers can only be uploaded to internal DNC lists.")
-		}
+	if diagErr := applyOutboundDncListEntries(d, outboundApi); diagErr != nil {
+		return diagErr
 	}
 
 	log.Printf("Created Outbound DNC list %s %s", name, *outboundDncList.Id)
@@ -210,7 +225,6 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	dncCodes := lists.InterfaceListToStrings(d.Get("dnc_codes").([]interface{}))
 	licenseId := d.Get("license_id").(string)
 	dncSourceType := d.Get("dnc_source_type").(string)
-	entries := d.Get("entries").([]interface{})
 
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
@@ -246,28 +260,20 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 			return resp, diag.Errorf("Failed to read Outbound DNC list %s: %s", d.Id(), getErr)
 		}
 		sdkDncList.Version = outboundDncList.Version
-		outboundDncList, _, updateErr := outboundApi.PutOutboundDnclist(d.Id(), sdkDncList)
+		_, _, updateErr := outboundApi.PutOutboundDnclist(d.Id(), sdkDncList)
 		if updateErr != nil {
 			return resp, diag.Errorf("Failed to update Outbound DNC list %s: %s", name, updateErr)
 		}
-		if len(entries) > 0 {
-			if *sdkDncList.DncSourceType == "rds" {
-				for _, entry := range entries {
-					response, err := uploadPhoneEntriesToDncList(outboundApi, outboundDncList, entry)
-					if err != nil {
-						return response, err
-					}
-				}
-			} else {
-				return nil, diag.Errorf("Phone numbers can only be uploaded to internal DNC lists.")
-			}
-		}
 		return nil, nil
 	})
 	if diagErr != nil {
 		return diagErr
 	}
 
+	if diagErr := applyOutboundDncListEntries(d, outboundApi); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Updated Outbound DNC list %s", name)
 	return readOutboundDncList(ctx, d, meta)
 }
@@ -279,7 +285,7 @@ func readOutboundDncList(ctx context.Context, d *schema.ResourceData, meta inter
 	log.Printf("Reading Outbound DNC list %s", d.Id())
 
 	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		sdkDncList, resp, getErr := outboundApi.GetOutboundDnclist(d.Id(), false, false)
+		sdkDncList, resp, getErr := outboundApi.GetOutboundDnclist(d.Id(), false, true)
 		if getErr != nil {
 			if gcloud.IsStatus404(resp) {
 				return retry.RetryableError(fmt.Errorf("failed to read Outbound DNC list %s: %s", d.Id(), getErr))
@@ -319,6 +325,9 @@ func readOutboundDncList(ctx context.Context, d *schema.ResourceData, meta inter
 		if sdkDncList.Division != nil && sdkDncList.Division.Id != nil {
 			_ = d.Set("division_id", *sdkDncList.Division.Id)
 		}
+		if sdkDncList.Size != nil {
+			_ = d.Set("entry_count", *sdkDncList.Size)
+		}
 		log.Printf("Read Outbound DNC list %s %s", d.Id(), *sdkDncList.Name)
 		return cc.CheckState()
 	})
@@ -355,25 +364,6 @@ func deleteOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	})
 }
 
-func uploadPhoneEntriesToDncList(api *platformclientv2.OutboundApi, dncList *platformclientv2.Dnclist, entry interface{}) (*platformclientv2.APIResponse, diag.Diagnostics) {
-	var phoneNumbers []string
-	if entryMap, ok := entry.(map[string]interface{}); ok && len(entryMap) > 0 {
-		if phoneNumbersList := entryMap["phone_numbers"].([]interface{}); phoneNumbersList != nil {
-			for _, number := range phoneNumbersList {
-				phoneNumbers = append(phoneNumbers, number.(string))
-			}
-		}
-		log.Printf("Uploading phone numbers to DNC list %s", *dncList.Name)
-		// POST /api/v2/outbound/dnclists/{dncListId}/phonenumbers
-		response, err := api.PostOutboundDnclistPhonenumbers(*dncList.Id, phoneNumbers, entryMap["expiration_date"].(string))
-		if err != nil {
-			return response, diag.Errorf("Failed to upload phone numbers to Outbound DNC list %s: %s", *dncList.Name, err)
-		}
-		log.Printf("Uploaded phone numbers to DNC list %s", *dncList.Name)
-	}
-	return nil, nil
-}
-
 func GenerateOutboundDncListBasic(resourceId string, name string) string {
 	return fmt.Sprintf(`
 resource "genesyscloud_outbound_dnclist" "%s" {
@@ -0,0 +1,320 @@
+package outbound
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	files "terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+This file contains the logic used to manage the phone numbers and email addresses of an internal DNC list. Entries come
+either from the entries blocks or from a CSV file. Entries set in blocks are compared with the previous configuration
+so that only added, changed and removed values are sent. A changed CSV file replaces every entry of the list, since the
+previous contents of the file are not kept in the state. Values are added and removed with the DNC PATCH endpoints in
+chunks.
+*/
+
+const (
+	dncPatchActionAdd    = "Add"
+	dncPatchActionRemove = "Remove"
+
+	// Maximum number of values accepted by a single DNC PATCH request
+	dncPatchChunkSize = 1000
+
+	dncEntriesPhoneNumberColumn    = "phone_number"
+	dncEntriesEmailAddressColumn   = "email_address"
+	dncEntriesExpirationDateColumn = "expiration_date"
+)
+
+// dncListEntries maps each phone number and email address of a DNC list to its expiration date. An empty expiration
+// date means the entry does not expire.
+type dncListEntries struct {
+	phoneNumbers   map[string]string
+	emailAddresses map[string]string
+}
+
+func newDncListEntries() dncListEntries {
+	return dncListEntries{
+		phoneNumbers:   make(map[string]string),
+		emailAddresses: make(map[string]string),
+	}
+}
+
+func (e dncListEntries) size() int {
+	return len(e.phoneNumbers) + len(e.emailAddresses)
+}
+
+// applyOutboundDncListEntries adds and removes entries of the DNC list so that it matches the configuration
+func applyOutboundDncListEntries(d *schema.ResourceData, outboundApi *platformclientv2.OutboundApi) diag.Diagnostics {
+	fileChanged := d.HasChanges("entries_filepath", "entries_file_content_hash")
+	if !d.IsNewResource() && !fileChanged && !d.HasChange("entries") {
+		return nil
+	}
+
+	desired, err := getDesiredDncListEntries(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("dnc_source_type").(string) != "rds" {
+		if desired.size() > 0 {
+			return diag.Errorf("Phone numbers and email addresses can only be added to internal DNC lists.")
+		}
+		return nil
+	}
+
+	var previous dncListEntries
+	if fileChanged && !d.IsNewResource() {
+		// The previous entries of the file are unknown, so the list is cleared before the file is loaded
+		if diagErr := clearOutboundDncList(outboundApi, d.Id()); diagErr != nil {
+			return diagErr
+		}
+		previous = newDncListEntries()
+	} else {
+		oldEntries, _ := d.GetChange("entries")
+		previous = flattenDncListEntriesBlocks(oldEntries.([]interface{}))
+	}
+
+	phoneNumberAdds, phoneNumberRemoves := diffDncListEntries(previous.phoneNumbers, desired.phoneNumbers)
+	emailAddressAdds, emailAddressRemoves := diffDncListEntries(previous.emailAddresses, desired.emailAddresses)
+	log.Printf("DNC list %s has %d entries to add and %d entries to remove", d.Id(), countDncListAdds(phoneNumberAdds)+countDncListAdds(emailAddressAdds), len(phoneNumberRemoves)+len(emailAddressRemoves))
+
+	if diagErr := patchDncListValues(d.Id(), dncPatchActionRemove, phoneNumberRemoves, "", patchDncListPhoneNumbers(outboundApi)); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := patchDncListValues(d.Id(), dncPatchActionRemove, emailAddressRemoves, "", patchDncListEmailAddresses(outboundApi)); diagErr != nil {
+		return diagErr
+	}
+	for _, expirationDate := range sortedExpirationDates(phoneNumberAdds) {
+		if diagErr := patchDncListValues(d.Id(), dncPatchActionAdd, phoneNumberAdds[expirationDate], expirationDate, patchDncListPhoneNumbers(outboundApi)); diagErr != nil {
+			return diagErr
+		}
+	}
+	for _, expirationDate := range sortedExpirationDates(emailAddressAdds) {
+		if diagErr := patchDncListValues(d.Id(), dncPatchActionAdd, emailAddressAdds[expirationDate], expirationDate, patchDncListEmailAddresses(outboundApi)); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+// getDesiredDncListEntries returns the entries configured in the entries_filepath file or, if no file is set, in the
+// entries blocks
+func getDesiredDncListEntries(d *schema.ResourceData) (dncListEntries, error) {
+	entriesFilepath := d.Get("entries_filepath").(string)
+	if entriesFilepath == "" {
+		return flattenDncListEntriesBlocks(d.Get("entries").([]interface{})), nil
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(entriesFilepath)
+	if err != nil {
+		return dncListEntries{}, fmt.Errorf("failed to open entries file %s: %s", entriesFilepath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	entries, err := parseDncListEntriesCsv(reader)
+	if err != nil {
+		return dncListEntries{}, fmt.Errorf("invalid entries file %s: %s", entriesFilepath, err)
+	}
+	return entries, nil
+}
+
+// flattenDncListEntriesBlocks merges the entries blocks into a single set of entries. If a value is listed in more than
+// one block, the expiration date of the last block is used.
+func flattenDncListEntriesBlocks(entriesBlocks []interface{}) dncListEntries {
+	entries := newDncListEntries()
+	for _, entryBlock := range entriesBlocks {
+		entryMap, ok := entryBlock.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		expirationDate, _ := entryMap["expiration_date"].(string)
+		if phoneNumbers, ok := entryMap["phone_numbers"].([]interface{}); ok {
+			for _, phoneNumber := range phoneNumbers {
+				entries.phoneNumbers[phoneNumber.(string)] = expirationDate
+			}
+		}
+		if emailAddresses, ok := entryMap["email_addresses"].([]interface{}); ok {
+			for _, emailAddress := range emailAddresses {
+				entries.emailAddresses[emailAddress.(string)] = expirationDate
+			}
+		}
+	}
+	return entries
+}
+
+// parseDncListEntriesCsv reads a CSV file with a phone_number and/or email_address column and an optional
+// expiration_date column. Each row must set exactly one phone number or email address.
+func parseDncListEntriesCsv(reader io.Reader) (dncListEntries, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return dncListEntries{}, fmt.Errorf("file is empty")
+		}
+		return dncListEntries{}, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	phoneNumberIndex, hasPhoneNumbers := columns[dncEntriesPhoneNumberColumn]
+	emailAddressIndex, hasEmailAddresses := columns[dncEntriesEmailAddressColumn]
+	expirationDateIndex, hasExpirationDates := columns[dncEntriesExpirationDateColumn]
+	if !hasPhoneNumbers && !hasEmailAddresses {
+		return dncListEntries{}, fmt.Errorf("header must contain a %s or %s column", dncEntriesPhoneNumberColumn, dncEntriesEmailAddressColumn)
+	}
+
+	field := func(record []string, index int, present bool) string {
+		if !present || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	entries := newDncListEntries()
+	for rowNum := 2; ; rowNum++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dncListEntries{}, err
+		}
+
+		phoneNumber := field(record, phoneNumberIndex, hasPhoneNumbers)
+		emailAddress := field(record, emailAddressIndex, hasEmailAddresses)
+		expirationDate := field(record, expirationDateIndex, hasExpirationDates)
+
+		switch {
+		case phoneNumber != "" && emailAddress != "":
+			return dncListEntries{}, fmt.Errorf("row %d sets both a phone number and an email address", rowNum)
+		case phoneNumber != "":
+			entries.phoneNumbers[phoneNumber] = expirationDate
+		case emailAddress != "":
+			entries.emailAddresses[emailAddress] = expirationDate
+		default:
+			return dncListEntries{}, fmt.Errorf("row %d has no phone number or email address", rowNum)
+		}
+	}
+	return entries, nil
+}
+
+// diffDncListEntries returns the values to add, grouped by expiration date, and the values to remove. A value whose
+// expiration date changed is added again so that the new date is applied.
+func diffDncListEntries(previous, desired map[string]string) (map[string][]string, []string) {
+	adds := make(map[string][]string)
+	for value, expirationDate := range desired {
+		if previousExpirationDate, ok := previous[value]; ok && previousExpirationDate == expirationDate {
+			continue
+		}
+		adds[expirationDate] = append(adds[expirationDate], value)
+	}
+	for expirationDate := range adds {
+		sort.Strings(adds[expirationDate])
+	}
+
+	var removes []string
+	for value := range previous {
+		if _, ok := desired[value]; !ok {
+			removes = append(removes, value)
+		}
+	}
+	sort.Strings(removes)
+	return adds, removes
+}
+
+func countDncListAdds(adds map[string][]string) int {
+	count := 0
+	for _, values := range adds {
+		count += len(values)
+	}
+	return count
+}
+
+func sortedExpirationDates(adds map[string][]string) []string {
+	expirationDates := make([]string, 0, len(adds))
+	for expirationDate := range adds {
+		expirationDates = append(expirationDates, expirationDate)
+	}
+	sort.Strings(expirationDates)
+	return expirationDates
+}
+
+type dncListPatchFunc func(dncListId, action string, values []string, expirationDate string) error
+
+func patchDncListPhoneNumbers(outboundApi *platformclientv2.OutboundApi) dncListPatchFunc {
+	return func(dncListId, action string, values []string, expirationDate string) error {
+		body := platformclientv2.Dncpatchphonenumbersrequest{
+			Action:       &action,
+			PhoneNumbers: &values,
+		}
+		if expirationDate != "" {
+			body.ExpirationDateTime = &expirationDate
+		}
+		_, err := outboundApi.PatchOutboundDnclistPhonenumbers(dncListId, body)
+		return err
+	}
+}
+
+func patchDncListEmailAddresses(outboundApi *platformclientv2.OutboundApi) dncListPatchFunc {
+	return func(dncListId, action string, values []string, expirationDate string) error {
+		body := platformclientv2.Dncpatchemailsrequest{
+			Action:         &action,
+			EmailAddresses: &values,
+		}
+		if expirationDate != "" {
+			body.ExpirationDateTime = &expirationDate
+		}
+		_, err := outboundApi.PatchOutboundDnclistEmailaddresses(dncListId, body)
+		return err
+	}
+}
+
+// patchDncListValues sends the values to the DNC list in chunks of the maximum size accepted by the PATCH endpoints
+func patchDncListValues(dncListId, action string, values []string, expirationDate string, patch dncListPatchFunc) diag.Diagnostics {
+	if len(values) == 0 {
+		return nil
+	}
+	return chunks.ProcessChunks(chunks.ChunkBy(values, dncPatchChunkSize), func(chunk []string) diag.Diagnostics {
+		log.Printf("Sending %s of %d values to DNC list %s", action, len(chunk), dncListId)
+		if err := patch(dncListId, action, chunk, expirationDate); err != nil {
+			return diag.Errorf("Failed to %s %d values of DNC list %s: %s", strings.ToLower(action), len(chunk), dncListId, err)
+		}
+		return nil
+	})
+}
+
+// clearOutboundDncList deletes every phone number and email address of the DNC list
+func clearOutboundDncList(outboundApi *platformclientv2.OutboundApi, dncListId string) diag.Diagnostics {
+	log.Printf("Clearing entries of DNC list %s", dncListId)
+	dncList, _, err := outboundApi.GetOutboundDnclist(dncListId, false, false)
+	if err != nil {
+		return diag.Errorf("Failed to read Outbound DNC list %s: %s", dncListId, err)
+	}
+	if dncList.ContactMethod != nil && *dncList.ContactMethod == "Email" {
+		if _, err := outboundApi.DeleteOutboundDnclistEmailaddresses(dncListId, false); err != nil {
+			return diag.Errorf("Failed to clear email addresses of Outbound DNC list %s: %s", dncListId, err)
+		}
+		return nil
+	}
+	if _, err := outboundApi.DeleteOutboundDnclistPhonenumbers(dncListId, false); err != nil {
+		return diag.Errorf("Failed to clear phone numbers of Outbound DNC list %s: %s", dncListId, err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package outbound
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -74,7 +75,8 @@ func TestAccResourceOutboundDncListRdsListType(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "dnc_source_type", dncSourceType),
 					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "contact_method", contactMethod),
 					gcloud.TestDefaultHomeDivision("genesyscloud_outbound_dnclist."+resourceID),
-					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 3),
+					// The number from the first step is removed since it is no longer in the entries
+					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 2),
 				),
 			},
 			{
@@ -105,8 +107,9 @@ func TestAccResourceOutboundDncListRdsListType(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "dnc_source_type", dncSourceType),
 					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "contact_method", contactMethod),
 					gcloud.TestDefaultHomeDivision("genesyscloud_outbound_dnclist."+resourceID),
-					// Expect two more to be added due to duplicate numbers
-					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 5),
+					// Duplicate numbers across entries are only added once
+					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 4),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "entry_count", "4"),
 				),
 			},
 			{
@@ -347,3 +350,84 @@ resource "genesyscloud_outbound_dnclist" "%s" {
 }
 `, resourceId, name, dncSourceType, contactMethod, loginId, licenseId, campaignId, strings.Join(dncCodes, ", "), strings.Join(nestedBlocks, "\n"))
 }
+
+func TestUnitParseDncListEntriesCsv(t *testing.T) {
+	entries, err := parseDncListEntriesCsv(strings.NewReader(`phone_number,email_address,expiration_date
++353112222222,,
++353221111111,,2030-01-01T00:00Z
+,someone@example.com,
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedPhoneNumbers := map[string]string{"+353112222222": "", "+353221111111": "2030-01-01T00:00Z"}
+	if !reflect.DeepEqual(entries.phoneNumbers, expectedPhoneNumbers) {
+		t.Errorf("Expected phone numbers %v, got %v", expectedPhoneNumbers, entries.phoneNumbers)
+	}
+	expectedEmailAddresses := map[string]string{"someone@example.com": ""}
+	if !reflect.DeepEqual(entries.emailAddresses, expectedEmailAddresses) {
+		t.Errorf("Expected email addresses %v, got %v", expectedEmailAddresses, entries.emailAddresses)
+	}
+
+	invalidFiles := map[string]string{
+		"empty file":       ``,
+		"no value column":  "expiration_date\n2030-01-01T00:00Z\n",
+		"row without data": "phone_number,expiration_date\n,2030-01-01T00:00Z\n",
+		"row with both":    "phone_number,email_address\n+353112222222,someone@example.com\n",
+	}
+	for name, content := range invalidFiles {
+		if _, err := parseDncListEntriesCsv(strings.NewReader(content)); err == nil {
+			t.Errorf("Expected an error for %s", name)
+		}
+	}
+}
+
+func TestUnitDiffDncListEntries(t *testing.T) {
+	previous := map[string]string{
+		"+353112222222": "",
+		"+353221111111": "",
+		"+353747474747": "2030-01-01T00:00Z",
+	}
+	desired := map[string]string{
+		"+353112222222": "",
+		"+353221111111": "2031-01-01T00:00Z",
+		"+353808080808": "",
+		"+353232323232": "",
+	}
+
+	adds, removes := diffDncListEntries(previous, desired)
+
+	expectedAdds := map[string][]string{
+		"":                  {"+353232323232", "+353808080808"},
+		"2031-01-01T00:00Z": {"+353221111111"},
+	}
+	if !reflect.DeepEqual(adds, expectedAdds) {
+		t.Errorf("Expected adds %v, got %v", expectedAdds, adds)
+	}
+	if !reflect.DeepEqual(removes, []string{"+353747474747"}) {
+		t.Errorf("Expected removes [+353747474747], got %v", removes)
+	}
+}
+
+func TestUnitFlattenDncListEntriesBlocks(t *testing.T) {
+	entries := flattenDncListEntriesBlocks([]interface{}{
+		map[string]interface{}{
+			"expiration_date": "",
+			"phone_numbers":   []interface{}{"+353112222222", "+353221111111"},
+			"email_addresses": []interface{}{},
+		},
+		map[string]interface{}{
+			"expiration_date": "2030-01-01T00:00Z",
+			"phone_numbers":   []interface{}{"+353112222222"},
+			"email_addresses": []interface{}{"someone@example.com"},
+		},
+	})
+
+	expectedPhoneNumbers := map[string]string{"+353112222222": "2030-01-01T00:00Z", "+353221111111": ""}
+	if !reflect.DeepEqual(entries.phoneNumbers, expectedPhoneNumbers) {
+		t.Errorf("Expected phone numbers %v, got %v", expectedPhoneNumbers, entries.phoneNumbers)
+	}
+	if entries.size() != 3 {
+		t.Errorf("Expected 3 entries, got %d", entries.size())
+	}
+}
@@ -7,6 +7,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/campaignlifecycle"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 	"time"
 
@@ -80,11 +81,11 @@ func createOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(*outboundCampaign.Id)
 
 	// Campaigns can be enabled after creation
-	if campaignStatus == "on" {
+	if campaignStatus == campaignlifecycle.StatusOn {
 		d.Set("campaign_status", campaignStatus)
-		diag := updateOutboundCampaignStatus(ctx, d.Id(), proxy, *outboundCampaign, campaignStatus)
-		if diag != nil {
-			return diag
+		diagErr := campaignlifecycle.StartCampaign(ctx, newCampaignLifecycleClient(proxy), d.Id(), d.Timeout(schema.TimeoutCreate))
+		if diagErr != nil {
+			return diagErr
 		}
 	}
 
@@ -154,24 +155,44 @@ func readOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta inte
 	})
 }
 
-// updateOutboundCampaign is used by the outbound_campaign resource to update an outbound campaign in Genesys Cloud
+// updateOutboundCampaign is used by the outbound_campaign resource to update an outbound campaign in Genesys Cloud.
+// A running campaign cannot be changed, so it is stopped and drained first and the requested status is restored afterwards.
 func updateOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	proxy := getOutboundCampaignProxy(clientConfig)
+	lifecycleClient := newCampaignLifecycleClient(proxy)
 	campaignStatus := d.Get("campaign_status").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
 	campaign := getOutboundCampaignFromResourceData(d)
 
-	log.Printf("Updating Outbound Campaign %s", *campaign.Name)
-	campaignSdk, err := proxy.updateOutboundCampaign(ctx, d.Id(), &campaign)
-	if err != nil {
-		return diag.Errorf("Failed to update campaign %s", err)
+	if d.HasChangesExcept("campaign_status") {
+		wasOn, diagErr := campaignlifecycle.StopCampaign(ctx, lifecycleClient, d.Id(), timeout)
+		if diagErr != nil {
+			return diagErr
+		}
+
+		log.Printf("Updating Outbound Campaign %s", *campaign.Name)
+		if _, err := proxy.updateOutboundCampaign(ctx, d.Id(), &campaign); err != nil {
+			diagErr := diag.Errorf("Failed to update campaign %s", err)
+			// Restart the campaign so that a failed update does not leave it off
+			if wasOn {
+				diagErr = append(diagErr, campaignlifecycle.StartCampaign(ctx, lifecycleClient, d.Id(), timeout)...)
+			}
+			return diagErr
+		}
 	}
 
-	// Check if Campaign Status needs updated
-	diagErr := updateOutboundCampaignStatus(ctx, d.Id(), proxy, *campaignSdk, campaignStatus)
-	if diagErr != nil {
-		return diagErr
+	// Move the campaign to the requested status
+	switch campaignStatus {
+	case campaignlifecycle.StatusOn:
+		if diagErr := campaignlifecycle.StartCampaign(ctx, lifecycleClient, d.Id(), timeout); diagErr != nil {
+			return diagErr
+		}
+	case campaignlifecycle.StatusOff:
+		if _, diagErr := campaignlifecycle.StopCampaign(ctx, lifecycleClient, d.Id(), timeout); diagErr != nil {
+			return diagErr
+		}
 	}
 
 	log.Printf("Updated Outbound Campaign %s", *campaign.Name)
@@ -183,14 +204,10 @@ func deleteOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta in
 	clientConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	proxy := getOutboundCampaignProxy(clientConfig)
 
-	campaignStatus := d.Get("campaign_status").(string)
-
 	// Campaigns have to be turned off before they can be deleted
-	if campaignStatus != "off" {
-		log.Printf("Turning off Outbound Campaign before deletion")
-		if diagErr := proxy.turnOffCampaign(ctx, d.Id()); diagErr != nil {
-			return diagErr
-		}
+	log.Printf("Turning off Outbound Campaign before deletion")
+	if _, diagErr := campaignlifecycle.StopCampaign(ctx, newCampaignLifecycleClient(proxy), d.Id(), d.Timeout(schema.TimeoutDelete)); diagErr != nil {
+		return diagErr
 	}
 
 	log.Printf("Deleting Outbound Campaign %s", d.Id())
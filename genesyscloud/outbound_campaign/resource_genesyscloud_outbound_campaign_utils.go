@@ -1,13 +1,10 @@
 package outbound_campaign
 
 import (
-	"context"
 	"fmt"
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
-	"log"
 	"strconv"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"terraform-provider-genesyscloud/genesyscloud/outbound"
@@ -76,21 +73,6 @@ func getOutboundCampaignFromResourceData(d *schema.ResourceData) platformclientv
 	return campaign
 }
 
-func updateOutboundCampaignStatus(ctx context.Context, campaignId string, proxy *outboundCampaignProxy, campaign platformclientv2.Campaign, newCampaignStatus string) diag.Diagnostics {
-	if newCampaignStatus != "" &&
-		// Campaign status can only go from ON -> OFF or OFF, COMPLETE, INVALID, ETC -> ON
-		((*campaign.CampaignStatus == "on" && newCampaignStatus == "off") ||
-			(*campaign.CampaignStatus != "on" && newCampaignStatus == "on")) {
-		campaign.CampaignStatus = &newCampaignStatus
-		log.Printf("Updating Outbound Campaign %s status to %s", *campaign.Name, newCampaignStatus)
-		_, err := proxy.updateOutboundCampaign(ctx, campaignId, &campaign)
-		if err != nil {
-			return diag.Errorf("Failed to update Outbound Campaign %s: %s", *campaign.Name, err)
-		}
-	}
-	return nil
-}
-
 func buildPhoneColumns(phonecolumns []interface{}) *[]platformclientv2.Phonecolumn {
 	if phonecolumns == nil || len(phonecolumns) == 0 {
 		return nil
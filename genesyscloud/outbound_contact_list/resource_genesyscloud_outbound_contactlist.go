@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/util/campaignlifecycle"
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
@@ -140,6 +141,10 @@ func ResourceOutboundContactList() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name for the contact list.`,
@@ -208,6 +213,29 @@ func ResourceOutboundContactList() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        outboundContactListColumnDataTypeSpecification,
 			},
+			`contacts_filepath`: {
+				Description:  `Path or URL of a CSV file containing contacts to upload to the contact list. The header row must contain every column in column_names. Rows that cannot be imported are reported as warnings.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: gcloud.ValidatePath,
+			},
+			`contacts_file_content_hash`: {
+				Description: `Hash value of the contacts CSV file content. Used to detect changes and upload the contacts again.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`contacts_id_name`: {
+				Description: `The name of the column that uniquely identifies a contact in the contacts CSV file. Rows with an empty or duplicate value in this column are rejected.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`contacts_upload_mode`: {
+				Description:  `Whether uploaded contacts are appended to the existing contacts or replace them. Valid values: append, replace.`,
+				Optional:     true,
+				Default:      contactsUploadModeAppend,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{contactsUploadModeAppend, contactsUploadModeReplace}, false),
+			},
 		},
 	}
 }
@@ -253,7 +281,12 @@ func createOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(*outboundContactList.Id)
 
 	log.Printf("Created Outbound Contact List %s %s", name, *outboundContactList.Id)
-	return readOutboundContactList(ctx, d, meta)
+
+	diags := uploadOutboundContactListContacts(ctx, d, sdkConfig)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, readOutboundContactList(ctx, d, meta)...)
 }
 
 func updateOutboundContactList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -288,26 +321,50 @@ func updateOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 		sdkContactList.ZipCodeColumnName = &zipCodeColumnName
 	}
 
-	log.Printf("Updating Outbound Contact List %s", name)
-	diagErr := gcloud.RetryWhen(gcloud.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		// Get current Outbound Contact list version
-		outboundContactList, resp, getErr := outboundApi.GetOutboundContactlist(d.Id(), false, false)
-		if getErr != nil {
-			return resp, diag.Errorf("Failed to read Outbound Contact List %s: %s", d.Id(), getErr)
-		}
-		sdkContactList.Version = outboundContactList.Version
-		outboundContactList, _, updateErr := outboundApi.PutOutboundContactlist(d.Id(), sdkContactList)
-		if updateErr != nil {
-			return resp, diag.Errorf("Failed to update Outbound Contact List %s: %s", name, updateErr)
+	apply := func() diag.Diagnostics {
+		log.Printf("Updating Outbound Contact List %s", name)
+		diagErr := gcloud.RetryWhen(gcloud.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			// Get current Outbound Contact list version
+			outboundContactList, resp, getErr := outboundApi.GetOutboundContactlist(d.Id(), false, false)
+			if getErr != nil {
+				return resp, diag.Errorf("Failed to read Outbound Contact List %s: %s", d.Id(), getErr)
+			}
+			sdkContactList.Version = outboundContactList.Version
+			outboundContactList, _, updateErr := outboundApi.PutOutboundContactlist(d.Id(), sdkContactList)
+			if updateErr != nil {
+				return resp, diag.Errorf("Failed to update Outbound Contact List %s: %s", name, updateErr)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return diagErr
 		}
-		return nil, nil
-	})
-	if diagErr != nil {
-		return diagErr
+
+		log.Printf("Updated Outbound Contact List %s", name)
+		return uploadOutboundContactListContacts(ctx, d, sdkConfig)
 	}
 
-	log.Printf("Updated Outbound Contact List %s", name)
-	return readOutboundContactList(ctx, d, meta)
+	diags := updateWithCampaignsStopped(ctx, d, sdkConfig, apply)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, readOutboundContactList(ctx, d, meta)...)
+}
+
+// updateWithCampaignsStopped stops the running campaigns that dial the contact list while apply changes it, and turns
+// them back on afterwards. Appending contacts does not disturb a running campaign, so such updates are applied directly.
+func updateWithCampaignsStopped(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration, apply func() diag.Diagnostics) diag.Diagnostics {
+	replacesContacts := d.Get("contacts_upload_mode").(string) == contactsUploadModeReplace && d.HasChanges("contacts_filepath", "contacts_file_content_hash")
+	if !replacesContacts && !d.HasChangesExcept("contacts_filepath", "contacts_file_content_hash", "contacts_id_name", "contacts_upload_mode") {
+		return apply()
+	}
+
+	client := campaignlifecycle.NewCampaignClient(sdkConfig)
+	campaignIds, err := campaignlifecycle.GetCampaignIdsByContactList(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to get the campaigns of Outbound Contact List %s: %s", d.Id(), err)
+	}
+	return campaignlifecycle.WithCampaignsStopped(ctx, client, campaignIds, d.Timeout(schema.TimeoutUpdate), apply)
 }
 
 func readOutboundContactList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,374 @@
+package outbound_contact_list
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	files "terraform-provider-genesyscloud/genesyscloud/util/files"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+This file contains the logic used to load contacts into a contact list from a CSV file. The CSV is validated locally
+first: rows with the wrong number of fields, an empty key column or a duplicate key are rejected and reported as
+warnings. The remaining rows are uploaded through the contact list upload endpoint and the import job is polled until
+it completes.
+*/
+
+const (
+	contactsUploadModeAppend  = "append"
+	contactsUploadModeReplace = "replace"
+
+	// Only the first rejections are reported individually so that a badly formatted file does not flood the output
+	maxReportedContactRejections = 20
+)
+
+// importStatusPollInterval is how often the import status is read while waiting for the import of an upload to start
+var importStatusPollInterval = time.Second
+
+type contactRowRejection struct {
+	row    int
+	reason string
+}
+
+// contactsCsv holds the rows of a contacts CSV file that passed validation
+type contactsCsv struct {
+	content    []byte
+	rows       int
+	rejections []contactRowRejection
+}
+
+// uploadOutboundContactListContacts uploads the contacts file if one is configured and it changed since the last apply
+func uploadOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	contactsFilepath := d.Get("contacts_filepath").(string)
+	if contactsFilepath == "" {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChanges("contacts_filepath", "contacts_file_content_hash") {
+		return nil
+	}
+
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+	columnNames := lists.InterfaceListToStrings(d.Get("column_names").([]interface{}))
+	contactsIdName := d.Get("contacts_id_name").(string)
+
+	reader, file, err := files.DownloadOrOpenFile(contactsFilepath)
+	if err != nil {
+		return diag.Errorf("Failed to open contacts file %s: %s", contactsFilepath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	contacts, err := parseContactsCsv(reader, columnNames, contactsIdName)
+	if err != nil {
+		return diag.Errorf("Invalid contacts file %s: %s", contactsFilepath, err)
+	}
+	diags := contactRejectionsToDiagnostics(contactsFilepath, contacts.rejections)
+
+	if d.Get("contacts_upload_mode").(string) == contactsUploadModeReplace && !d.IsNewResource() {
+		log.Printf("Clearing contacts of Outbound Contact List %s before upload", d.Id())
+		if _, err := outboundApi.PostOutboundContactlistClear(d.Id()); err != nil {
+			return append(diags, diag.Errorf("Failed to clear contacts of Outbound Contact List %s: %s", d.Id(), err)...)
+		}
+	}
+
+	if contacts.rows == 0 {
+		log.Printf("No valid contacts to upload to Outbound Contact List %s", d.Id())
+		return diags
+	}
+
+	// The import status endpoint keeps reporting the last import, so remember it to recognise the import of this upload
+	previousImport, err := getContactListImportStatus(outboundApi, d.Id())
+	if err != nil {
+		return append(diags, diag.Errorf("Failed to read import status of Outbound Contact List %s: %s", d.Id(), err)...)
+	}
+
+	log.Printf("Uploading %d contacts to Outbound Contact List %s", contacts.rows, d.Id())
+	if err := postContactsCsv(sdkConfig, d.Id(), contactsIdName, contacts.content); err != nil {
+		return append(diags, diag.Errorf("Failed to upload contacts to Outbound Contact List %s: %s", d.Id(), err)...)
+	}
+
+	return append(diags, waitForContactListImport(ctx, d, outboundApi, previousImport)...)
+}
+
+// postContactsCsv sends the validated CSV to the contact list upload endpoint as a multipart file
+func postContactsCsv(sdkConfig *platformclientv2.Configuration, contactListId, contactsIdName string, content []byte) error {
+	tempFile, err := os.CreateTemp("", "contacts-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(content); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		tempFile.Close()
+		return err
+	}
+
+	formData := make(map[string]io.Reader, 0)
+	formData["file"] = tempFile
+	formData["id"] = strings.NewReader(contactListId)
+	formData["fileType"] = strings.NewReader("contactlist")
+	if contactsIdName != "" {
+		formData["contact-id-name"] = strings.NewReader(contactsIdName)
+	}
+
+	headers := make(map[string]string, 0)
+	headers["Authorization"] = "Bearer " + sdkConfig.AccessToken
+
+	basePath := strings.Replace(sdkConfig.BasePath, "api", "apps", -1)
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, "POST", basePath+"/uploads/v2/contactlist")
+	_, err = s3Uploader.Upload()
+	return err
+}
+
+// getContactListImportStatus returns the status of the last import into the contact list, or nil if it was never imported into
+func getContactListImportStatus(outboundApi *platformclientv2.OutboundApi, contactListId string) (*platformclientv2.Importstatus, error) {
+	status, resp, err := outboundApi.GetOutboundContactlistImportstatus(contactListId)
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return status, nil
+}
+
+// waitForContactListImport waits for the import job of the upload to complete or fail. The import status has no job ID,
+// so the wait has two phases. It first waits for the import of the upload to start, which is recognised by a pending or
+// in progress state, or by a finished status that differs from previousImport, the status read before the upload. Only
+// then does it wait for a finished state, so that the finished status of the previous import is never taken as the
+// result of the upload.
+func waitForContactListImport(ctx context.Context, d *schema.ResourceData, outboundApi *platformclientv2.OutboundApi, previousImport *platformclientv2.Importstatus) diag.Diagnostics {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	getStatus := func() (*platformclientv2.Importstatus, error) {
+		return getContactListImportStatus(outboundApi, d.Id())
+	}
+
+	importStatus, diagErr := waitForImportStatus(ctx, d.Id(), timeout, getStatus, previousImport)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if importStatus.TotalRecords != nil && importStatus.CompletedRecords != nil && *importStatus.CompletedRecords < *importStatus.TotalRecords {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Outbound Contact List %s imported %d of %d contacts", d.Id(), *importStatus.CompletedRecords, *importStatus.TotalRecords),
+			Detail:   "Genesys Cloud did not import every uploaded row. Check the contact list in the UI for the rejected contacts.",
+		}}
+	}
+	log.Printf("Imported contacts to Outbound Contact List %s", d.Id())
+	return nil
+}
+
+// waitForImportStatus runs both phases of the wait within timeout and returns the finished status of the new import
+func waitForImportStatus(ctx context.Context, contactListId string, timeout time.Duration, getStatus func() (*platformclientv2.Importstatus, error), previousImport *platformclientv2.Importstatus) (*platformclientv2.Importstatus, diag.Diagnostics) {
+	deadline := time.Now().Add(timeout)
+
+	var importStatus *platformclientv2.Importstatus
+	readStatus := func() *retry.RetryError {
+		status, err := getStatus()
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read import status of Outbound Contact List %s: %s", contactListId, err))
+		}
+		if status == nil {
+			return retry.RetryableError(fmt.Errorf("import of contacts to Outbound Contact List %s has not started yet", contactListId))
+		}
+		importStatus = status
+		return nil
+	}
+
+	// Poll at a fixed short interval rather than with a growing backoff, so that a short import is seen in progress
+	// even if it finishes with the same result as the previous import
+	for {
+		if retryErr := readStatus(); retryErr != nil && !retryErr.Retryable {
+			return nil, diag.FromErr(retryErr.Err)
+		}
+		if importStatus != nil && (previousImport == nil || isImportStarted(previousImport, importStatus)) {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, diag.Errorf("timed out after %s waiting for the import of contacts to Outbound Contact List %s to start", timeout, contactListId)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, diag.FromErr(ctx.Err())
+		case <-time.After(importStatusPollInterval):
+		}
+	}
+	log.Printf("Import of contacts to Outbound Contact List %s started", contactListId)
+
+	// The status that showed the import starting may already be its finished status
+	if !isImportFinished(importStatus) {
+		diagErr := gcloud.WithRetries(ctx, time.Until(deadline), func() *retry.RetryError {
+			if retryErr := readStatus(); retryErr != nil {
+				return retryErr
+			}
+			if !isImportFinished(importStatus) {
+				return retry.RetryableError(fmt.Errorf("import of contacts to Outbound Contact List %s is %s", contactListId, strings.ToLower(importStatusState(importStatus))))
+			}
+			return nil
+		})
+		if diagErr != nil {
+			return nil, diagErr
+		}
+	}
+
+	if importStatusState(importStatus) == "FAILED" {
+		reason := "unknown reason"
+		if importStatus.FailureReason != nil {
+			reason = *importStatus.FailureReason
+		}
+		return nil, diag.Errorf("import of contacts to Outbound Contact List %s failed: %s", contactListId, reason)
+	}
+	return importStatus, nil
+}
+
+// isImportStarted reports whether the import status no longer describes the import that preceded the upload. A pending
+// or in progress import always belongs to the upload. A finished import belongs to it if its state, record counts or
+// failure reason differ from those of the previous import.
+func isImportStarted(previousImport, status *platformclientv2.Importstatus) bool {
+	if !isImportFinished(status) {
+		return true
+	}
+	return importStatusState(status) != importStatusState(previousImport) ||
+		!ptrEqual(status.TotalRecords, previousImport.TotalRecords) ||
+		!ptrEqual(status.CompletedRecords, previousImport.CompletedRecords) ||
+		!ptrEqual(status.FailureReason, previousImport.FailureReason)
+}
+
+func isImportFinished(status *platformclientv2.Importstatus) bool {
+	state := importStatusState(status)
+	return state == "COMPLETED" || state == "FAILED"
+}
+
+func importStatusState(status *platformclientv2.Importstatus) string {
+	if status == nil || status.State == nil {
+		return ""
+	}
+	return *status.State
+}
+
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// parseContactsCsv validates a contacts CSV against the contact list columns. The header must contain every column of the
+// contact list. Rows that cannot be imported are left out of the returned content and reported as rejections.
+func parseContactsCsv(reader io.Reader, columnNames []string, contactsIdName string) (*contactsCsv, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, fmt.Errorf("failed to read header: %s", err)
+	}
+
+	idColumn := -1
+	for i, column := range header {
+		if column == contactsIdName {
+			idColumn = i
+		}
+	}
+	if contactsIdName != "" && idColumn == -1 {
+		return nil, fmt.Errorf("header does not contain the contacts_id_name column %s", contactsIdName)
+	}
+	for _, columnName := range columnNames {
+		if !lists.ItemInSlice(columnName, header) {
+			return nil, fmt.Errorf("header does not contain the contact list column %s", columnName)
+		}
+	}
+
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+
+	result := &contactsCsv{}
+	seenIds := make(map[string]int)
+	for row := 2; ; row++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			result.rejections = append(result.rejections, contactRowRejection{row: row, reason: err.Error()})
+			continue
+		}
+		if len(record) != len(header) {
+			result.rejections = append(result.rejections, contactRowRejection{row: row, reason: fmt.Sprintf("expected %d fields but found %d", len(header), len(record))})
+			continue
+		}
+		if idColumn != -1 {
+			id := strings.TrimSpace(record[idColumn])
+			if id == "" {
+				result.rejections = append(result.rejections, contactRowRejection{row: row, reason: fmt.Sprintf("%s is empty", contactsIdName)})
+				continue
+			}
+			if firstRow, ok := seenIds[id]; ok {
+				result.rejections = append(result.rejections, contactRowRejection{row: row, reason: fmt.Sprintf("%s %s duplicates row %d", contactsIdName, id, firstRow)})
+				continue
+			}
+			seenIds[id] = row
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return nil, err
+		}
+		result.rows++
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return nil, err
+	}
+	result.content = buf.Bytes()
+	return result, nil
+}
+
+func contactRejectionsToDiagnostics(contactsFilepath string, rejections []contactRowRejection) diag.Diagnostics {
+	if len(rejections) == 0 {
+		return nil
+	}
+
+	details := make([]string, 0, maxReportedContactRejections)
+	for i, rejection := range rejections {
+		if i == maxReportedContactRejections {
+			details = append(details, fmt.Sprintf("... and %d more", len(rejections)-maxReportedContactRejections))
+			break
+		}
+		details = append(details, fmt.Sprintf("row %d: %s", rejection.row, rejection.reason))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%d rows of contacts file %s were rejected", len(rejections), contactsFilepath),
+		Detail:   strings.Join(details, "\n"),
+	}}
+}
@@ -1,9 +1,12 @@
 package outbound_contact_list
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	obAttemptLimit "terraform-provider-genesyscloud/genesyscloud/outbound_attempt_limit"
@@ -371,3 +374,103 @@ func testVerifyContactListDestroyed(state *terraform.State) error {
 	// Success. All contact lists destroyed
 	return nil
 }
+
+func TestUnitParseContactsCsv(t *testing.T) {
+	contactsFile := `Id,First Name,Cell
+1,Ann,+13175550101
+2,Bob
+,Carl,+13175550103
+1,Dana,+13175550104
+3,"Eve, Jr.",+13175550105
+`
+	contacts, err := parseContactsCsv(strings.NewReader(contactsFile), []string{"First Name", "Cell"}, "Id")
+	if err != nil {
+		t.Fatalf("Unexpected error parsing contacts: %v", err)
+	}
+
+	if contacts.rows != 2 {
+		t.Errorf("Expected 2 valid rows, got %d", contacts.rows)
+	}
+	expectedRejectedRows := []int{3, 4, 5}
+	if len(contacts.rejections) != len(expectedRejectedRows) {
+		t.Fatalf("Expected %d rejections, got %v", len(expectedRejectedRows), contacts.rejections)
+	}
+	for i, row := range expectedRejectedRows {
+		if contacts.rejections[i].row != row {
+			t.Errorf("Expected rejection %d to be row %d, got row %d", i, row, contacts.rejections[i].row)
+		}
+	}
+
+	expectedContent := "Id,First Name,Cell\n1,Ann,+13175550101\n3,\"Eve, Jr.\",+13175550105\n"
+	if string(contacts.content) != expectedContent {
+		t.Errorf("Expected content %q, got %q", expectedContent, string(contacts.content))
+	}
+
+	if _, err := parseContactsCsv(strings.NewReader("Id,First Name\n1,Ann\n"), []string{"First Name", "Cell"}, "Id"); err == nil {
+		t.Error("Expected an error for a header missing a contact list column")
+	}
+	if _, err := parseContactsCsv(strings.NewReader("First Name,Cell\nAnn,+13175550101\n"), []string{"First Name", "Cell"}, "Id"); err == nil {
+		t.Error("Expected an error for a header missing the contacts_id_name column")
+	}
+}
+
+func TestUnitIsImportStarted(t *testing.T) {
+	newStatus := func(state string, total, completed int) *platformclientv2.Importstatus {
+		return &platformclientv2.Importstatus{State: &state, TotalRecords: &total, CompletedRecords: &completed}
+	}
+	previousImport := newStatus("COMPLETED", 10, 10)
+
+	if isImportStarted(previousImport, newStatus("COMPLETED", 10, 10)) {
+		t.Error("Expected the status of the previous import not to be treated as a new import")
+	}
+	if !isImportStarted(previousImport, newStatus("IN_PROGRESS", 10, 0)) {
+		t.Error("Expected an in progress import to be treated as a new import")
+	}
+	if !isImportStarted(previousImport, newStatus("COMPLETED", 4, 4)) {
+		t.Error("Expected a completed import with different record counts to be treated as a new import")
+	}
+	if !isImportStarted(previousImport, newStatus("FAILED", 10, 10)) {
+		t.Error("Expected a failed import after a completed one to be treated as a new import")
+	}
+}
+
+func TestUnitWaitForImportStatus(t *testing.T) {
+	importStatusPollInterval = 10 * time.Millisecond
+	defer func() { importStatusPollInterval = time.Second }()
+
+	newStatus := func(state string, total, completed int) *platformclientv2.Importstatus {
+		return &platformclientv2.Importstatus{State: &state, TotalRecords: &total, CompletedRecords: &completed}
+	}
+	statusSequence := func(statuses ...*platformclientv2.Importstatus) func() (*platformclientv2.Importstatus, error) {
+		return func() (*platformclientv2.Importstatus, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return status, nil
+		}
+	}
+	previousImport := newStatus("COMPLETED", 10, 10)
+
+	// A re-upload of the same rows finishes with the same counts, so it is only recognised by its in progress states
+	getStatus := statusSequence(previousImport, newStatus("PENDING", 10, 0), newStatus("IN_PROGRESS", 10, 5), newStatus("COMPLETED", 10, 10))
+	importStatus, diagErr := waitForImportStatus(context.Background(), "list-id", time.Minute, getStatus, previousImport)
+	if diagErr != nil {
+		t.Fatalf("Unexpected error waiting for the import: %v", diagErr)
+	}
+	if *importStatus.State != "COMPLETED" {
+		t.Errorf("Expected the import to be completed, got %s", *importStatus.State)
+	}
+
+	// A failed import of the upload is reported
+	getStatus = statusSequence(previousImport, newStatus("FAILED", 0, 0))
+	if _, diagErr := waitForImportStatus(context.Background(), "list-id", time.Minute, getStatus, previousImport); !diagErr.HasError() {
+		t.Error("Expected an error for a failed import")
+	}
+
+	// The finished status of the previous import is never taken as the result of the upload
+	getStatus = statusSequence(previousImport)
+	if _, diagErr := waitForImportStatus(context.Background(), "list-id", 50*time.Millisecond, getStatus, previousImport); !diagErr.HasError() {
+		t.Error("Expected a timeout while the previous import is the only one reported")
+	}
+}
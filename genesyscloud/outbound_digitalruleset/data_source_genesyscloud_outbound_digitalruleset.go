@@ -0,0 +1,42 @@
+package outbound_digitalruleset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+)
+
+/*
+   The data_source_genesyscloud_outbound_digitalruleset.go contains the data source implementation
+   for the resource.
+*/
+
+// dataSourceOutboundDigitalrulesetRead retrieves by name the id in question
+func dataSourceOutboundDigitalrulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		ruleSetId, retryable, _, err := proxy.getOutboundDigitalrulesetIdByName(ctx, name)
+
+		if err != nil && !retryable {
+			return retry.NonRetryableError(fmt.Errorf("Error searching outbound digital ruleset %s: %s", name, err))
+		}
+
+		if retryable {
+			return retry.RetryableError(fmt.Errorf("No outbound digital ruleset found with name %s", name))
+		}
+
+		d.SetId(ruleSetId)
+		return nil
+	})
+}
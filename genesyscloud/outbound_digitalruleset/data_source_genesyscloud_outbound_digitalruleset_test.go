@@ -0,0 +1,54 @@
+package outbound_digitalruleset
+
+import (
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+Test Class for the Outbound digital ruleset Data Source
+*/
+func TestAccDataSourceOutboundDigitalruleset(t *testing.T) {
+	t.Parallel()
+	var (
+		ruleSetResourceId   = "digital-rule-set-resource"
+		ruleSetDataSourceId = "digital-rule-set-data-source"
+		ruleSetName         = "Test Digital Rule Set " + uuid.NewString()
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: GenerateOutboundDigitalruleset(ruleSetResourceId, ruleSetName, gcloud.NullValue, `
+	rules {
+		name     = "Skip unreachable addresses"
+		order    = 0
+		category = "PreContact"
+		conditions {
+			contact_address_condition_settings {
+				operator = "EndsWith"
+				value    = "@example.com"
+			}
+		}
+		actions {
+			do_not_send_action_settings = jsonencode({})
+		}
+	}
+`) + fmt.Sprintf(`data "genesyscloud_outbound_digitalruleset" "%s" {
+  name       = "%s"
+  depends_on = [genesyscloud_outbound_digitalruleset.%s]
+}
+`, ruleSetDataSourceId, ruleSetName, ruleSetResourceId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_outbound_digitalruleset."+ruleSetDataSourceId, "id", "genesyscloud_outbound_digitalruleset."+ruleSetResourceId, "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+package outbound_digitalruleset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_outbound_digitalruleset_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *outboundDigitalrulesetProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createOutboundDigitalrulesetFunc func(ctx context.Context, p *outboundDigitalrulesetProxy, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error)
+type getAllOutboundDigitalrulesetFunc func(ctx context.Context, p *outboundDigitalrulesetProxy) (*[]platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error)
+type getOutboundDigitalrulesetIdByNameFunc func(ctx context.Context, p *outboundDigitalrulesetProxy, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getOutboundDigitalrulesetByIdFunc func(ctx context.Context, p *outboundDigitalrulesetProxy, id string) (digitalRuleSet *platformclientv2.Digitalruleset, resp *platformclientv2.APIResponse, err error)
+type updateOutboundDigitalrulesetFunc func(ctx context.Context, p *outboundDigitalrulesetProxy, id string, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error)
+type deleteOutboundDigitalrulesetFunc func(ctx context.Context, p *outboundDigitalrulesetProxy, id string) (resp *platformclientv2.APIResponse, err error)
+
+// outboundDigitalrulesetProxy contains all of the methods that call genesys cloud APIs.
+type outboundDigitalrulesetProxy struct {
+	clientConfig                          *platformclientv2.Configuration
+	outboundApi                           *platformclientv2.OutboundApi
+	createOutboundDigitalrulesetAttr      createOutboundDigitalrulesetFunc
+	getAllOutboundDigitalrulesetAttr      getAllOutboundDigitalrulesetFunc
+	getOutboundDigitalrulesetIdByNameAttr getOutboundDigitalrulesetIdByNameFunc
+	getOutboundDigitalrulesetByIdAttr     getOutboundDigitalrulesetByIdFunc
+	updateOutboundDigitalrulesetAttr      updateOutboundDigitalrulesetFunc
+	deleteOutboundDigitalrulesetAttr      deleteOutboundDigitalrulesetFunc
+}
+
+// newOutboundDigitalrulesetProxy initializes the outbound digitalruleset proxy with all of the data needed to communicate with Genesys Cloud
+func newOutboundDigitalrulesetProxy(clientConfig *platformclientv2.Configuration) *outboundDigitalrulesetProxy {
+	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &outboundDigitalrulesetProxy{
+		clientConfig:                          clientConfig,
+		outboundApi:                           api,
+		createOutboundDigitalrulesetAttr:      createOutboundDigitalrulesetFn,
+		getAllOutboundDigitalrulesetAttr:      getAllOutboundDigitalrulesetFn,
+		getOutboundDigitalrulesetIdByNameAttr: getOutboundDigitalrulesetIdByNameFn,
+		getOutboundDigitalrulesetByIdAttr:     getOutboundDigitalrulesetByIdFn,
+		updateOutboundDigitalrulesetAttr:      updateOutboundDigitalrulesetFn,
+		deleteOutboundDigitalrulesetAttr:      deleteOutboundDigitalrulesetFn,
+	}
+}
+
+// getOutboundDigitalrulesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getOutboundDigitalrulesetProxy(clientConfig *platformclientv2.Configuration) *outboundDigitalrulesetProxy {
+	if internalProxy == nil {
+		internalProxy = newOutboundDigitalrulesetProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createOutboundDigitalruleset creates a Genesys Cloud outbound digitalruleset
+func (p *outboundDigitalrulesetProxy) createOutboundDigitalruleset(ctx context.Context, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	return p.createOutboundDigitalrulesetAttr(ctx, p, digitalRuleSet)
+}
+
+// getAllOutboundDigitalruleset retrieves all Genesys Cloud outbound digitalruleset
+func (p *outboundDigitalrulesetProxy) getAllOutboundDigitalruleset(ctx context.Context) (*[]platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	return p.getAllOutboundDigitalrulesetAttr(ctx, p)
+}
+
+// getOutboundDigitalrulesetIdByName returns a single Genesys Cloud outbound digitalruleset by a name
+func (p *outboundDigitalrulesetProxy) getOutboundDigitalrulesetIdByName(ctx context.Context, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getOutboundDigitalrulesetIdByNameAttr(ctx, p, name)
+}
+
+// getOutboundDigitalrulesetById returns a single Genesys Cloud outbound digitalruleset by Id
+func (p *outboundDigitalrulesetProxy) getOutboundDigitalrulesetById(ctx context.Context, id string) (digitalRuleSet *platformclientv2.Digitalruleset, resp *platformclientv2.APIResponse, err error) {
+	return p.getOutboundDigitalrulesetByIdAttr(ctx, p, id)
+}
+
+// updateOutboundDigitalruleset updates a Genesys Cloud outbound digitalruleset
+func (p *outboundDigitalrulesetProxy) updateOutboundDigitalruleset(ctx context.Context, id string, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	return p.updateOutboundDigitalrulesetAttr(ctx, p, id, digitalRuleSet)
+}
+
+// deleteOutboundDigitalruleset deletes a Genesys Cloud outbound digitalruleset by Id
+func (p *outboundDigitalrulesetProxy) deleteOutboundDigitalruleset(ctx context.Context, id string) (resp *platformclientv2.APIResponse, err error) {
+	return p.deleteOutboundDigitalrulesetAttr(ctx, p, id)
+}
+
+// createOutboundDigitalrulesetFn is an implementation function for creating a Genesys Cloud outbound digitalruleset
+func createOutboundDigitalrulesetFn(ctx context.Context, p *outboundDigitalrulesetProxy, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	ruleSet, resp, err := p.outboundApi.PostOutboundDigitalrulesets(*digitalRuleSet)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create outbound digitalruleset: %s", err)
+	}
+	return ruleSet, resp, nil
+}
+
+// getAllOutboundDigitalrulesetFn is the implementation for retrieving all outbound digitalruleset in Genesys Cloud
+func getAllOutboundDigitalrulesetFn(ctx context.Context, p *outboundDigitalrulesetProxy) (*[]platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	var allDigitalRuleSets []platformclientv2.Digitalruleset
+	const pageSize = 100
+
+	ruleSets, resp, err := p.outboundApi.GetOutboundDigitalrulesets(pageSize, 1, "", "", "", nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get outbound digitalrulesets: %v", err)
+	}
+	if ruleSets.Entities == nil || len(*ruleSets.Entities) == 0 {
+		return &allDigitalRuleSets, resp, nil
+	}
+	allDigitalRuleSets = append(allDigitalRuleSets, *ruleSets.Entities...)
+
+	for pageNum := 2; ruleSets.PageCount != nil && pageNum <= *ruleSets.PageCount; pageNum++ {
+		page, resp, err := p.outboundApi.GetOutboundDigitalrulesets(pageSize, pageNum, "", "", "", nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get outbound digitalrulesets: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allDigitalRuleSets = append(allDigitalRuleSets, *page.Entities...)
+	}
+
+	return &allDigitalRuleSets, resp, nil
+}
+
+// getOutboundDigitalrulesetIdByNameFn is an implementation of the function to get a Genesys Cloud outbound digitalruleset by name
+func getOutboundDigitalrulesetIdByNameFn(ctx context.Context, p *outboundDigitalrulesetProxy, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	const pageSize = 100
+	ruleSets, resp, err := p.outboundApi.GetOutboundDigitalrulesets(pageSize, 1, "", "", name, nil)
+	if err != nil {
+		return "", false, resp, fmt.Errorf("error searching outbound digitalruleset %s: %s", name, err)
+	}
+
+	if ruleSets.Entities == nil || len(*ruleSets.Entities) == 0 {
+		return "", true, resp, fmt.Errorf("no outbound digitalruleset found with name %s", name)
+	}
+
+	for _, ruleSet := range *ruleSets.Entities {
+		if ruleSet.Name != nil && *ruleSet.Name == name {
+			return *ruleSet.Id, false, resp, nil
+		}
+	}
+	return "", true, resp, fmt.Errorf("unable to find outbound digitalruleset with name %s", name)
+}
+
+// getOutboundDigitalrulesetByIdFn is an implementation of the function to get a Genesys Cloud outbound digitalruleset by Id
+func getOutboundDigitalrulesetByIdFn(ctx context.Context, p *outboundDigitalrulesetProxy, id string) (digitalRuleSet *platformclientv2.Digitalruleset, resp *platformclientv2.APIResponse, err error) {
+	ruleSet, resp, err := p.outboundApi.GetOutboundDigitalruleset(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve outbound digitalruleset by id %s: %s", id, err)
+	}
+	return ruleSet, resp, nil
+}
+
+// updateOutboundDigitalrulesetFn is an implementation of the function to update a Genesys Cloud outbound digitalruleset
+func updateOutboundDigitalrulesetFn(ctx context.Context, p *outboundDigitalrulesetProxy, id string, digitalRuleSet *platformclientv2.Digitalruleset) (*platformclientv2.Digitalruleset, *platformclientv2.APIResponse, error) {
+	existing, resp, err := getOutboundDigitalrulesetByIdFn(ctx, p, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	digitalRuleSet.Version = existing.Version
+	ruleSet, resp, err := p.outboundApi.PutOutboundDigitalruleset(id, *digitalRuleSet)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update outbound digitalruleset: %s", err)
+	}
+	return ruleSet, resp, nil
+}
+
+// deleteOutboundDigitalrulesetFn is an implementation function for deleting a Genesys Cloud outbound digitalruleset
+func deleteOutboundDigitalrulesetFn(ctx context.Context, p *outboundDigitalrulesetProxy, id string) (resp *platformclientv2.APIResponse, err error) {
+	resp, err = p.outboundApi.DeleteOutboundDigitalruleset(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete outbound digitalruleset: %s", err)
+	}
+	return resp, nil
+}
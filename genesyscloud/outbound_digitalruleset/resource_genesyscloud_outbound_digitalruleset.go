@@ -0,0 +1,128 @@
+package outbound_digitalruleset
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_digitalruleset.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllAuthOutboundDigitalrulesets retrieves all of the outbound digital rulesets via Terraform in the Genesys Cloud and is used for the exporter
+func getAllAuthOutboundDigitalrulesets(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getOutboundDigitalrulesetProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	ruleSets, _, err := proxy.getAllOutboundDigitalruleset(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get digital rulesets: %v", err)
+	}
+
+	for _, ruleSet := range *ruleSets {
+		resources[*ruleSet.Id] = &resourceExporter.ResourceMeta{Name: *ruleSet.Name}
+	}
+
+	return resources, nil
+}
+
+// createOutboundDigitalruleset is used by the outbound_digitalruleset resource to create a Genesys cloud outbound digital ruleset
+func createOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+	digitalRuleSet := getOutboundDigitalrulesetFromResourceData(d)
+
+	log.Printf("Creating Outbound Digital Ruleset %s", *digitalRuleSet.Name)
+	ruleSet, _, err := proxy.createOutboundDigitalruleset(ctx, &digitalRuleSet)
+	if err != nil {
+		return diag.Errorf("Failed to create digital ruleset: %s", err)
+	}
+
+	d.SetId(*ruleSet.Id)
+	log.Printf("Created Outbound Digital Ruleset %s", *ruleSet.Id)
+	return readOutboundDigitalruleset(ctx, d, meta)
+}
+
+// readOutboundDigitalruleset is used by the outbound_digitalruleset resource to read an outbound digital ruleset from genesys cloud
+func readOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+	log.Printf("Reading Outbound Digital Ruleset %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		ruleSet, resp, getErr := proxy.getOutboundDigitalrulesetById(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read Outbound Digital Ruleset %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read Outbound Digital Ruleset %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceOutboundDigitalruleset())
+
+		resourcedata.SetNillableValue(d, "name", ruleSet.Name)
+		resourcedata.SetNillableReference(d, "contact_list_id", ruleSet.ContactList)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "rules", ruleSet.Rules, flattenDigitalrules)
+
+		log.Printf("Read Outbound Digital Ruleset %s %s", d.Id(), *ruleSet.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateOutboundDigitalruleset is used by the outbound_digitalruleset resource to update an outbound digital ruleset in Genesys Cloud
+func updateOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+	digitalRuleSet := getOutboundDigitalrulesetFromResourceData(d)
+
+	log.Printf("Updating Outbound Digital Ruleset %s", d.Id())
+	ruleSet, _, err := proxy.updateOutboundDigitalruleset(ctx, d.Id(), &digitalRuleSet)
+	if err != nil {
+		return diag.Errorf("Failed to update digital ruleset %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Updated Outbound Digital Ruleset %s", *ruleSet.Id)
+	return readOutboundDigitalruleset(ctx, d, meta)
+}
+
+// deleteOutboundDigitalruleset is used by the outbound_digitalruleset resource to delete an outbound digital ruleset from Genesys cloud
+func deleteOutboundDigitalruleset(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+	if _, err := proxy.deleteOutboundDigitalruleset(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete digital ruleset %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getOutboundDigitalrulesetById(ctx, d.Id())
+		if gcloud.IsStatus404(resp) {
+			log.Printf("Deleted Outbound Digital Ruleset %s", d.Id())
+			return nil
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Error deleting Outbound Digital Ruleset %s: %s", d.Id(), err))
+		}
+
+		return retry.RetryableError(fmt.Errorf("Outbound Digital Ruleset %s still exists", d.Id()))
+	})
+}
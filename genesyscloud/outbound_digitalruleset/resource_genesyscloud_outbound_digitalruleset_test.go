@@ -0,0 +1,250 @@
+package outbound_digitalruleset
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+
+	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
+)
+
+/*
+The resource_genesyscloud_outbound_digitalruleset_test.go contains all of the test cases for running the resource
+tests for outbound_digitalruleset.
+*/
+
+func TestAccResourceOutboundDigitalruleset(t *testing.T) {
+	t.Parallel()
+	var (
+		contactListResourceId = "contact-list"
+		contactListName       = "Test Contact List " + uuid.NewString()
+		columnNames           = []string{strconv.Quote("Cell"), strconv.Quote("Email"), strconv.Quote("Attempts")}
+
+		wrapupCodeResourceId = "wrapupcode"
+		wrapupCodeName       = "Terraform Test Wrapupcode " + uuid.NewString()
+
+		ruleSetResourceId = "digital-rule-set"
+		ruleSetName1      = "Test Digital Rule Set " + uuid.NewString()
+		ruleSetName2      = "Test Digital Rule Set " + uuid.NewString()
+		fullResourceId    = "genesyscloud_outbound_digitalruleset." + ruleSetResourceId
+	)
+
+	contactListConfig := obContactList.GenerateOutboundContactList(
+		contactListResourceId,
+		contactListName,
+		gcloud.NullValue,
+		gcloud.NullValue,
+		[]string{},
+		columnNames,
+		gcloud.FalseValue,
+		gcloud.NullValue,
+		gcloud.NullValue,
+		obContactList.GeneratePhoneColumnsBlock(
+			"Cell",
+			"cell",
+			gcloud.NullValue,
+		),
+		`
+	email_columns {
+		column_name = "Email"
+		type        = "work"
+	}
+`,
+	) + gcloud.GenerateRoutingWrapupcodeResource(wrapupCodeResourceId, wrapupCodeName)
+
+	preContactRule := `
+	rules {
+		name     = "Increment attempts"
+		order    = 0
+		category = "PreContact"
+		conditions {
+			inverted = false
+			contact_column_condition_settings {
+				column_name = "Attempts"
+				operator    = "LessThan"
+				value       = "5"
+				value_type  = "Numeric"
+			}
+		}
+		actions {
+			update_contact_column_action_settings {
+				properties = {
+					Attempts = "1"
+				}
+				update_option = "Increment"
+			}
+		}
+	}
+`
+
+	postContactRule := fmt.Sprintf(`
+	rules {
+		name     = "Do not send after wrapup"
+		order    = 1
+		category = "PostContact"
+		conditions {
+			last_result_overall_condition_settings {
+				email_wrapup_codes = [genesyscloud_routing_wrapupcode.%s.id]
+				sms_wrapup_codes   = [genesyscloud_routing_wrapupcode.%s.id]
+			}
+		}
+		actions {
+			do_not_send_action_settings = jsonencode({})
+		}
+	}
+`, wrapupCodeResourceId, wrapupCodeResourceId)
+
+	contactListRef := "genesyscloud_outbound_contact_list." + contactListResourceId + ".id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: contactListConfig + GenerateOutboundDigitalruleset(ruleSetResourceId, ruleSetName1, contactListRef, preContactRule),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceId, "name", ruleSetName1),
+					resource.TestCheckResourceAttrPair(fullResourceId, "contact_list_id", "genesyscloud_outbound_contact_list."+contactListResourceId, "id"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.#", "1"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.name", "Increment attempts"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.category", "PreContact"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.conditions.0.contact_column_condition_settings.0.column_name", "Attempts"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.conditions.0.contact_column_condition_settings.0.operator", "LessThan"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.conditions.0.contact_column_condition_settings.0.value_type", "Numeric"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.actions.0.update_contact_column_action_settings.0.properties.Attempts", "1"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.0.actions.0.update_contact_column_action_settings.0.update_option", "Increment"),
+				),
+			},
+			// Rename and add a post contact rule
+			{
+				Config: contactListConfig + GenerateOutboundDigitalruleset(ruleSetResourceId, ruleSetName2, contactListRef, preContactRule, postContactRule),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceId, "name", ruleSetName2),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.#", "2"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.1.category", "PostContact"),
+					resource.TestCheckResourceAttrPair(fullResourceId, "rules.1.conditions.0.last_result_overall_condition_settings.0.email_wrapup_codes.0", "genesyscloud_routing_wrapupcode."+wrapupCodeResourceId, "id"),
+					resource.TestCheckResourceAttrPair(fullResourceId, "rules.1.conditions.0.last_result_overall_condition_settings.0.sms_wrapup_codes.0", "genesyscloud_routing_wrapupcode."+wrapupCodeResourceId, "id"),
+					resource.TestCheckResourceAttr(fullResourceId, "rules.1.actions.0.do_not_send_action_settings", "{}"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyOutboundDigitalrulesetDestroyed,
+	})
+}
+
+func TestUnitOutboundDigitalrulesetRoundTrip(t *testing.T) {
+	var doNotSend interface{} = map[string]interface{}{}
+	ruleSet := platformclientv2.Digitalruleset{
+		Name:        platformclientv2.String("Digital Rule Set"),
+		ContactList: &platformclientv2.Domainentityref{Id: platformclientv2.String("contact-list-id")},
+		Rules: &[]platformclientv2.Digitalrule{
+			{
+				Name:     platformclientv2.String("Rule 1"),
+				Order:    platformclientv2.Int(0),
+				Category: platformclientv2.String("PreContact"),
+				Conditions: &[]platformclientv2.Digitalcondition{
+					{
+						Inverted: platformclientv2.Bool(true),
+						LastResultByColumnConditionSettings: &platformclientv2.Lastresultbycolumnconditionsettings{
+							EmailColumnName:  platformclientv2.String("Email"),
+							EmailWrapupCodes: &[]string{"wrapup-1"},
+							SmsColumnName:    platformclientv2.String("Cell"),
+							SmsWrapupCodes:   &[]string{"wrapup-1", "wrapup-2"},
+						},
+					},
+					{
+						Inverted: platformclientv2.Bool(false),
+						DataActionConditionSettings: &platformclientv2.Dataactionconditionsettings{
+							DataActionId:           platformclientv2.String("data-action-id"),
+							ContactIdField:         platformclientv2.String("contactId"),
+							DataNotFoundResolution: platformclientv2.Bool(true),
+							Predicates: &[]platformclientv2.Digitaldataactionconditionpredicate{
+								{
+									OutputField:                  platformclientv2.String("status"),
+									OutputOperator:               platformclientv2.String("Equals"),
+									ComparisonValue:              platformclientv2.String("blocked"),
+									Inverted:                     platformclientv2.Bool(false),
+									OutputFieldMissingResolution: platformclientv2.Bool(true),
+								},
+							},
+							ContactColumnToDataActionFieldMappings: &[]platformclientv2.Dataactioncontactcolumnfieldmapping{
+								{
+									ContactColumnName: platformclientv2.String("Email"),
+									DataActionField:   platformclientv2.String("email"),
+								},
+							},
+						},
+					},
+				},
+				Actions: &[]platformclientv2.Digitalaction{
+					{
+						DoNotSendActionSettings: &doNotSend,
+					},
+					{
+						AppendToDncActionSettings: &platformclientv2.Appendtodncactionsettings{
+							Expire:             platformclientv2.Bool(true),
+							ExpirationDuration: platformclientv2.String("P1D"),
+							ListType:           platformclientv2.String("Rds"),
+						},
+					},
+					{
+						SetContentTemplateActionSettings: &platformclientv2.Setcontenttemplateactionsettings{
+							SmsContentTemplateId:   platformclientv2.String("sms-template-id"),
+							EmailContentTemplateId: platformclientv2.String("email-template-id"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resourceSchema := ResourceOutboundDigitalruleset().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.Set("name", *ruleSet.Name)
+	d.Set("contact_list_id", *ruleSet.ContactList.Id)
+	if err := d.Set("rules", flattenDigitalrules(ruleSet.Rules)); err != nil {
+		t.Fatalf("Failed to set rules: %s", err)
+	}
+
+	built := getOutboundDigitalrulesetFromResourceData(d)
+	if !reflect.DeepEqual(built, ruleSet) {
+		t.Errorf("Digital rule set did not survive a flatten and build round trip.\nexpected: %s\ngot:      %s", ruleSet.String(), built.String())
+	}
+}
+
+func testVerifyOutboundDigitalrulesetDestroyed(state *terraform.State) error {
+	outboundAPI := platformclientv2.NewOutboundApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_outbound_digitalruleset" {
+			continue
+		}
+		ruleSet, resp, err := outboundAPI.GetOutboundDigitalruleset(rs.Primary.ID)
+		if ruleSet != nil {
+			return fmt.Errorf("digital ruleset (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// digital ruleset not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All digital rulesets destroyed
+	return nil
+}
@@ -0,0 +1,621 @@
+package outbound_digitalruleset
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_digitalruleset_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getOutboundDigitalrulesetFromResourceData maps data from schema ResourceData object to a platformclientv2.Digitalruleset
+func getOutboundDigitalrulesetFromResourceData(d *schema.ResourceData) platformclientv2.Digitalruleset {
+	name := d.Get("name").(string)
+
+	return platformclientv2.Digitalruleset{
+		Name:        &name,
+		ContactList: gcloud.BuildSdkDomainEntityRef(d, "contact_list_id"),
+		Rules:       buildDigitalrules(d.Get("rules").([]interface{})),
+	}
+}
+
+// buildDigitalrules maps a []interface{} into a Genesys Cloud *[]platformclientv2.Digitalrule
+func buildDigitalrules(rules []interface{}) *[]platformclientv2.Digitalrule {
+	rulesSlice := make([]platformclientv2.Digitalrule, 0)
+	for _, rule := range rules {
+		var sdkRule platformclientv2.Digitalrule
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkRule.Name, ruleMap, "name")
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkRule.Category, ruleMap, "category")
+		sdkRule.Order = platformclientv2.Int(ruleMap["order"].(int))
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkRule.Conditions, ruleMap, "conditions", buildDigitalconditions)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkRule.Actions, ruleMap, "actions", buildDigitalactions)
+
+		rulesSlice = append(rulesSlice, sdkRule)
+	}
+
+	return &rulesSlice
+}
+
+// buildDigitalconditions maps a []interface{} into a Genesys Cloud *[]platformclientv2.Digitalcondition
+func buildDigitalconditions(conditions []interface{}) *[]platformclientv2.Digitalcondition {
+	conditionSlice := make([]platformclientv2.Digitalcondition, 0)
+	for _, condition := range conditions {
+		var sdkCondition platformclientv2.Digitalcondition
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		sdkCondition.Inverted = platformclientv2.Bool(conditionMap["inverted"].(bool))
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.ContactColumnConditionSettings, conditionMap, "contact_column_condition_settings", buildContactcolumnconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.ContactAddressConditionSettings, conditionMap, "contact_address_condition_settings", buildContactaddressconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.ContactAddressTypeConditionSettings, conditionMap, "contact_address_type_condition_settings", buildContactaddresstypeconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.LastAttemptByColumnConditionSettings, conditionMap, "last_attempt_by_column_condition_settings", buildLastattemptbycolumnconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.LastAttemptOverallConditionSettings, conditionMap, "last_attempt_overall_condition_settings", buildLastattemptoverallconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.LastResultByColumnConditionSettings, conditionMap, "last_result_by_column_condition_settings", buildLastresultbycolumnconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.LastResultOverallConditionSettings, conditionMap, "last_result_overall_condition_settings", buildLastresultoverallconditionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkCondition.DataActionConditionSettings, conditionMap, "data_action_condition_settings", buildDataactionconditionsettings)
+
+		conditionSlice = append(conditionSlice, sdkCondition)
+	}
+
+	return &conditionSlice
+}
+
+// firstSettingsMap returns the map held by a single-item settings block, or nil if the block is not set
+func firstSettingsMap(settings []interface{}) map[string]interface{} {
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	settingsMap, _ := settings[0].(map[string]interface{})
+	return settingsMap
+}
+
+// buildContactcolumnconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Contactcolumnconditionsettings
+func buildContactcolumnconditionsettings(settings []interface{}) *platformclientv2.Contactcolumnconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Contactcolumnconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.ColumnName, settingsMap, "column_name")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Operator, settingsMap, "operator")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Value, settingsMap, "value")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.ValueType, settingsMap, "value_type")
+	return &sdkSettings
+}
+
+// buildContactaddressconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Contactaddressconditionsettings
+func buildContactaddressconditionsettings(settings []interface{}) *platformclientv2.Contactaddressconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Contactaddressconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Operator, settingsMap, "operator")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Value, settingsMap, "value")
+	return &sdkSettings
+}
+
+// buildContactaddresstypeconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Contactaddresstypeconditionsettings
+func buildContactaddresstypeconditionsettings(settings []interface{}) *platformclientv2.Contactaddresstypeconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Contactaddresstypeconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Operator, settingsMap, "operator")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Value, settingsMap, "value")
+	return &sdkSettings
+}
+
+// buildLastattemptbycolumnconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Lastattemptbycolumnconditionsettings
+func buildLastattemptbycolumnconditionsettings(settings []interface{}) *platformclientv2.Lastattemptbycolumnconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Lastattemptbycolumnconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.EmailColumnName, settingsMap, "email_column_name")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.SmsColumnName, settingsMap, "sms_column_name")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Operator, settingsMap, "operator")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Value, settingsMap, "value")
+	return &sdkSettings
+}
+
+// buildLastattemptoverallconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Lastattemptoverallconditionsettings
+func buildLastattemptoverallconditionsettings(settings []interface{}) *platformclientv2.Lastattemptoverallconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Lastattemptoverallconditionsettings
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.MediaTypes, settingsMap, "media_types")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Operator, settingsMap, "operator")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.Value, settingsMap, "value")
+	return &sdkSettings
+}
+
+// buildLastresultbycolumnconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Lastresultbycolumnconditionsettings
+func buildLastresultbycolumnconditionsettings(settings []interface{}) *platformclientv2.Lastresultbycolumnconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Lastresultbycolumnconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.EmailColumnName, settingsMap, "email_column_name")
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.EmailWrapupCodes, settingsMap, "email_wrapup_codes")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.SmsColumnName, settingsMap, "sms_column_name")
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.SmsWrapupCodes, settingsMap, "sms_wrapup_codes")
+	return &sdkSettings
+}
+
+// buildLastresultoverallconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Lastresultoverallconditionsettings
+func buildLastresultoverallconditionsettings(settings []interface{}) *platformclientv2.Lastresultoverallconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Lastresultoverallconditionsettings
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.EmailWrapupCodes, settingsMap, "email_wrapup_codes")
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.SmsWrapupCodes, settingsMap, "sms_wrapup_codes")
+	return &sdkSettings
+}
+
+// buildDataactionconditionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Dataactionconditionsettings
+func buildDataactionconditionsettings(settings []interface{}) *platformclientv2.Dataactionconditionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Dataactionconditionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.DataActionId, settingsMap, "data_action_id")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.ContactIdField, settingsMap, "contact_id_field")
+	sdkSettings.DataNotFoundResolution = platformclientv2.Bool(settingsMap["data_not_found_resolution"].(bool))
+	resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkSettings.Predicates, settingsMap, "predicates", buildDigitaldataactionconditionpredicates)
+	resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkSettings.ContactColumnToDataActionFieldMappings, settingsMap, "contact_column_to_data_action_field_mappings", buildDataactioncontactcolumnfieldmappings)
+	return &sdkSettings
+}
+
+// buildDigitaldataactionconditionpredicates maps a []interface{} into a Genesys Cloud *[]platformclientv2.Digitaldataactionconditionpredicate
+func buildDigitaldataactionconditionpredicates(predicates []interface{}) *[]platformclientv2.Digitaldataactionconditionpredicate {
+	predicatesSlice := make([]platformclientv2.Digitaldataactionconditionpredicate, 0)
+	for _, predicate := range predicates {
+		var sdkPredicate platformclientv2.Digitaldataactionconditionpredicate
+		predicateMap, ok := predicate.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkPredicate.OutputField, predicateMap, "output_field")
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkPredicate.OutputOperator, predicateMap, "output_operator")
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkPredicate.ComparisonValue, predicateMap, "comparison_value")
+		sdkPredicate.Inverted = platformclientv2.Bool(predicateMap["inverted"].(bool))
+		sdkPredicate.OutputFieldMissingResolution = platformclientv2.Bool(predicateMap["output_field_missing_resolution"].(bool))
+
+		predicatesSlice = append(predicatesSlice, sdkPredicate)
+	}
+
+	return &predicatesSlice
+}
+
+// buildDataactioncontactcolumnfieldmappings maps a []interface{} into a Genesys Cloud *[]platformclientv2.Dataactioncontactcolumnfieldmapping
+func buildDataactioncontactcolumnfieldmappings(fieldmappings []interface{}) *[]platformclientv2.Dataactioncontactcolumnfieldmapping {
+	fieldmappingsSlice := make([]platformclientv2.Dataactioncontactcolumnfieldmapping, 0)
+	for _, fieldmapping := range fieldmappings {
+		var sdkFieldmapping platformclientv2.Dataactioncontactcolumnfieldmapping
+		fieldmappingMap, ok := fieldmapping.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkFieldmapping.ContactColumnName, fieldmappingMap, "contact_column_name")
+		resourcedata.BuildSDKStringValueIfNotNil(&sdkFieldmapping.DataActionField, fieldmappingMap, "data_action_field")
+
+		fieldmappingsSlice = append(fieldmappingsSlice, sdkFieldmapping)
+	}
+
+	return &fieldmappingsSlice
+}
+
+// buildDigitalactions maps a []interface{} into a Genesys Cloud *[]platformclientv2.Digitalaction
+func buildDigitalactions(actions []interface{}) *[]platformclientv2.Digitalaction {
+	actionsSlice := make([]platformclientv2.Digitalaction, 0)
+	for _, action := range actions {
+		var sdkAction platformclientv2.Digitalaction
+		actionMap, ok := action.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkAction.UpdateContactColumnActionSettings, actionMap, "update_contact_column_action_settings", buildUpdatecontactcolumnactionsettings)
+		sdkAction.DoNotSendActionSettings = buildJsonActionSettings(actionMap, "do_not_send_action_settings")
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkAction.AppendToDncActionSettings, actionMap, "append_to_dnc_action_settings", buildAppendtodncactionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkAction.MarkContactUncontactableActionSettings, actionMap, "mark_contact_uncontactable_action_settings", buildMarkcontactuncontactableactionsettings)
+		sdkAction.MarkContactAddressUncontactableActionSettings = buildJsonActionSettings(actionMap, "mark_contact_address_uncontactable_action_settings")
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkAction.SetContentTemplateActionSettings, actionMap, "set_content_template_action_settings", buildSetcontenttemplateactionsettings)
+		resourcedata.BuildSDKInterfaceArrayValueIfNotNil(&sdkAction.SetSmsPhoneNumberActionSettings, actionMap, "set_sms_phone_number_action_settings", buildSetsmsphonenumberactionsettings)
+
+		actionsSlice = append(actionsSlice, sdkAction)
+	}
+
+	return &actionsSlice
+}
+
+// buildJsonActionSettings reads an action whose settings are free-form JSON. The schema validates the JSON, so a
+// value that fails to parse is only logged.
+func buildJsonActionSettings(actionMap map[string]interface{}, key string) *interface{} {
+	settingsJson, _ := actionMap[key].(string)
+	if settingsJson == "" {
+		return nil
+	}
+
+	settings, err := gcloud.JsonStringToInterface(settingsJson)
+	if err != nil {
+		log.Printf("Failed to parse %s: %s", key, err)
+		return nil
+	}
+	return &settings
+}
+
+// buildUpdatecontactcolumnactionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Updatecontactcolumnactionsettings
+func buildUpdatecontactcolumnactionsettings(settings []interface{}) *platformclientv2.Updatecontactcolumnactionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Updatecontactcolumnactionsettings
+	resourcedata.BuildSDKStringMapValueIfNotNil(&sdkSettings.Properties, settingsMap, "properties")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.UpdateOption, settingsMap, "update_option")
+	return &sdkSettings
+}
+
+// buildAppendtodncactionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Appendtodncactionsettings
+func buildAppendtodncactionsettings(settings []interface{}) *platformclientv2.Appendtodncactionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Appendtodncactionsettings
+	sdkSettings.Expire = platformclientv2.Bool(settingsMap["expire"].(bool))
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.ExpirationDuration, settingsMap, "expiration_duration")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.ListType, settingsMap, "list_type")
+	return &sdkSettings
+}
+
+// buildMarkcontactuncontactableactionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Markcontactuncontactableactionsettings
+func buildMarkcontactuncontactableactionsettings(settings []interface{}) *platformclientv2.Markcontactuncontactableactionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Markcontactuncontactableactionsettings
+	resourcedata.BuildSDKStringArrayValueIfNotNil(&sdkSettings.MediaTypes, settingsMap, "media_types")
+	return &sdkSettings
+}
+
+// buildSetcontenttemplateactionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Setcontenttemplateactionsettings
+func buildSetcontenttemplateactionsettings(settings []interface{}) *platformclientv2.Setcontenttemplateactionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Setcontenttemplateactionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.SmsContentTemplateId, settingsMap, "sms_content_template_id")
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.EmailContentTemplateId, settingsMap, "email_content_template_id")
+	return &sdkSettings
+}
+
+// buildSetsmsphonenumberactionsettings maps a []interface{} into a Genesys Cloud *platformclientv2.Setsmsphonenumberactionsettings
+func buildSetsmsphonenumberactionsettings(settings []interface{}) *platformclientv2.Setsmsphonenumberactionsettings {
+	settingsMap := firstSettingsMap(settings)
+	if settingsMap == nil {
+		return nil
+	}
+
+	var sdkSettings platformclientv2.Setsmsphonenumberactionsettings
+	resourcedata.BuildSDKStringValueIfNotNil(&sdkSettings.SenderSmsPhoneNumber, settingsMap, "sender_sms_phone_number")
+	return &sdkSettings
+}
+
+// flattenDigitalrules maps a Genesys Cloud *[]platformclientv2.Digitalrule into a []interface{}
+func flattenDigitalrules(rules *[]platformclientv2.Digitalrule) []interface{} {
+	if len(*rules) == 0 {
+		return nil
+	}
+
+	var ruleList []interface{}
+	for _, rule := range *rules {
+		ruleMap := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(ruleMap, "name", rule.Name)
+		resourcedata.SetMapValueIfNotNil(ruleMap, "order", rule.Order)
+		resourcedata.SetMapValueIfNotNil(ruleMap, "category", rule.Category)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(ruleMap, "conditions", rule.Conditions, flattenDigitalconditions)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(ruleMap, "actions", rule.Actions, flattenDigitalactions)
+
+		ruleList = append(ruleList, ruleMap)
+	}
+
+	return ruleList
+}
+
+// flattenDigitalconditions maps a Genesys Cloud *[]platformclientv2.Digitalcondition into a []interface{}
+func flattenDigitalconditions(conditions *[]platformclientv2.Digitalcondition) []interface{} {
+	if len(*conditions) == 0 {
+		return nil
+	}
+
+	var conditionList []interface{}
+	for _, condition := range *conditions {
+		conditionMap := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(conditionMap, "inverted", condition.Inverted)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "contact_column_condition_settings", condition.ContactColumnConditionSettings, flattenContactcolumnconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "contact_address_condition_settings", condition.ContactAddressConditionSettings, flattenContactaddressconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "contact_address_type_condition_settings", condition.ContactAddressTypeConditionSettings, flattenContactaddresstypeconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "last_attempt_by_column_condition_settings", condition.LastAttemptByColumnConditionSettings, flattenLastattemptbycolumnconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "last_attempt_overall_condition_settings", condition.LastAttemptOverallConditionSettings, flattenLastattemptoverallconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "last_result_by_column_condition_settings", condition.LastResultByColumnConditionSettings, flattenLastresultbycolumnconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "last_result_overall_condition_settings", condition.LastResultOverallConditionSettings, flattenLastresultoverallconditionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(conditionMap, "data_action_condition_settings", condition.DataActionConditionSettings, flattenDataactionconditionsettings)
+
+		conditionList = append(conditionList, conditionMap)
+	}
+
+	return conditionList
+}
+
+// flattenContactcolumnconditionsettings maps a Genesys Cloud *platformclientv2.Contactcolumnconditionsettings into a []interface{}
+func flattenContactcolumnconditionsettings(settings *platformclientv2.Contactcolumnconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "column_name", settings.ColumnName)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "operator", settings.Operator)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value", settings.Value)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value_type", settings.ValueType)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenContactaddressconditionsettings maps a Genesys Cloud *platformclientv2.Contactaddressconditionsettings into a []interface{}
+func flattenContactaddressconditionsettings(settings *platformclientv2.Contactaddressconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "operator", settings.Operator)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value", settings.Value)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenContactaddresstypeconditionsettings maps a Genesys Cloud *platformclientv2.Contactaddresstypeconditionsettings into a []interface{}
+func flattenContactaddresstypeconditionsettings(settings *platformclientv2.Contactaddresstypeconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "operator", settings.Operator)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value", settings.Value)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenLastattemptbycolumnconditionsettings maps a Genesys Cloud *platformclientv2.Lastattemptbycolumnconditionsettings into a []interface{}
+func flattenLastattemptbycolumnconditionsettings(settings *platformclientv2.Lastattemptbycolumnconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "email_column_name", settings.EmailColumnName)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "sms_column_name", settings.SmsColumnName)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "operator", settings.Operator)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value", settings.Value)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenLastattemptoverallconditionsettings maps a Genesys Cloud *platformclientv2.Lastattemptoverallconditionsettings into a []interface{}
+func flattenLastattemptoverallconditionsettings(settings *platformclientv2.Lastattemptoverallconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "media_types", settings.MediaTypes)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "operator", settings.Operator)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "value", settings.Value)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenLastresultbycolumnconditionsettings maps a Genesys Cloud *platformclientv2.Lastresultbycolumnconditionsettings into a []interface{}
+func flattenLastresultbycolumnconditionsettings(settings *platformclientv2.Lastresultbycolumnconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "email_column_name", settings.EmailColumnName)
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "email_wrapup_codes", settings.EmailWrapupCodes)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "sms_column_name", settings.SmsColumnName)
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "sms_wrapup_codes", settings.SmsWrapupCodes)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenLastresultoverallconditionsettings maps a Genesys Cloud *platformclientv2.Lastresultoverallconditionsettings into a []interface{}
+func flattenLastresultoverallconditionsettings(settings *platformclientv2.Lastresultoverallconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "email_wrapup_codes", settings.EmailWrapupCodes)
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "sms_wrapup_codes", settings.SmsWrapupCodes)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenDataactionconditionsettings maps a Genesys Cloud *platformclientv2.Dataactionconditionsettings into a []interface{}
+func flattenDataactionconditionsettings(settings *platformclientv2.Dataactionconditionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "data_action_id", settings.DataActionId)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "contact_id_field", settings.ContactIdField)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "data_not_found_resolution", settings.DataNotFoundResolution)
+	resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(settingsMap, "predicates", settings.Predicates, flattenDigitaldataactionconditionpredicates)
+	resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(settingsMap, "contact_column_to_data_action_field_mappings", settings.ContactColumnToDataActionFieldMappings, flattenDataactioncontactcolumnfieldmappings)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenDigitaldataactionconditionpredicates maps a Genesys Cloud *[]platformclientv2.Digitaldataactionconditionpredicate into a []interface{}
+func flattenDigitaldataactionconditionpredicates(predicates *[]platformclientv2.Digitaldataactionconditionpredicate) []interface{} {
+	if len(*predicates) == 0 {
+		return nil
+	}
+
+	var predicateList []interface{}
+	for _, predicate := range *predicates {
+		predicateMap := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(predicateMap, "output_field", predicate.OutputField)
+		resourcedata.SetMapValueIfNotNil(predicateMap, "output_operator", predicate.OutputOperator)
+		resourcedata.SetMapValueIfNotNil(predicateMap, "comparison_value", predicate.ComparisonValue)
+		resourcedata.SetMapValueIfNotNil(predicateMap, "inverted", predicate.Inverted)
+		resourcedata.SetMapValueIfNotNil(predicateMap, "output_field_missing_resolution", predicate.OutputFieldMissingResolution)
+
+		predicateList = append(predicateList, predicateMap)
+	}
+
+	return predicateList
+}
+
+// flattenDataactioncontactcolumnfieldmappings maps a Genesys Cloud *[]platformclientv2.Dataactioncontactcolumnfieldmapping into a []interface{}
+func flattenDataactioncontactcolumnfieldmappings(fieldmappings *[]platformclientv2.Dataactioncontactcolumnfieldmapping) []interface{} {
+	if len(*fieldmappings) == 0 {
+		return nil
+	}
+
+	var fieldmappingsList []interface{}
+	for _, fieldmapping := range *fieldmappings {
+		fieldmappingMap := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(fieldmappingMap, "contact_column_name", fieldmapping.ContactColumnName)
+		resourcedata.SetMapValueIfNotNil(fieldmappingMap, "data_action_field", fieldmapping.DataActionField)
+
+		fieldmappingsList = append(fieldmappingsList, fieldmappingMap)
+	}
+
+	return fieldmappingsList
+}
+
+// flattenDigitalactions maps a Genesys Cloud *[]platformclientv2.Digitalaction into a []interface{}
+func flattenDigitalactions(actions *[]platformclientv2.Digitalaction) []interface{} {
+	if len(*actions) == 0 {
+		return nil
+	}
+
+	var actionList []interface{}
+	for _, action := range *actions {
+		actionMap := make(map[string]interface{})
+
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(actionMap, "update_contact_column_action_settings", action.UpdateContactColumnActionSettings, flattenUpdatecontactcolumnactionsettings)
+		setMapJsonActionSettings(actionMap, "do_not_send_action_settings", action.DoNotSendActionSettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(actionMap, "append_to_dnc_action_settings", action.AppendToDncActionSettings, flattenAppendtodncactionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(actionMap, "mark_contact_uncontactable_action_settings", action.MarkContactUncontactableActionSettings, flattenMarkcontactuncontactableactionsettings)
+		setMapJsonActionSettings(actionMap, "mark_contact_address_uncontactable_action_settings", action.MarkContactAddressUncontactableActionSettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(actionMap, "set_content_template_action_settings", action.SetContentTemplateActionSettings, flattenSetcontenttemplateactionsettings)
+		resourcedata.SetMapInterfaceArrayWithFuncIfNotNil(actionMap, "set_sms_phone_number_action_settings", action.SetSmsPhoneNumberActionSettings, flattenSetsmsphonenumberactionsettings)
+
+		actionList = append(actionList, actionMap)
+	}
+
+	return actionList
+}
+
+// setMapJsonActionSettings writes free-form action settings into the map as a JSON string
+func setMapJsonActionSettings(targetMap map[string]interface{}, key string, settings *interface{}) {
+	if settings == nil {
+		return
+	}
+
+	settingsJson, err := gcloud.InterfaceToJson(*settings)
+	if err != nil {
+		log.Printf("Failed to marshal %s: %s", key, err)
+		return
+	}
+	targetMap[key] = settingsJson
+}
+
+// flattenUpdatecontactcolumnactionsettings maps a Genesys Cloud *platformclientv2.Updatecontactcolumnactionsettings into a []interface{}
+func flattenUpdatecontactcolumnactionsettings(settings *platformclientv2.Updatecontactcolumnactionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapStringMapValueIfNotNil(settingsMap, "properties", settings.Properties)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "update_option", settings.UpdateOption)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenAppendtodncactionsettings maps a Genesys Cloud *platformclientv2.Appendtodncactionsettings into a []interface{}
+func flattenAppendtodncactionsettings(settings *platformclientv2.Appendtodncactionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "expire", settings.Expire)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "expiration_duration", settings.ExpirationDuration)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "list_type", settings.ListType)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenMarkcontactuncontactableactionsettings maps a Genesys Cloud *platformclientv2.Markcontactuncontactableactionsettings into a []interface{}
+func flattenMarkcontactuncontactableactionsettings(settings *platformclientv2.Markcontactuncontactableactionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapStringArrayValueIfNotNil(settingsMap, "media_types", settings.MediaTypes)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenSetcontenttemplateactionsettings maps a Genesys Cloud *platformclientv2.Setcontenttemplateactionsettings into a []interface{}
+func flattenSetcontenttemplateactionsettings(settings *platformclientv2.Setcontenttemplateactionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "sms_content_template_id", settings.SmsContentTemplateId)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "email_content_template_id", settings.EmailContentTemplateId)
+
+	return []interface{}{settingsMap}
+}
+
+// flattenSetsmsphonenumberactionsettings maps a Genesys Cloud *platformclientv2.Setsmsphonenumberactionsettings into a []interface{}
+func flattenSetsmsphonenumberactionsettings(settings *platformclientv2.Setsmsphonenumberactionsettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(settingsMap, "sender_sms_phone_number", settings.SenderSmsPhoneNumber)
+
+	return []interface{}{settingsMap}
+}
+
+// GenerateOutboundDigitalruleset returns the HCL for a genesyscloud_outbound_digitalruleset resource
+func GenerateOutboundDigitalruleset(resourceId string, name string, contactListId string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_outbound_digitalruleset" "%s" {
+	name            = "%s"
+	contact_list_id = %s
+	%s
+}
+`, resourceId, name, contactListId, strings.Join(nestedBlocks, "\n"))
+}
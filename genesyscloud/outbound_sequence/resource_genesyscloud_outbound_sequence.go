@@ -105,6 +105,13 @@ func updateOutboundSequence(ctx context.Context, d *schema.ResourceData, meta in
 	proxy := getOutboundSequenceProxy(sdkConfig)
 	status := d.Get("status").(string)
 
+	// A running sequence can't be changed, so it is stopped first and the PUT below restores its status
+	if !d.IsNewResource() && d.HasChangesExcept("status") {
+		if diagErr := stopOutboundSequence(ctx, proxy, d.Id(), d.Timeout(schema.TimeoutUpdate)); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	outboundSequence := getOutboundSequenceFromResourceData(d)
 	if status != "off" {
 		outboundSequence.Status = &status
@@ -126,20 +133,11 @@ func deleteOutboundSequence(ctx context.Context, d *schema.ResourceData, meta in
 	proxy := getOutboundSequenceProxy(sdkConfig)
 
 	// Sequence can't be deleted while running
-	sequence, _, err := proxy.getOutboundSequenceById(ctx, d.Id())
-	if *sequence.Status == "on" {
-		if err != nil {
-			return diag.Errorf("Failed to get outbound sequence %s: %s", d.Id(), err)
-		}
-		sequence.Status = platformclientv2.String("off")
-		_, err = proxy.updateOutboundSequence(ctx, d.Id(), sequence)
-		if err != nil {
-			return diag.Errorf("Failed to turn off outbound sequence %s: %s", d.Id(), err)
-		}
-		time.Sleep(20 * time.Second) // Give the sequence a chance to turned off
+	if diagErr := stopOutboundSequence(ctx, proxy, d.Id(), d.Timeout(schema.TimeoutDelete)); diagErr != nil {
+		return diagErr
 	}
 
-	_, err = proxy.deleteOutboundSequence(ctx, d.Id())
+	_, err := proxy.deleteOutboundSequence(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Failed to delete outbound sequence %s: %s", d.Id(), err)
 	}
@@ -2,6 +2,8 @@ package outbound_sequence
 
 import (
 	"context"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,6 +42,10 @@ func ResourceOutboundSequence() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			`name`: {
@@ -54,7 +60,7 @@ func ResourceOutboundSequence() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			`status`: {
-				Description:  `The current status of the CampaignSequence. A CampaignSequence can be turned 'on' or 'off' (default). Changing from "on" to "off" will cause the current sequence to drop and be recreated with a new ID.`,
+				Description:  `The current status of the CampaignSequence. A CampaignSequence can be turned 'on' or 'off' (default). Changing from "on" to "off" will cause the current sequence to drop and be recreated with a new ID. A running CampaignSequence is turned off and left to finish stopping before other changes are applied; the time allowed for this can be set with the update and delete timeouts.`,
 				Optional:     true,
 				Computed:     true,
 				Type:         schema.TypeString,
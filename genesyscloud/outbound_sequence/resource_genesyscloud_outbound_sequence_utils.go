@@ -1,10 +1,16 @@
 package outbound_sequence
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
+
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
@@ -23,6 +29,37 @@ func getOutboundSequenceFromResourceData(d *schema.ResourceData) platformclientv
 	}
 }
 
+// stopOutboundSequence turns a running sequence off and waits for it to finish stopping
+func stopOutboundSequence(ctx context.Context, proxy *outboundSequenceProxy, sequenceId string, timeout time.Duration) diag.Diagnostics {
+	sequence, _, err := proxy.getOutboundSequenceById(ctx, sequenceId)
+	if err != nil {
+		return diag.Errorf("Failed to get outbound sequence %s: %s", sequenceId, err)
+	}
+	if sequence.Status == nil || (*sequence.Status != "on" && *sequence.Status != "stopping") {
+		return nil
+	}
+
+	if *sequence.Status == "on" {
+		log.Printf("Turning off outbound sequence %s", sequenceId)
+		sequence.Status = platformclientv2.String("off")
+		if _, err := proxy.updateOutboundSequence(ctx, sequenceId, sequence); err != nil {
+			return diag.Errorf("Failed to turn off outbound sequence %s: %s", sequenceId, err)
+		}
+	}
+
+	log.Printf("Waiting for outbound sequence %s to stop", sequenceId)
+	return gcloud.WithRetries(ctx, timeout, func() *retry.RetryError {
+		sequence, _, err := proxy.getOutboundSequenceById(ctx, sequenceId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get outbound sequence %s: %s", sequenceId, err))
+		}
+		if sequence.Status != nil && (*sequence.Status == "on" || *sequence.Status == "stopping") {
+			return retry.RetryableError(fmt.Errorf("outbound sequence %s is still %s", sequenceId, *sequence.Status))
+		}
+		return nil
+	})
+}
+
 func GenerateOutboundSequence(
 	resourceId string,
 	name string,
@@ -80,6 +80,12 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					Description:  "AWS region where org exists. e.g. us-east-1. Can be set with the `GENESYSCLOUD_REGION` environment variable.",
 					ValidateFunc: validation.StringInSlice(getAllowedRegions(), true),
 				},
+				"api_base_path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_API_BASE_PATH", nil),
+					Description: "Overrides the API base path derived from aws_region, e.g. to point the provider at a local mock of the Genesys Cloud API. Can be set with the `GENESYSCLOUD_API_BASE_PATH` environment variable.",
+				},
 				"sdk_debug": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -235,6 +241,9 @@ func initClientConfig(data *schema.ResourceData, version string, config *platfor
 	oauthclientID := data.Get("oauthclient_id").(string)
 	oauthclientSecret := data.Get("oauthclient_secret").(string)
 	basePath := GetRegionBasePath(data.Get("aws_region").(string))
+	if basePathOverride, ok := data.GetOk("api_base_path"); ok {
+		basePath = strings.TrimSuffix(basePathOverride.(string), "/")
+	}
 
 	config.BasePath = basePath
 	if data.Get("sdk_debug").(bool) {
@@ -326,6 +335,9 @@ func AuthorizeSdk() (*platformclientv2.Configuration, error) {
 	}
 
 	sdkConfig.BasePath = GetRegionBasePath(os.Getenv("GENESYSCLOUD_REGION"))
+	if basePathOverride := os.Getenv("GENESYSCLOUD_API_BASE_PATH"); basePathOverride != "" {
+		sdkConfig.BasePath = strings.TrimSuffix(basePathOverride, "/")
+	}
 
 	err := sdkConfig.AuthorizeClientCredentials(os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"), os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"))
 	if err != nil {
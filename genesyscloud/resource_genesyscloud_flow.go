@@ -1,12 +1,16 @@
 package genesyscloud
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,9 +19,11 @@ import (
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
 
@@ -54,6 +60,8 @@ func FlowExporter() *resourceExporter.ResourceExporter {
 		CustomFlowResolver: map[string]*resourceExporter.CustomFlowResolver{
 			"file_content_hash": {ResolverFunc: resourceExporter.FileContentHashResolver},
 		},
+		// Versions only exist in the source org, so the exported flow publishes the version created by its own upload
+		ExcludedAttributes: []string{"versions", "published_version"},
 	}
 }
 
@@ -68,6 +76,7 @@ func ResourceFlow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeFlowDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"filepath": {
@@ -92,10 +101,92 @@ func ResourceFlow() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"template": {
+				Description: "Renders the flow configuration file with Go's text/template engine before it is uploaded. Variables are referenced as {{ .name }} and support conditionals, loops and includes of shared YAML fragments. Substitutions are also exposed as template variables.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        flowTemplateResource,
+			},
+			"template_includes_hash": {
+				Description: "Hash of the files listed in template.includes. It is recalculated on every plan, so editing an include uploads the flow again even though file_content_hash is unchanged.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version": {
+				Description: "Version ID of the flow that should be published, e.g. '3.0'. Setting this pins the flow to that version, and setting it to an earlier version rolls the flow back without uploading the configuration file again. If not set, the version published by the latest upload is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"versions": {
+				Description: fmt.Sprintf("The latest %d versions of the flow, newest first.", maxFlowVersions),
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        flowVersionResource,
+			},
 		},
 	}
 }
 
+// maxFlowVersions is the number of versions kept in the versions attribute
+const maxFlowVersions = 10
+
+var flowVersionResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "Version ID.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"commit_version": {
+			Description: "Commit version.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"configuration_version": {
+			Description: "Configuration version.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"date_created": {
+			Description: "Date the version was created in ISO-8601 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"published": {
+			Description: "Whether this is the currently published version.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	},
+}
+
+var flowTemplateResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"variables_json": {
+			Description:      "JSON object of template variables. Values may be nested lists and objects so they can be used with range and if actions.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "{}",
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: SuppressEquivalentJsonDiffs,
+		},
+		"includes": {
+			Description: "File paths of shared YAML fragments. Each fragment is registered as a named template using its file name without extension, e.g. {{ include \"greeting\" . | indent 4 }}.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: ValidatePath},
+		},
+		"strict": {
+			Description: "Fail the flow update when the template references a variable that has not been supplied.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+	},
+}
+
 func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	architectAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
@@ -109,6 +200,22 @@ func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			return retry.NonRetryableError(fmt.Errorf("Failed to read flow %s: %s", d.Id(), err))
 		}
 
+		publishedVersionId := ""
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			publishedVersionId = *flow.PublishedVersion.Id
+		}
+
+		versions, resp, err := getFlowVersions(architectAPI, d.Id())
+		if err != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read versions of flow %s: %s", d.Id(), err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read versions of flow %s: %s", d.Id(), err))
+		}
+
+		_ = d.Set("published_version", publishedVersionId)
+		_ = d.Set("versions", flattenFlowVersions(versions, publishedVersionId))
+
 		log.Printf("Read flow %s %s", d.Id(), *flow.Name)
 		return nil
 	})
@@ -146,6 +253,37 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating flow")
 
+	// Changes to the version management attributes alone do not require a new upload
+	uploaded := false
+	if d.Id() == "" || d.HasChanges("filepath", "file_content_hash", "substitutions", "template", "template_includes_hash") {
+		if diagErr := uploadFlow(ctx, d, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+		uploaded = true
+	}
+
+	if diagErr := publishPinnedFlowVersion(ctx, d, architectAPI, uploaded); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated flow %s. ", d.Id())
+	return readFlow(ctx, d, meta)
+}
+
+// uploadFlow uploads the flow configuration file through an Architect job and sets the ID of the resulting flow
+func uploadFlow(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	filePath := d.Get("filepath").(string)
+	substitutions := d.Get("substitutions").(map[string]interface{})
+
+	// Render the template before registering a job so that missing variables fail fast
+	reader, err := buildFlowReader(d, filePath, substitutions)
+	if err != nil {
+		setFileContentHashToNil(d)
+		return diag.Errorf(err.Error())
+	}
+
 	//Check to see if we need to force and unlock on an architect flow
 	if isForceUnlockEnabled(d) {
 		err := forceUnlockFlow(d.Id(), sdkConfig)
@@ -171,15 +309,6 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	jobId := *flowJob.Id
 	headers := *flowJob.Headers
 
-	filePath := d.Get("filepath").(string)
-	substitutions := d.Get("substitutions").(map[string]interface{})
-
-	reader, _, err := files.DownloadOrOpenFile(filePath)
-	if err != nil {
-		setFileContentHashToNil(d)
-		return diag.Errorf(err.Error())
-	}
-
 	s3Uploader := files.NewS3Uploader(reader, nil, substitutions, headers, "PUT", presignedUrl)
 	_, err = s3Uploader.Upload()
 	if err != nil {
@@ -228,8 +357,185 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	d.SetId(flowID)
 
-	log.Printf("Updated flow %s. ", d.Id())
-	return readFlow(ctx, d, meta)
+	log.Printf("Uploaded flow %s. ", d.Id())
+	return nil
+}
+
+// publishPinnedFlowVersion publishes the version set in published_version if it is not the currently published version.
+// An upload always publishes the uploaded version, so a configured pin is re-applied after every upload.
+func publishPinnedFlowVersion(ctx context.Context, d *schema.ResourceData, architectAPI *platformclientv2.ArchitectApi, uploaded bool) diag.Diagnostics {
+	rawPinnedVersion := d.GetRawConfig().GetAttr("published_version")
+	if rawPinnedVersion.IsNull() || !rawPinnedVersion.IsKnown() {
+		return nil
+	}
+	if !uploaded && !d.HasChange("published_version") {
+		return nil
+	}
+	pinnedVersion := d.Get("published_version").(string)
+	if pinnedVersion == "" {
+		return nil
+	}
+
+	flow, _, err := architectAPI.GetFlow(d.Id(), false)
+	if err != nil {
+		return diag.Errorf("Failed to read flow %s: %s", d.Id(), err)
+	}
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil && *flow.PublishedVersion.Id == pinnedVersion {
+		return nil
+	}
+
+	log.Printf("Publishing version %s of flow %s", pinnedVersion, d.Id())
+	if _, _, err := architectAPI.PostFlowsActionsPublish(d.Id(), pinnedVersion); err != nil {
+		return diag.Errorf("Failed to publish version %s of flow %s: %s", pinnedVersion, d.Id(), err)
+	}
+
+	// Publishing is asynchronous, wait until the flow reports the pinned version as published
+	return WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		flow, _, err := architectAPI.GetFlow(d.Id(), false)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read flow %s: %s", d.Id(), err))
+		}
+		if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil || *flow.PublishedVersion.Id != pinnedVersion {
+			time.Sleep(5 * time.Second)
+			return retry.RetryableError(fmt.Errorf("Version %s of flow %s has not been published yet", pinnedVersion, d.Id()))
+		}
+		return nil
+	})
+}
+
+// getFlowVersions returns the latest versions of a flow, newest first. Only the first page is read so that a flow with a
+// long history does not slow down every refresh or grow the state without limit.
+func getFlowVersions(architectAPI *platformclientv2.ArchitectApi, flowId string) ([]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	versionListing, resp, err := architectAPI.GetFlowVersions(flowId, 1, maxFlowVersions, false)
+	if err != nil {
+		return nil, resp, err
+	}
+	if versionListing.Entities == nil {
+		return nil, resp, nil
+	}
+	return latestFlowVersions(*versionListing.Entities), resp, nil
+}
+
+// latestFlowVersions sorts versions newest first and keeps at most maxFlowVersions of them. Version IDs have the form
+// "<major>.0", so they are compared as numbers.
+func latestFlowVersions(versions []platformclientv2.Flowversion) []platformclientv2.Flowversion {
+	sorted := append([]platformclientv2.Flowversion(nil), versions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return flowVersionNumber(sorted[i]) > flowVersionNumber(sorted[j])
+	})
+	if len(sorted) > maxFlowVersions {
+		sorted = sorted[:maxFlowVersions]
+	}
+	return sorted
+}
+
+func flowVersionNumber(version platformclientv2.Flowversion) float64 {
+	if version.Id == nil {
+		return 0
+	}
+	number, err := strconv.ParseFloat(*version.Id, 64)
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+func flattenFlowVersions(versions []platformclientv2.Flowversion, publishedVersionId string) []interface{} {
+	flattened := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		versionMap := make(map[string]interface{})
+		if version.Id != nil {
+			versionMap["id"] = *version.Id
+			versionMap["published"] = *version.Id == publishedVersionId
+		}
+		if version.CommitVersion != nil {
+			versionMap["commit_version"] = *version.CommitVersion
+		}
+		if version.ConfigurationVersion != nil {
+			versionMap["configuration_version"] = *version.ConfigurationVersion
+		}
+		if version.DateCreated != nil {
+			versionMap["date_created"] = time.UnixMilli(int64(*version.DateCreated)).UTC().Format(time.RFC3339)
+		}
+		flattened = append(flattened, versionMap)
+	}
+	return flattened
+}
+
+// buildFlowReader opens the flow configuration file and, if a template block is configured, renders it
+func buildFlowReader(d *schema.ResourceData, filePath string, substitutions map[string]interface{}) (io.Reader, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	templateList := d.Get("template").([]interface{})
+	if len(templateList) == 0 || templateList[0] == nil {
+		return reader, nil
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	flowTemplate, err := buildFlowTemplate(templateList[0].(map[string]interface{}), substitutions)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := flowTemplate.Render(filepath.Base(filePath), reader)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(rendered), nil
+}
+
+// customizeFlowDiff hashes the template includes so that a change to an include is planned as a new upload.
+// file_content_hash only covers the main configuration file.
+func customizeFlowDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("template.0.includes") {
+		return diff.SetNewComputed("template_includes_hash")
+	}
+
+	includes := make([]string, 0)
+	if templateList, ok := diff.Get("template").([]interface{}); ok && len(templateList) > 0 && templateList[0] != nil {
+		includes = lists.InterfaceListToStrings(templateList[0].(map[string]interface{})["includes"].([]interface{}))
+	}
+
+	includesHash := ""
+	if len(includes) > 0 {
+		var err error
+		if includesHash, err = files.HashIncludes(includes); err != nil {
+			return err
+		}
+	}
+
+	if diff.Get("template_includes_hash").(string) != includesHash {
+		return diff.SetNew("template_includes_hash", includesHash)
+	}
+	return nil
+}
+
+func buildFlowTemplate(templateMap map[string]interface{}, substitutions map[string]interface{}) (*files.FlowTemplate, error) {
+	variables := make(map[string]interface{})
+	for k, v := range substitutions {
+		variables[k] = v
+	}
+
+	if variablesJson, ok := templateMap["variables_json"].(string); ok && variablesJson != "" {
+		jsonVariables := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(variablesJson), &jsonVariables); err != nil {
+			return nil, fmt.Errorf("failed to parse template variables_json: %s", err)
+		}
+		for k, v := range jsonVariables {
+			variables[k] = v
+		}
+	}
+
+	return &files.FlowTemplate{
+		Variables: variables,
+		Includes:  lists.InterfaceListToStrings(templateMap["includes"].([]interface{})),
+		Strict:    templateMap["strict"].(bool),
+	}, nil
 }
 
 func deleteFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -295,4 +601,6 @@ func updateFile(filepath, content string) {
 // in the file content hash and re-attempt an update, should the user re-run terraform apply without making changes to the file contents
 func setFileContentHashToNil(d *schema.ResourceData) {
 	_ = d.Set("file_content_hash", nil)
+	// Also clear the includes hash so that the next plan retries the upload
+	_ = d.Set("template_includes_hash", nil)
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -522,3 +523,81 @@ func testVerifyFlowDestroyed(state *terraform.State) error {
 	// Success. All Flows destroyed
 	return nil
 }
+
+// TestUnitFlattenFlowVersions verifies that versions are kept newest first, capped and flagged as published
+func TestUnitFlattenFlowVersions(t *testing.T) {
+	versions := make([]platformclientv2.Flowversion, 0)
+	for i := 1; i <= maxFlowVersions+2; i++ {
+		versions = append(versions, platformclientv2.Flowversion{Id: platformclientv2.String(fmt.Sprintf("%d.0", i))})
+	}
+
+	flattened := flattenFlowVersions(latestFlowVersions(versions), "11.0")
+	if len(flattened) != maxFlowVersions {
+		t.Fatalf("Expected %d versions, got %d", maxFlowVersions, len(flattened))
+	}
+
+	ids := make([]string, 0)
+	for _, version := range flattened {
+		versionMap := version.(map[string]interface{})
+		ids = append(ids, versionMap["id"].(string))
+		if published := versionMap["published"].(bool); published != (versionMap["id"] == "11.0") {
+			t.Errorf("Expected only version 11.0 to be published, got published=%v for %s", published, versionMap["id"])
+		}
+	}
+	expected := "12.0,11.0,10.0,9.0,8.0,7.0,6.0,5.0,4.0,3.0"
+	if strings.Join(ids, ",") != expected {
+		t.Errorf("Expected versions %s, got %s", expected, strings.Join(ids, ","))
+	}
+}
+
+// TestUnitFlowTemplateIncludesDiff verifies that editing a template include plans a new upload
+func TestUnitFlowTemplateIncludesDiff(t *testing.T) {
+	includePath := filepath.Join(t.TempDir(), "greeting.yaml")
+	if err := os.WriteFile(includePath, []byte("tts: Hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"filepath":          "flow.yaml",
+		"file_content_hash": "abc",
+		"template": []interface{}{
+			map[string]interface{}{"includes": []interface{}{includePath}},
+		},
+	})
+	planIncludesHash := func(state *terraform.InstanceState) string {
+		instanceDiff, err := ResourceFlow().Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if instanceDiff == nil || instanceDiff.Attributes["template_includes_hash"] == nil {
+			return ""
+		}
+		return instanceDiff.Attributes["template_includes_hash"].New
+	}
+
+	includesHash := planIncludesHash(&terraform.InstanceState{ID: "flow-id", Attributes: map[string]string{}})
+	if includesHash == "" {
+		t.Fatal("Expected a hash of the template includes on create")
+	}
+
+	state := &terraform.InstanceState{ID: "flow-id", Attributes: map[string]string{
+		"filepath":                  "flow.yaml",
+		"file_content_hash":         "abc",
+		"template.#":                "1",
+		"template.0.includes.#":     "1",
+		"template.0.includes.0":     includePath,
+		"template.0.variables_json": "{}",
+		"template.0.strict":         "true",
+		"template_includes_hash":    includesHash,
+	}}
+	if newHash := planIncludesHash(state); newHash != "" {
+		t.Errorf("Expected no change to the includes hash, got %s", newHash)
+	}
+
+	if err := os.WriteFile(includePath, []byte("tts: Goodbye"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if newHash := planIncludesHash(state); newHash == "" || newHash == includesHash {
+		t.Errorf("Expected a new includes hash after editing the include, got %q", newHash)
+	}
+}
@@ -12,9 +12,11 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
 
@@ -35,6 +37,34 @@ var (
 	}
 )
 
+var emailRouteSignatureResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Description: "A toggle to enable the signature on email send.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"canned_response_id": {
+			Description: "The identifier referring to an email signature canned response.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"always_included": {
+			Description: "A toggle that defines if a signature is always included or only set on the first email in an email chain.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"inclusion_type": {
+			Description:  "The configuration to indicate when the signature of a conversation has to be included. Valid values: Draft | Send | SendOnce.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Draft", "Send", "SendOnce"}, false),
+		},
+	},
+}
+
 func getAllRoutingEmailRoutes(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
 	resources := make(resourceExporter.ResourceIDMetaMap)
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
@@ -89,6 +119,7 @@ func RoutingEmailRouteExporter() *resourceExporter.ResourceExporter {
 			"spam_flow_id":                  {RefType: "genesyscloud_flow"},
 			"reply_email_address.domain_id": {RefType: "genesyscloud_routing_email_domain"},
 			"reply_email_address.route_id":  {RefType: "genesyscloud_routing_email_route"},
+			"signature.canned_response_id":  {RefType: "genesyscloud_responsemanagement_response"},
 		},
 		RemoveIfMissing: map[string][]string{
 			"reply_email_address": {"route_id"},
@@ -196,6 +227,26 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"signature": {
+				Description: "The configuration for the canned response signature that will be appended to outbound emails sent via this route.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        emailRouteSignatureResource,
+			},
+			"history_inclusion": {
+				Description:  "The configuration to indicate how the history of a conversation has to be included in a draft. Valid values: Include | Exclude | Optional.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Include", "Exclude", "Optional"}, false),
+			},
+			"allow_multiple_actions": {
+				Description: "Control if multiple actions are allowed on this route. When true the disconnect has to be done manually. When false a conversation will be disconnected by the system after every action.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -226,18 +277,24 @@ func createRoutingEmailRoute(ctx context.Context, d *schema.ResourceData, meta i
 	if err := validateSdkReplyEmailAddress(d); err != nil {
 		return diag.Errorf("Error occurred while validating the reply email address when creating the record: %s", err)
 	}
+	if err := validateReplyEmailRouteExists(routingAPI, replyDomainID, replyRouteID); err != nil {
+		return diag.Errorf("Error occurred while validating the reply email address when creating the record: %s", err)
+	}
 
 	sdkRoute := platformclientv2.Inboundroute{
-		Pattern:   &pattern,
-		FromName:  &fromName,
-		FromEmail: &fromEmail,
-		Queue:     BuildSdkDomainEntityRef(d, "queue_id"),
-		Priority:  &priority,
-		Language:  BuildSdkDomainEntityRef(d, "language_id"),
-		Flow:      BuildSdkDomainEntityRef(d, "flow_id"),
-		SpamFlow:  BuildSdkDomainEntityRef(d, "spam_flow_id"),
-		Skills:    BuildSdkDomainEntityRefArr(d, "skill_ids"),
-		AutoBcc:   buildSdkAutoBccEmailAddresses(d),
+		Pattern:              &pattern,
+		FromName:             &fromName,
+		FromEmail:            &fromEmail,
+		Queue:                BuildSdkDomainEntityRef(d, "queue_id"),
+		Priority:             &priority,
+		Language:             BuildSdkDomainEntityRef(d, "language_id"),
+		Flow:                 BuildSdkDomainEntityRef(d, "flow_id"),
+		SpamFlow:             BuildSdkDomainEntityRef(d, "spam_flow_id"),
+		Skills:               BuildSdkDomainEntityRefArr(d, "skill_ids"),
+		AutoBcc:              buildSdkAutoBccEmailAddresses(d),
+		Signature:            buildSdkEmailRouteSignature(d),
+		HistoryInclusion:     resourcedata.GetNillableValue[string](d, "history_inclusion"),
+		AllowMultipleActions: resourcedata.GetNillableBool(d, "allow_multiple_actions"),
 	}
 
 	//If the isSelfReferenceRoute() is set to false, we use the route id provided by the terraform script
@@ -389,6 +446,10 @@ func readRoutingEmailRoute(ctx context.Context, d *schema.ResourceData, meta int
 			d.Set("auto_bcc", nil)
 		}
 
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "signature", route.Signature, flattenEmailRouteSignature)
+		resourcedata.SetNillableValue(d, "history_inclusion", route.HistoryInclusion)
+		resourcedata.SetNillableValue(d, "allow_multiple_actions", route.AllowMultipleActions)
+
 		log.Printf("Read routing email route %s", d.Id())
 		return cc.CheckState()
 	})
@@ -411,19 +472,25 @@ func updateRoutingEmailRoute(ctx context.Context, d *schema.ResourceData, meta i
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 
 	replyDomainID, replyRouteID, _ := extractReplyEmailAddressValue(d)
+	if err := validateReplyEmailRouteExists(routingAPI, replyDomainID, replyRouteID); err != nil {
+		return diag.Errorf("Error occurred while validating the reply email address while trying to update the record: %s", err)
+	}
 
 	sdkRoute := platformclientv2.Inboundroute{
-		Id:        &id,
-		Pattern:   &pattern,
-		FromName:  &fromName,
-		FromEmail: &fromEmail,
-		Queue:     BuildSdkDomainEntityRef(d, "queue_id"),
-		Priority:  &priority,
-		Language:  BuildSdkDomainEntityRef(d, "language_id"),
-		Flow:      BuildSdkDomainEntityRef(d, "flow_id"),
-		SpamFlow:  BuildSdkDomainEntityRef(d, "spam_flow_id"),
-		Skills:    BuildSdkDomainEntityRefArr(d, "skill_ids"),
-		AutoBcc:   buildSdkAutoBccEmailAddresses(d),
+		Id:                   &id,
+		Pattern:              &pattern,
+		FromName:             &fromName,
+		FromEmail:            &fromEmail,
+		Queue:                BuildSdkDomainEntityRef(d, "queue_id"),
+		Priority:             &priority,
+		Language:             BuildSdkDomainEntityRef(d, "language_id"),
+		Flow:                 BuildSdkDomainEntityRef(d, "flow_id"),
+		SpamFlow:             BuildSdkDomainEntityRef(d, "spam_flow_id"),
+		Skills:               BuildSdkDomainEntityRefArr(d, "skill_ids"),
+		AutoBcc:              buildSdkAutoBccEmailAddresses(d),
+		Signature:            buildSdkEmailRouteSignature(d),
+		HistoryInclusion:     resourcedata.GetNillableValue[string](d, "history_inclusion"),
+		AllowMultipleActions: resourcedata.GetNillableBool(d, "allow_multiple_actions"),
 	}
 
 	if isSelfReferenceRouteSet(d) {
@@ -502,6 +569,23 @@ func validateSdkReplyEmailAddress(d *schema.ResourceData) error {
 	return nil
 }
 
+// validateReplyEmailRouteExists checks that the reply route belongs to the reply domain, since the API does not
+// reject replies pointing at a route of another domain
+func validateReplyEmailRouteExists(routingAPI *platformclientv2.RoutingApi, domainID string, routeID string) error {
+	if domainID == "" || routeID == "" {
+		return nil
+	}
+
+	_, resp, err := routingAPI.GetRoutingEmailDomainRoute(domainID, routeID)
+	if err != nil {
+		if IsStatus404(resp) {
+			return fmt.Errorf("reply email address route %s does not exist in domain %s", routeID, domainID)
+		}
+		return fmt.Errorf("failed to read reply email address route %s in domain %s: %s", routeID, domainID, err)
+	}
+	return nil
+}
+
 func extractReplyEmailAddressValue(d *schema.ResourceData) (string, string, bool) {
 	replyEmailAddress := d.Get("reply_email_address").([]interface{})
 	if replyEmailAddress != nil && len(replyEmailAddress) > 0 {
@@ -558,3 +642,33 @@ func flattenAutoBccEmailAddresses(addresses []platformclientv2.Emailaddress) *sc
 	}
 	return addressSet
 }
+
+func buildSdkEmailRouteSignature(d *schema.ResourceData) *platformclientv2.Signature {
+	signatureList := d.Get("signature").([]interface{})
+	if len(signatureList) == 0 || signatureList[0] == nil {
+		return nil
+	}
+	signatureMap := signatureList[0].(map[string]interface{})
+
+	enabled := signatureMap["enabled"].(bool)
+	cannedResponseId := signatureMap["canned_response_id"].(string)
+	alwaysIncluded := signatureMap["always_included"].(bool)
+	signature := platformclientv2.Signature{
+		Enabled:          &enabled,
+		CannedResponseId: &cannedResponseId,
+		AlwaysIncluded:   &alwaysIncluded,
+	}
+	if inclusionType := signatureMap["inclusion_type"].(string); inclusionType != "" {
+		signature.InclusionType = &inclusionType
+	}
+	return &signature
+}
+
+func flattenEmailRouteSignature(signature *platformclientv2.Signature) []interface{} {
+	signatureMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(signatureMap, "enabled", signature.Enabled)
+	resourcedata.SetMapValueIfNotNil(signatureMap, "canned_response_id", signature.CannedResponseId)
+	resourcedata.SetMapValueIfNotNil(signatureMap, "always_included", signature.AlwaysIncluded)
+	resourcedata.SetMapValueIfNotNil(signatureMap, "inclusion_type", signature.InclusionType)
+	return []interface{}{signatureMap}
+}
@@ -3,6 +3,8 @@ package genesyscloud
 import (
 	"fmt"
 	"github.com/google/uuid"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -244,6 +246,113 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingEmailRouteSignatureAndHistory(t *testing.T) {
+	var (
+		domainRes        = "routing-domain-signature"
+		domainId         = fmt.Sprintf("terraform.%s.com", strings.Replace(uuid.NewString(), "-", "", -1))
+		libraryResource  = "signature-library"
+		libraryName      = "Terraform Signature Library " + uuid.NewString()
+		responseResource = "signature-response"
+		responseName     = "Terraform Signature " + uuid.NewString()
+		routeRes         = "email-route-signature"
+		routeFullName    = "genesyscloud_routing_email_route." + routeRes
+		routePattern     = "terraformsignature"
+		fromEmail        = "terraform-signature@test.com"
+		fromName         = "John Terraform"
+	)
+
+	baseConfig := GenerateRoutingEmailDomainResource(
+		domainRes,
+		domainId,
+		FalseValue,
+		NullValue,
+	) + generateResponseManagementLibraryResource(
+		libraryResource,
+		libraryName,
+	) + generateResponseManagementResponseResource(
+		responseResource,
+		responseName,
+		[]string{"genesyscloud_responsemanagement_library." + libraryResource + ".id"},
+		NullValue,
+		NullValue,
+		NullValue,
+		[]string{},
+		generateTextsBlock("Kind regards, the support team", "text/plain"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create a route with a signature and history settings
+				Config: baseConfig + generateRoutingEmailRouteResource(
+					routeRes,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					fromEmail,
+					generateRoutingEmailSignature(TrueValue, "genesyscloud_responsemanagement_response."+responseResource+".id", FalseValue, strconv.Quote("Draft")),
+					`history_inclusion = "Include"`,
+					`allow_multiple_actions = true`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.enabled", TrueValue),
+					resource.TestCheckResourceAttrPair(routeFullName, "signature.0.canned_response_id", "genesyscloud_responsemanagement_response."+responseResource, "id"),
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.always_included", FalseValue),
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.inclusion_type", "Draft"),
+					resource.TestCheckResourceAttr(routeFullName, "history_inclusion", "Include"),
+					resource.TestCheckResourceAttr(routeFullName, "allow_multiple_actions", TrueValue),
+				),
+			},
+			{
+				// Update the signature and history settings
+				Config: baseConfig + generateRoutingEmailRouteResource(
+					routeRes,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					fromEmail,
+					generateRoutingEmailSignature(FalseValue, "genesyscloud_responsemanagement_response."+responseResource+".id", TrueValue, strconv.Quote("Send")),
+					`history_inclusion = "Exclude"`,
+					`allow_multiple_actions = false`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.enabled", FalseValue),
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.always_included", TrueValue),
+					resource.TestCheckResourceAttr(routeFullName, "signature.0.inclusion_type", "Send"),
+					resource.TestCheckResourceAttr(routeFullName, "history_inclusion", "Exclude"),
+					resource.TestCheckResourceAttr(routeFullName, "allow_multiple_actions", FalseValue),
+				),
+			},
+			{
+				// A reply route must exist in the reply domain
+				Config: baseConfig + generateRoutingEmailRouteResource(
+					routeRes,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					fromEmail,
+					generateRoutingReplyEmail(
+						false,
+						"genesyscloud_routing_email_domain."+domainRes+".id",
+						strconv.Quote(uuid.NewString()),
+					),
+				),
+				ExpectError: regexp.MustCompile("does not exist in domain"),
+			},
+			{
+				// Import/Read
+				ResourceName:        routeFullName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: domainId + "/",
+			},
+		},
+		CheckDestroy: testVerifyRoutingEmailRouteDestroyed,
+	})
+}
+
 func generateRoutingEmailRouteResource(
 	resourceID string,
 	domainID string,
@@ -285,6 +394,21 @@ func generateRoutingAutoBcc(
 	`, name, email)
 }
 
+func generateRoutingEmailSignature(
+	enabled string,
+	cannedResponseId string,
+	alwaysIncluded string,
+	inclusionType string) string {
+	return fmt.Sprintf(`
+        signature {
+            enabled = %s
+            canned_response_id = %s
+            always_included = %s
+            inclusion_type = %s
+        }
+	`, enabled, cannedResponseId, alwaysIncluded, inclusionType)
+}
+
 func generateRoutingReplyEmail(
 	selfReferenceRoute bool,
 	domainID string,
@@ -172,22 +172,24 @@ func RoutingQueueExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: GetAllWithPooledClient(getAllRoutingQueues),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
-			"division_id":                              {RefType: "genesyscloud_auth_division"},
-			"queue_flow_id":                            {RefType: "genesyscloud_flow"},
-			"email_in_queue_flow_id":                   {RefType: "genesyscloud_flow"},
-			"message_in_queue_flow_id":                 {RefType: "genesyscloud_flow"},
-			"whisper_prompt_id":                        {RefType: "genesyscloud_architect_user_prompt"},
-			"outbound_messaging_sms_address_id":        {},                               // Ref type not yet defined
-			"default_script_ids.*":                     {RefType: "genesyscloud_script"}, // Ref type not yet defined
-			"outbound_email_address.route_id":          {RefType: "genesyscloud_routing_email_route"},
-			"outbound_email_address.domain_id":         {RefType: "genesyscloud_routing_email_domain"},
-			"bullseye_rings.skills_to_remove":          {RefType: "genesyscloud_routing_skill"},
-			"members.user_id":                          {RefType: "genesyscloud_user"},
-			"wrapup_codes":                             {RefType: "genesyscloud_routing_wrapupcode"},
-			"skill_groups":                             {RefType: "genesyscloud_routing_skill_group"},
-			"teams":                                    {RefType: "genesyscloud_team"},
-			"groups":                                   {RefType: "genesyscloud_group"},
-			"conditional_group_routing_rules.queue_id": {RefType: "genesyscloud_routing_queue"},
+			"division_id":                                    {RefType: "genesyscloud_auth_division"},
+			"queue_flow_id":                                  {RefType: "genesyscloud_flow"},
+			"email_in_queue_flow_id":                         {RefType: "genesyscloud_flow"},
+			"message_in_queue_flow_id":                       {RefType: "genesyscloud_flow"},
+			"whisper_prompt_id":                              {RefType: "genesyscloud_architect_user_prompt"},
+			"outbound_messaging_sms_address_id":              {}, // Ref type not yet defined
+			"outbound_messaging_open_messaging_recipient_id": {RefType: "genesyscloud_routing_message_address_open"},
+			"outbound_messaging_whatsapp_recipient_id":       {RefType: "genesyscloud_routing_message_address_whatsapp"},
+			"default_script_ids.*":                           {RefType: "genesyscloud_script"}, // Ref type not yet defined
+			"outbound_email_address.route_id":                {RefType: "genesyscloud_routing_email_route"},
+			"outbound_email_address.domain_id":               {RefType: "genesyscloud_routing_email_domain"},
+			"bullseye_rings.skills_to_remove":                {RefType: "genesyscloud_routing_skill"},
+			"members.user_id":                                {RefType: "genesyscloud_user"},
+			"wrapup_codes":                                   {RefType: "genesyscloud_routing_wrapupcode"},
+			"skill_groups":                                   {RefType: "genesyscloud_routing_skill_group"},
+			"teams":                                          {RefType: "genesyscloud_team"},
+			"groups":                                         {RefType: "genesyscloud_group"},
+			"conditional_group_routing_rules.queue_id":       {RefType: "genesyscloud_routing_queue"},
 		},
 		RemoveIfMissing: map[string][]string{
 			"outbound_email_address": {"route_id"},
@@ -460,6 +462,16 @@ func ResourceRoutingQueue() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"outbound_messaging_open_messaging_recipient_id": {
+				Description: "The unique ID of the outbound Open Messaging address for the queue. This is the ID of a `genesyscloud_routing_message_address_open` resource.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"outbound_messaging_whatsapp_recipient_id": {
+				Description: "The unique ID of the outbound WhatsApp address for the queue. This is the ID of a `genesyscloud_routing_message_address_whatsapp` resource.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"outbound_email_address": {
 				Description: "The outbound email address settings for this queue.",
 				Type:        schema.TypeList,
@@ -481,12 +493,20 @@ func ResourceRoutingQueue() *schema.Resource {
 				},
 			},
 			"members": {
-				Description: "Users in the queue. If not set, this resource will not manage members.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				ConfigMode:  schema.SchemaConfigModeAttr,
-				Elem:        queueMemberResource,
+				Description:   "Users in the queue. If not set, this resource will not manage members. Only users added directly are managed; members that come from groups, teams and skill groups are never removed.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConfigMode:    schema.SchemaConfigModeAttr,
+				Elem:          queueMemberResource,
+				ConflictsWith: []string{"ignore_members"},
+			},
+			"ignore_members": {
+				Description:   "If true, this resource neither reads nor changes the members of the queue. Use this when the members are managed with genesyscloud_routing_queue_member.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"members"},
 			},
 			"wrapup_codes": {
 				Description: "IDs of wrapup codes assigned to this queue. If not set, this resource will not manage wrapup codes.",
@@ -496,11 +516,19 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"direct_routing": {
-				Description: "Used by the System to set Direct Routing settings for a system Direct Routing queue.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Elem:        directRoutingResource,
+				Description:   "Used by the System to set Direct Routing settings for a system Direct Routing queue.",
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				Elem:          directRoutingResource,
+				ConflictsWith: []string{"ignore_direct_routing"},
+			},
+			"ignore_direct_routing": {
+				Description:   "If true, this resource neither reads nor changes the Direct Routing settings of the queue. Use this when they are managed with genesyscloud_routing_direct_routing_settings. The two must not both manage the Direct Routing settings of the same queue.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"direct_routing"},
 			},
 			"skill_groups": {
 				Description: "List of skill group ids assigned to the queue.",
@@ -697,6 +725,18 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("outbound_messaging_sms_address_id", nil)
 		}
 
+		if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.OpenMessagingRecipient != nil {
+			d.Set("outbound_messaging_open_messaging_recipient_id", *currentQueue.OutboundMessagingAddresses.OpenMessagingRecipient.Id)
+		} else {
+			d.Set("outbound_messaging_open_messaging_recipient_id", nil)
+		}
+
+		if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.WhatsAppRecipient != nil {
+			d.Set("outbound_messaging_whatsapp_recipient_id", *currentQueue.OutboundMessagingAddresses.WhatsAppRecipient.Id)
+		} else {
+			d.Set("outbound_messaging_whatsapp_recipient_id", nil)
+		}
+
 		if currentQueue.OutboundEmailAddress != nil && *currentQueue.OutboundEmailAddress != nil {
 			outboundEmailAddress := *currentQueue.OutboundEmailAddress
 			d.Set("outbound_email_address", []interface{}{flattenQueueEmailAddress(*outboundEmailAddress)})
@@ -704,7 +744,7 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("outbound_email_address", nil)
 		}
 
-		if currentQueue.DirectRouting != nil {
+		if currentQueue.DirectRouting != nil && !d.Get("ignore_direct_routing").(bool) {
 			d.Set("direct_routing", []interface{}{flattenDirectRouting(*currentQueue.DirectRouting)})
 		} else {
 			d.Set("direct_routing", nil)
@@ -716,11 +756,15 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		}
 		d.Set("wrapup_codes", wrapupCodes)
 
-		members, err := flattenQueueMembers(d.Id(), "user", routingAPI)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("%v", err))
+		if d.Get("ignore_members").(bool) {
+			d.Set("members", nil)
+		} else {
+			members, err := flattenQueueMembers(d.Id(), "user", routingAPI)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", err))
+			}
+			d.Set("members", members)
 		}
-		d.Set("members", members)
 
 		skillgroup := "SKILLGROUP"
 		team := "TEAM"
@@ -752,6 +796,11 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diagErr
 	}
 
+	directRouting, diagErr := buildSdkDirectRoutingForUpdate(d, routingAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
 	updateQueue := platformclientv2.Queuerequest{
 		Name:                         platformclientv2.String(d.Get("name").(string)),
 		Description:                  platformclientv2.String(d.Get("description").(string)),
@@ -774,7 +823,7 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
 		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
 		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
-		DirectRouting:                buildSdkDirectRouting(d),
+		DirectRouting:                directRouting,
 		MemberGroups:                 &memberGroups,
 	}
 
@@ -1248,12 +1297,15 @@ func validateMapCommTypes(val interface{}, _ cty.Path) diag.Diagnostics {
 }
 
 func buildSdkQueueMessagingAddresses(d *schema.ResourceData) *platformclientv2.Queuemessagingaddresses {
-	if _, ok := d.GetOk("outbound_messaging_sms_address_id"); ok {
-		return &platformclientv2.Queuemessagingaddresses{
-			SmsAddress: BuildSdkDomainEntityRef(d, "outbound_messaging_sms_address_id"),
-		}
+	messagingAddresses := platformclientv2.Queuemessagingaddresses{
+		SmsAddress:             BuildSdkDomainEntityRef(d, "outbound_messaging_sms_address_id"),
+		OpenMessagingRecipient: BuildSdkDomainEntityRef(d, "outbound_messaging_open_messaging_recipient_id"),
+		WhatsAppRecipient:      BuildSdkDomainEntityRef(d, "outbound_messaging_whatsapp_recipient_id"),
 	}
-	return nil
+	if messagingAddresses.SmsAddress == nil && messagingAddresses.OpenMessagingRecipient == nil && messagingAddresses.WhatsAppRecipient == nil {
+		return nil
+	}
+	return &messagingAddresses
 }
 
 func buildSdkQueueEmailAddress(d *schema.ResourceData) *platformclientv2.Queueemailaddress {
@@ -1320,6 +1372,19 @@ func buildSdkDirectRouting(d *schema.ResourceData) *platformclientv2.Directrouti
 	return nil
 }
 
+// buildSdkDirectRoutingForUpdate returns the Direct Routing settings to send with a queue update. The queue is replaced
+// as a whole, so when ignore_direct_routing is set the current settings are read and sent back unchanged.
+func buildSdkDirectRoutingForUpdate(d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) (*platformclientv2.Directrouting, diag.Diagnostics) {
+	if !d.Get("ignore_direct_routing").(bool) {
+		return buildSdkDirectRouting(d), nil
+	}
+	currentQueue, _, err := routingAPI.GetRoutingQueue(d.Id())
+	if err != nil {
+		return nil, diag.Errorf("Failed to read Direct Routing settings of queue %s: %s", d.Id(), err)
+	}
+	return currentQueue.DirectRouting, nil
+}
+
 func flattenDirectRouting(settings platformclientv2.Directrouting) map[string]interface{} {
 	settingsMap := make(map[string]interface{})
 
@@ -1434,6 +1499,9 @@ func getRoutingQueueWrapupCodes(queueID string, api *platformclientv2.RoutingApi
 }
 
 func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) diag.Diagnostics {
+	if d.Get("ignore_members").(bool) {
+		return nil
+	}
 	if d.HasChange("members") {
 		if members := d.Get("members"); members != nil {
 			log.Printf("Updating members for Queue %s", d.Get("name"))
@@ -1446,7 +1514,8 @@ func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.Rou
 				newUserRingNums[newUserIds[i]] = memberMap["ring_num"].(int)
 			}
 
-			oldSdkUsers, err := getRoutingQueueMembers(d.Id(), "", routingAPI)
+			// Only direct members are compared so that members from groups, teams and skill groups are left alone
+			oldSdkUsers, err := getRoutingQueueMembers(d.Id(), "user", routingAPI)
 			if err != nil {
 				return err
 			}
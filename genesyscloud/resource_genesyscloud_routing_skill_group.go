@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -31,6 +32,33 @@ type SkillGroupsRequest struct {
 	SkillConditions struct{} `json:"skillConditions"` //Keep this here.  Even though we do not use this field in the struct The generated attributed is used as a placeholder
 }
 
+// SkillGroupCondition is the known structure of a skill_conditions entry. It is used to validate the JSON and to
+// preview the members of a skill group.
+type SkillGroupCondition struct {
+	RoutingSkillConditions  []SkillGroupRoutingCondition  `json:"routingSkillConditions"`
+	LanguageSkillConditions []SkillGroupLanguageCondition `json:"languageSkillConditions"`
+	Operation               string                        `json:"operation"`
+}
+
+type SkillGroupRoutingCondition struct {
+	RoutingSkill    string                `json:"routingSkill"`
+	Comparator      string                `json:"comparator"`
+	Proficiency     int                   `json:"proficiency"`
+	ChildConditions []SkillGroupCondition `json:"childConditions"`
+}
+
+type SkillGroupLanguageCondition struct {
+	LanguageSkill   string                `json:"languageSkill"`
+	Comparator      string                `json:"comparator"`
+	Proficiency     int                   `json:"proficiency"`
+	ChildConditions []SkillGroupCondition `json:"childConditions"`
+}
+
+var (
+	skillConditionOperations  = []string{"And", "Or"}
+	skillConditionComparators = []string{"GreaterThan", "LessThan", "EqualTo", "GreaterThanOrEqualTo", "LessThanOrEqualTo"}
+)
+
 type AllSkillGroups struct {
 	Entities []struct {
 		ID   string `json:"id"`
@@ -96,6 +124,7 @@ func ResourceSkillGroupExporter() *resourceExporter.ResourceExporter {
 			"division_id": {"division_id"},
 		},
 		JsonEncodeAttributes: []string{"skill_conditions"},
+		ExcludedAttributes:   []string{"member_count", "member_ids"},
 	}
 }
 
@@ -135,6 +164,7 @@ func ResourceRoutingSkillGroup() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: SuppressEquivalentJsonDiffs,
+				ValidateFunc:     validateSkillConditions,
 			},
 			"member_division_ids": {
 				Description: "The IDs of member divisions to add or remove for this skill group. An empty array means all divisions will be removed, \"*\" means all divisions will be added.",
@@ -143,6 +173,17 @@ func ResourceRoutingSkillGroup() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"member_count": {
+				Description: "The number of users that are members of the skill group.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"member_ids": {
+				Description: "The IDs of the users that are members of the skill group. Membership is evaluated by Genesys Cloud after the skill group is saved, so it can take a few moments to be up to date.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -434,6 +475,13 @@ func readSkillGroups(ctx context.Context, d *schema.ResourceData, meta interface
 		memberDivisionIds := organizeMemberDivisionIdsForRead(schemaMemberDivisionIds, apiMemberDivisionIds, divisionId.(string))
 		_ = d.Set("member_division_ids", memberDivisionIds)
 
+		memberIds, err := getSkillGroupMemberIds(d.Id(), routingAPI)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read members of skill group %s: %s", d.Id(), err))
+		}
+		_ = d.Set("member_ids", memberIds)
+		_ = d.Set("member_count", len(memberIds))
+
 		log.Printf("Read skill groups name  %s %s", d.Id(), name)
 		return cc.CheckState()
 	})
@@ -530,6 +578,107 @@ func readSkillGroupMemberDivisionIds(d *schema.ResourceData, routingAPI *platfor
 	return apiSkillGroupMemberDivisionIds, nil
 }
 
+// getSkillGroupMemberIds returns the IDs of the users that are members of a skill group
+func getSkillGroupMemberIds(skillGroupId string, routingAPI *platformclientv2.RoutingApi) ([]string, error) {
+	const pageSize = 100
+	memberIds := make([]string, 0)
+	after := ""
+	for {
+		members, _, err := routingAPI.GetRoutingSkillgroupMembers(skillGroupId, pageSize, after, "", "")
+		if err != nil {
+			return nil, err
+		}
+		if members.Entities == nil || len(*members.Entities) == 0 {
+			break
+		}
+		for _, member := range *members.Entities {
+			memberIds = append(memberIds, *member.Id)
+		}
+
+		if members.NextUri == nil || *members.NextUri == "" {
+			break
+		}
+		nextUri, err := url.Parse(*members.NextUri)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse next page of skill group members: %s", err)
+		}
+		after = nextUri.Query().Get("after")
+		if after == "" {
+			break
+		}
+	}
+	return memberIds, nil
+}
+
+// validateSkillConditions checks that skill_conditions is a JSON array that matches the known structure of skill
+// group conditions
+func validateSkillConditions(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	if _, err := parseSkillConditions(v); err != nil {
+		return nil, []error{fmt.Errorf("%s is not valid: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// parseSkillConditions unmarshals skill conditions, rejecting unknown attributes and values
+func parseSkillConditions(skillConditionsJson string) ([]SkillGroupCondition, error) {
+	var conditions []SkillGroupCondition
+	decoder := json.NewDecoder(strings.NewReader(skillConditionsJson))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&conditions); err != nil {
+		return nil, err
+	}
+	if err := validateSkillGroupConditions(conditions, "skill conditions"); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+func validateSkillGroupConditions(conditions []SkillGroupCondition, path string) error {
+	for i, condition := range conditions {
+		conditionPath := fmt.Sprintf("%s[%d]", path, i)
+		if !lists.ItemInSlice(condition.Operation, skillConditionOperations) {
+			return fmt.Errorf("%s: operation must be one of %s, got %q", conditionPath, strings.Join(skillConditionOperations, ", "), condition.Operation)
+		}
+		for j, routingCondition := range condition.RoutingSkillConditions {
+			skillPath := fmt.Sprintf("%s.routingSkillConditions[%d]", conditionPath, j)
+			if routingCondition.RoutingSkill == "" {
+				return fmt.Errorf("%s: routingSkill is required", skillPath)
+			}
+			if err := validateSkillCondition(routingCondition.Comparator, routingCondition.Proficiency, routingCondition.ChildConditions, skillPath); err != nil {
+				return err
+			}
+		}
+		for j, languageCondition := range condition.LanguageSkillConditions {
+			skillPath := fmt.Sprintf("%s.languageSkillConditions[%d]", conditionPath, j)
+			if languageCondition.LanguageSkill == "" {
+				return fmt.Errorf("%s: languageSkill is required", skillPath)
+			}
+			if err := validateSkillCondition(languageCondition.Comparator, languageCondition.Proficiency, languageCondition.ChildConditions, skillPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateSkillCondition(comparator string, proficiency int, childConditions []SkillGroupCondition, path string) error {
+	if !lists.ItemInSlice(comparator, skillConditionComparators) {
+		return fmt.Errorf("%s: comparator must be one of %s, got %q", path, strings.Join(skillConditionComparators, ", "), comparator)
+	}
+	if proficiency < 0 || proficiency > 5 {
+		return fmt.Errorf("%s: proficiency must be between 0 and 5, got %d", path, proficiency)
+	}
+	return validateSkillGroupConditions(childConditions, path+".childConditions")
+}
+
 func allMemberDivisionsSpecified(schemaSkillGroupMemberDivisionIds []string) bool {
 	return lists.ItemInSlice("*", schemaSkillGroupMemberDivisionIds)
 }
@@ -38,6 +38,12 @@ type OrgUtilizationWithLabels struct {
 	LabelUtilizations map[string]LabelUtilization `json:"labelUtilizations"`
 }
 
+type AgentUtilizationWithLabels struct {
+	Utilization       map[string]MediaUtilization `json:"utilization"`
+	LabelUtilizations map[string]LabelUtilization `json:"labelUtilizations"`
+	Level             string                      `json:"level"`
+}
+
 var (
 	// Map of SDK media type name to schema media type name
 	utilizationMediaTypes = map[string]string{
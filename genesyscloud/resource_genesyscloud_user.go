@@ -2,8 +2,10 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"path"
 	"strings"
 	"time"
 
@@ -13,8 +15,10 @@ import (
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -114,6 +118,20 @@ var (
 			},
 		},
 	}
+	userStationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"default_station_id": {
+				Description: "ID of the station the user is associated with by default. If not set, the user's default station is removed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"associated_station_id": {
+				Description: "ID of the station the user is currently associated with. Users associate themselves with a station when they log in, so this is read only.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
 )
 
 func getAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
@@ -185,6 +203,11 @@ func UserExporter() *resourceExporter.ResourceExporter {
 			"locations":         {"location_id"},
 		},
 		AllowZeroValues: []string{"routing_skills.proficiency", "routing_languages.proficiency"},
+		// Stations are created by phones and edges, so their IDs are locked to the org
+		UnResolvableAttributes: map[string]*schema.Schema{
+			"default_station_id": userStationResource.Schema["default_station_id"],
+		},
+		ExcludedAttributes: []string{"station.associated_station_id"},
 	}
 }
 
@@ -197,7 +220,7 @@ func ResourceUser() *schema.Resource {
 		UpdateContext: UpdateWithPooledClient(updateUser),
 		DeleteContext: DeleteWithPooledClient(deleteUser),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importUser,
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -350,8 +373,53 @@ func ResourceUser() *schema.Resource {
 					},
 				},
 			},
+			"biography": {
+				Description: "The biography and personal interests shown on the user's profile. If not set, this resource will not manage the biography.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"biography": {
+							Description: "Personal detailed description.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"interests": {
+							Description: "User's interests.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"hobbies": {
+							Description: "User's hobbies.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"spouse": {
+							Description: "User's spouse.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"profile_image_filepath": {
+				Description:  "Path or URL to an image file to upload as the user's profile image. If not set, this resource will not manage the profile image. Removing a previously set path clears the profile image.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidatePath,
+			},
+			"profile_image_file_content_hash": {
+				Description: "Hash value of the profile image file content. Used to detect changes. Only required when uploading a local image file.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"routing_utilization": {
-				Description: "The routing utilization settings for this user. If empty list, the org default settings are used. If not set, this resource will not manage the users's utilization settings.",
+				Description: "The routing utilization settings for this user. If empty list, the user overrides are deleted and the org default settings are used. If not set, this resource will not manage the users's utilization settings.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -404,13 +472,74 @@ func ResourceUser() *schema.Resource {
 							ConfigMode:  schema.SchemaConfigModeAttr,
 							Elem:        utilizationSettingsResource,
 						},
+						"label_utilizations": {
+							Description: "Label utilization settings. If not set, the labels use the org default settings. This is in PREVIEW and should not be used unless the feature is available to your organization.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							ConfigMode:  schema.SchemaConfigModeAttr,
+							Elem:        utilizationLabelResource,
+						},
 					},
 				},
 			},
+			"voicemail_userpolicies": {
+				Description: "The voicemail policy settings for this user. If not set, this resource will not manage the user's voicemail policy.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Description: "Whether the user has voicemail enabled.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+						"alert_timeout_seconds": {
+							Description:  "The number of seconds to ring the user's phone before a call is transferred to voicemail.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"send_email_notifications": {
+							Description: "Whether email notifications are sent to the user when a new voicemail is received.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+						"pin": {
+							Description: "The PIN used to access the user's voicemail. Setting or changing this value resets the PIN. The PIN is never returned by the API, so changes made outside of Terraform are not detected.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"station": {
+				Description: "The stations assigned to this user. Only the default station is managed, and the associated station is read only. If not set, this resource will not manage the user's stations. Outbound caller ID is not a user setting in Genesys Cloud and is configured on queues, sites and phones instead.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        userStationResource,
+			},
 		},
 	}
 }
 
+func importUser(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// Seed the optional settings blocks so that the read which follows an import or export fetches them
+	d.Set("voicemail_userpolicies", []interface{}{map[string]interface{}{}})
+	d.Set("station", []interface{}{map[string]interface{}{}})
+	return []*schema.ResourceData{d}, nil
+}
+
 func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	email := d.Get("email").(string)
 	name := d.Get("name").(string)
@@ -481,7 +610,8 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		"acd_auto_answer",
 		"profile_skills",
 		"certifications",
-		"employer_info") {
+		"employer_info",
+		"biography") {
 		log.Printf("Updating additional attributes for user %s", email)
 		_, _, patchErr := usersAPI.PatchUser(d.Id(), platformclientv2.Updateuser{
 			Manager:        &manager,
@@ -489,6 +619,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 			AcdAutoAnswer:  &acdAutoAnswer,
 			Certifications: buildSdkCertifications(d),
 			EmployerInfo:   buildSdkEmployerInfo(d),
+			Biography:      buildSdkBiography(d),
 			Version:        user.Version,
 		})
 		if patchErr != nil {
@@ -511,7 +642,22 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = updateUserRoutingUtilization(d, usersAPI)
+	diagErr = updateUserRoutingUtilization(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserVoicemailPolicies(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserStations(d, usersAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserProfileImage(d, sdkConfig, usersAPI)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -534,6 +680,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			"profileSkills",
 			"certifications",
 			"employerInfo",
+			"biography",
 		}, "", "")
 
 		if getErr != nil {
@@ -582,11 +729,25 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		d.Set("profile_skills", flattenUserProfileSkills(currentUser.ProfileSkills))
 		d.Set("certifications", flattenUserCertifications(currentUser.Certifications))
 		d.Set("employer_info", flattenUserEmployerInfo(currentUser.EmployerInfo))
+		d.Set("biography", flattenUserBiography(currentUser.Biography))
 
-		if diagErr := readUserRoutingUtilization(d, usersAPI); diagErr != nil {
+		if diagErr := readUserRoutingUtilization(d, sdkConfig); diagErr != nil {
 			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
 		}
 
+		// Voicemail policies and stations are only read when they are managed, or when the user is imported or exported
+		if len(d.Get("voicemail_userpolicies").([]interface{})) > 0 {
+			if diagErr := readUserVoicemailPolicies(d, sdkConfig); diagErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+		}
+
+		if len(d.Get("station").([]interface{})) > 0 {
+			if diagErr := readUserStations(d, usersAPI); diagErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+		}
+
 		log.Printf("Read user %s %s", d.Id(), *currentUser.Email)
 		return cc.CheckState()
 	})
@@ -633,6 +794,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		AcdAutoAnswer:  &acdAutoAnswer,
 		Certifications: buildSdkCertifications(d),
 		EmployerInfo:   buildSdkEmployerInfo(d),
+		Biography:      buildSdkBiography(d),
 	}, usersAPI)
 	if patchErr != nil {
 		return patchErr
@@ -658,7 +820,22 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = updateUserRoutingUtilization(d, usersAPI)
+	diagErr = updateUserRoutingUtilization(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserVoicemailPolicies(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserStations(d, usersAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserProfileImage(d, sdkConfig, usersAPI)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -894,6 +1071,31 @@ func buildSdkEmployerInfo(d *schema.ResourceData) *platformclientv2.Employerinfo
 	return nil
 }
 
+func buildSdkBiography(d *schema.ResourceData) *platformclientv2.Biography {
+	if configBio := d.Get("biography").([]interface{}); configBio != nil {
+		var sdkBio platformclientv2.Biography
+		if len(configBio) > 0 {
+			if _, ok := configBio[0].(map[string]interface{}); !ok {
+				return nil
+			}
+			bioMap := configBio[0].(map[string]interface{})
+			// Only set non-empty values.
+			if biography := bioMap["biography"].(string); len(biography) > 0 {
+				sdkBio.Biography = &biography
+			}
+			if spouse := bioMap["spouse"].(string); len(spouse) > 0 {
+				sdkBio.Spouse = &spouse
+			}
+			interests := lists.InterfaceListToStrings(bioMap["interests"].([]interface{}))
+			sdkBio.Interests = &interests
+			hobbies := lists.InterfaceListToStrings(bioMap["hobbies"].([]interface{}))
+			sdkBio.Hobbies = &hobbies
+		}
+		return &sdkBio
+	}
+	return nil
+}
+
 func buildSdkCertifications(d *schema.ResourceData) *[]string {
 	if certs := d.Get("certifications"); certs != nil {
 		return lists.SetToStringList(certs.(*schema.Set))
@@ -1025,27 +1227,77 @@ func flattenUserEmployerInfo(empInfo *platformclientv2.Employerinfo) []interface
 	}}
 }
 
-func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
-	settings, resp, getErr := usersAPI.GetRoutingUserUtilization(d.Id())
+func flattenUserBiography(bio *platformclientv2.Biography) []interface{} {
+	if bio == nil {
+		return nil
+	}
+	var (
+		biography string
+		spouse    string
+		interests []interface{}
+		hobbies   []interface{}
+	)
+
+	if bio.Biography != nil {
+		biography = *bio.Biography
+	}
+	if bio.Spouse != nil {
+		spouse = *bio.Spouse
+	}
+	if bio.Interests != nil {
+		interests = lists.StringListToInterfaceList(*bio.Interests)
+	}
+	if bio.Hobbies != nil {
+		hobbies = lists.StringListToInterfaceList(*bio.Hobbies)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"biography": biography,
+		"spouse":    spouse,
+		"interests": interests,
+		"hobbies":   hobbies,
+	}}
+}
+
+func readUserRoutingUtilization(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	// Calling the Utilization API directly while the label feature is not available.
+	// Once it is, this code can go back to using platformclientv2's UsersApi to make the call.
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	apiClient := &routingAPI.Configuration.APIClient
+
+	path := fmt.Sprintf("%s/api/v2/routing/users/%s/utilization", routingAPI.Configuration.BasePath, d.Id())
+	headerParams := buildHeaderParams(routingAPI)
+
+	response, getErr := apiClient.CallAPI(path, "GET", nil, headerParams, nil, nil, "", nil)
 	if getErr != nil {
-		if IsStatus404(resp) {
+		if IsStatus404(response) {
 			d.SetId("") // User doesn't exist
 			return nil
 		}
 		return diag.Errorf("Failed to read Routing Utilization for user %s: %s", d.Id(), getErr)
 	}
 
-	if settings != nil && settings.Utilization != nil {
+	settings := &AgentUtilizationWithLabels{}
+	if err := json.Unmarshal(response.RawBody, settings); err != nil {
+		return diag.Errorf("Failed to unmarshal Routing Utilization for user %s: %s", d.Id(), err)
+	}
+
+	if settings.Utilization != nil {
 		// If the settings are org-wide, set to empty to indicate no settings on the user
-		if settings.Level != nil && *settings.Level == "Organization" {
+		if settings.Level == "Organization" {
 			d.Set("routing_utilization", []interface{}{})
 		} else {
 			allSettings := map[string]interface{}{}
 			for sdkType, schemaType := range utilizationMediaTypes {
-				if mediaSettings, ok := (*settings.Utilization)[sdkType]; ok {
-					allSettings[schemaType] = flattenUtilizationMediaSetting(mediaSettings)
+				if mediaSettings, ok := settings.Utilization[sdkType]; ok {
+					allSettings[schemaType] = flattenUtilizationSetting(mediaSettings)
 				}
 			}
+			if settings.LabelUtilizations != nil {
+				// Only add to the state the configured labels, in the configured order, like the org-wide settings
+				originalLabelUtilizations, _ := d.Get("routing_utilization.0.label_utilizations").([]interface{})
+				allSettings["label_utilizations"] = filterAndFlattenLabelUtilizations(settings.LabelUtilizations, originalLabelUtilizations)
+			}
 			d.Set("routing_utilization", []interface{}{allSettings})
 		}
 	} else {
@@ -1231,9 +1483,11 @@ func updateUserProfileSkills(d *schema.ResourceData, usersAPI *platformclientv2.
 	return nil
 }
 
-func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func updateUserRoutingUtilization(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	if d.HasChange("routing_utilization") {
 		if utilConfig := d.Get("routing_utilization").([]interface{}); utilConfig != nil {
+			routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
 			if len(utilConfig) > 0 { // Specified but empty utilization list will reset to org-wide defaults
 				sdkSettings := make(map[string]platformclientv2.Mediautilization)
 				allSettings := utilConfig[0].(map[string]interface{})
@@ -1242,16 +1496,41 @@ func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclie
 						sdkSettings[sdkType] = buildSdkMediaUtilization(mediaSettings.([]interface{}))
 					}
 				}
-				// Update settings
-				_, _, err := usersAPI.PutRoutingUserUtilization(d.Id(), platformclientv2.Utilizationrequest{
-					Utilization: &sdkSettings,
+				labelUtilizations, _ := allSettings["label_utilizations"].([]interface{})
+
+				// Retrying on 409s because a label created immediately before the utilization update can lead to a conflict
+				diagErr := RetryWhen(IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+					var resp *platformclientv2.APIResponse
+					var err error
+
+					// If the user has label(s), calls the Utilization API directly.
+					// This code can go back to using platformclientv2's UsersApi once label utilization is available in it.
+					if len(labelUtilizations) > 0 {
+						apiClient := &routingAPI.Configuration.APIClient
+
+						path := fmt.Sprintf("%s/api/v2/routing/users/%s/utilization", routingAPI.Configuration.BasePath, d.Id())
+						headerParams := buildHeaderParams(routingAPI)
+						requestPayload := make(map[string]interface{})
+						requestPayload["utilization"] = sdkSettings
+						requestPayload["labelUtilizations"] = buildLabelUtilizationsRequest(labelUtilizations)
+						resp, err = apiClient.CallAPI(path, "PUT", requestPayload, headerParams, nil, nil, "", nil)
+					} else {
+						_, resp, err = routingAPI.PutRoutingUserUtilization(d.Id(), platformclientv2.Utilizationrequest{
+							Utilization: &sdkSettings,
+						})
+					}
+
+					if err != nil {
+						return resp, diag.Errorf("Failed to update Routing Utilization for user %s: %s", d.Id(), err)
+					}
+					return resp, nil
 				})
-				if err != nil {
-					return diag.Errorf("Failed to update Routing Utilization for user %s: %s", d.Id(), err)
+				if diagErr != nil {
+					return diagErr
 				}
 			} else {
 				// Reset to org-wide defaults
-				_, err := usersAPI.DeleteRoutingUserUtilization(d.Id())
+				_, err := routingAPI.DeleteRoutingUserUtilization(d.Id())
 				if err != nil {
 					return diag.Errorf("Failed to delete Routing Utilization for user %s: %s", d.Id(), err)
 				}
@@ -1261,6 +1540,199 @@ func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclie
 	return nil
 }
 
+// updateUserProfileImage uploads the configured image as a public asset and sets it as the user's profile image.
+// Removing the file path clears the profile image.
+func updateUserProfileImage(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	if !d.HasChanges("profile_image_filepath", "profile_image_file_content_hash") {
+		return nil
+	}
+
+	images := make([]platformclientv2.Userimage, 0)
+	if imagePath := d.Get("profile_image_filepath").(string); imagePath != "" {
+		imageUri, diagErr := uploadUserProfileImage(d.Id(), imagePath, sdkConfig)
+		if diagErr != nil {
+			return diagErr
+		}
+		images = append(images, platformclientv2.Userimage{ImageUri: &imageUri})
+	}
+
+	log.Printf("Updating profile image for user %s", d.Id())
+	return patchUser(d.Id(), platformclientv2.Updateuser{Images: &images}, usersAPI)
+}
+
+// uploadUserProfileImage uploads an image file to the public assets bucket and returns the URI of the uploaded image
+func uploadUserProfileImage(userId string, imagePath string, sdkConfig *platformclientv2.Configuration) (string, diag.Diagnostics) {
+	uploadsAPI := platformclientv2.NewUploadsApiWithConfig(sdkConfig)
+
+	fileName := path.Base(imagePath)
+	uploadResponse, _, err := uploadsAPI.PostUploadsPublicassetsImages(platformclientv2.Uploadurlrequest{
+		FileName: &fileName,
+	})
+	if err != nil {
+		return "", diag.Errorf("Failed to get upload URL for profile image of user %s: %s", userId, err)
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(imagePath)
+	if err != nil {
+		return "", diag.Errorf("Failed to open profile image %s: %s", imagePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	var headers map[string]string
+	if uploadResponse.Headers != nil {
+		headers = *uploadResponse.Headers
+	}
+	s3Uploader := files.NewS3Uploader(reader, nil, nil, headers, "PUT", *uploadResponse.Url)
+	if _, err := s3Uploader.Upload(); err != nil {
+		return "", diag.Errorf("Failed to upload profile image of user %s: %s", userId, err)
+	}
+
+	// Public assets are served from the upload location without the signature
+	imageUri, _, _ := strings.Cut(*uploadResponse.Url, "?")
+	return imageUri, nil
+}
+
+func readUserVoicemailPolicies(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	voicemailAPI := platformclientv2.NewVoicemailApiWithConfig(sdkConfig)
+
+	policy, _, getErr := voicemailAPI.GetVoicemailUserpolicy(d.Id())
+	if getErr != nil {
+		return diag.Errorf("Failed to read voicemail policy for user %s: %s", d.Id(), getErr)
+	}
+
+	// The PIN is write-only, so keep the configured value
+	pin := d.Get("voicemail_userpolicies.0.pin").(string)
+	d.Set("voicemail_userpolicies", flattenUserVoicemailPolicies(policy, pin))
+	return nil
+}
+
+func updateUserVoicemailPolicies(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	if !d.HasChange("voicemail_userpolicies") {
+		return nil
+	}
+	policyConfig := d.Get("voicemail_userpolicies").([]interface{})
+	if len(policyConfig) == 0 || policyConfig[0] == nil {
+		return nil
+	}
+	policyMap := policyConfig[0].(map[string]interface{})
+
+	voicemailAPI := platformclientv2.NewVoicemailApiWithConfig(sdkConfig)
+	// Only send the settings present in the configuration. Omitted settings hold the value read from the API and are
+	// left unchanged rather than being reset to false.
+	configured := configuredVoicemailPolicyAttrs(d)
+	policy := platformclientv2.Voicemailuserpolicy{}
+	if configured["enabled"] {
+		policy.Enabled = platformclientv2.Bool(policyMap["enabled"].(bool))
+	}
+	if configured["send_email_notifications"] {
+		policy.SendEmailNotifications = platformclientv2.Bool(policyMap["send_email_notifications"].(bool))
+	}
+	if alertTimeout := policyMap["alert_timeout_seconds"].(int); configured["alert_timeout_seconds"] && alertTimeout > 0 {
+		policy.AlertTimeoutSeconds = &alertTimeout
+	}
+	// Only send the PIN when it changes, as sending it resets the user's PIN
+	if pin := policyMap["pin"].(string); pin != "" && d.HasChange("voicemail_userpolicies.0.pin") {
+		policy.Pin = &pin
+	}
+
+	log.Printf("Updating voicemail policy for user %s", d.Id())
+	_, _, err := voicemailAPI.PatchVoicemailUserpolicy(d.Id(), policy)
+	if err != nil {
+		return diag.Errorf("Failed to update voicemail policy for user %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// configuredVoicemailPolicyAttrs returns the voicemail policy attributes that are set in the configuration
+func configuredVoicemailPolicyAttrs(d *schema.ResourceData) map[string]bool {
+	configured := make(map[string]bool)
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return configured
+	}
+	rawPolicies := rawConfig.GetAttr("voicemail_userpolicies")
+	if rawPolicies.IsNull() || !rawPolicies.IsKnown() || rawPolicies.LengthInt() == 0 {
+		return configured
+	}
+	rawPolicy := rawPolicies.Index(cty.NumberIntVal(0))
+	for _, attr := range []string{"enabled", "alert_timeout_seconds", "send_email_notifications"} {
+		if value := rawPolicy.GetAttr(attr); !value.IsNull() {
+			configured[attr] = true
+		}
+	}
+	return configured
+}
+
+func readUserStations(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	stations, _, getErr := usersAPI.GetUserStation(d.Id())
+	if getErr != nil {
+		return diag.Errorf("Failed to read stations for user %s: %s", d.Id(), getErr)
+	}
+	d.Set("station", flattenUserStations(stations))
+	return nil
+}
+
+func updateUserStations(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	if !d.HasChange("station") {
+		return nil
+	}
+
+	// Only the default station is managed. Users associate themselves with a station when they log in, and removing
+	// that association would log them out of it.
+	if d.HasChange("station.0.default_station_id") {
+		if stationId := d.Get("station.0.default_station_id").(string); stationId != "" {
+			log.Printf("Setting default station %s for user %s", stationId, d.Id())
+			if _, err := usersAPI.PutUserStationDefaultstationStationId(d.Id(), stationId); err != nil {
+				return diag.Errorf("Failed to set default station %s for user %s: %s", stationId, d.Id(), err)
+			}
+		} else {
+			log.Printf("Removing default station for user %s", d.Id())
+			if resp, err := usersAPI.DeleteUserStationDefaultstation(d.Id()); err != nil && !IsStatus404(resp) {
+				return diag.Errorf("Failed to remove default station for user %s: %s", d.Id(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func flattenUserVoicemailPolicies(policy *platformclientv2.Voicemailuserpolicy, pin string) []interface{} {
+	if policy == nil {
+		return nil
+	}
+	policyMap := map[string]interface{}{
+		"pin": pin,
+	}
+	if policy.Enabled != nil {
+		policyMap["enabled"] = *policy.Enabled
+	}
+	if policy.AlertTimeoutSeconds != nil {
+		policyMap["alert_timeout_seconds"] = *policy.AlertTimeoutSeconds
+	}
+	if policy.SendEmailNotifications != nil {
+		policyMap["send_email_notifications"] = *policy.SendEmailNotifications
+	}
+	return []interface{}{policyMap}
+}
+
+func flattenUserStations(stations *platformclientv2.Userstations) []interface{} {
+	if stations == nil || (stations.DefaultStation == nil && stations.AssociatedStation == nil) {
+		return nil
+	}
+	stationMap := map[string]interface{}{
+		"default_station_id":    "",
+		"associated_station_id": "",
+	}
+	if stations.DefaultStation != nil && stations.DefaultStation.Id != nil {
+		stationMap["default_station_id"] = *stations.DefaultStation.Id
+	}
+	if stations.AssociatedStation != nil && stations.AssociatedStation.Id != nil {
+		stationMap["associated_station_id"] = *stations.AssociatedStation.Id
+	}
+	return []interface{}{stationMap}
+}
+
 func flattenUserSkills(skills *[]platformclientv2.Userroutingskill) *schema.Set {
 	if skills == nil {
 		return nil
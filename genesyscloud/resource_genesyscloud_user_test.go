@@ -823,6 +823,254 @@ func TestAccResourceUserRoutingUtil(t *testing.T) {
 	})
 }
 
+func TestAccResourceUserRoutingUtilWithLabels(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource1 = "test-user-util-labels"
+		userName      = "Terraform Util Labels"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		maxCapacity1  = "3"
+		maxCapacity2  = "4"
+
+		redLabelResource  = "label_red"
+		blueLabelResource = "label_blue"
+		redLabelName      = "Terraform Red " + uuid.NewString()
+		blueLabelName     = "Terraform Blue " + uuid.NewString()
+	)
+
+	labelsConfig := GenerateRoutingUtilizationLabelResource(redLabelResource, redLabelName, "") +
+		GenerateRoutingUtilizationLabelResource(blueLabelResource, blueLabelName, redLabelResource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			if err := checkIfLabelsAreEnabled(); err != nil {
+				t.Skipf("%v", err) // be sure to skip the test and not fail it
+			}
+		},
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create with label utilization settings
+				Config: labelsConfig + GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserRoutingUtil(
+						generateRoutingUtilMediaType("call", maxCapacity1, FalseValue),
+						generateLabelUtilization(redLabelResource, maxCapacity1),
+						generateLabelUtilization(blueLabelResource, maxCapacity1, redLabelResource),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateUserUtilizationLevel("genesyscloud_user."+userResource1, "Agent"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.call.0.maximum_capacity", maxCapacity1),
+					resource.TestCheckResourceAttrPair("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.0.label_id", "genesyscloud_routing_utilization_label."+redLabelResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.0.maximum_capacity", maxCapacity1),
+					resource.TestCheckResourceAttrPair("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.1.label_id", "genesyscloud_routing_utilization_label."+blueLabelResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.1.interrupting_label_ids.#", "1"),
+				),
+			},
+			{
+				// Update label utilization settings
+				Config: labelsConfig + GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserRoutingUtil(
+						generateRoutingUtilMediaType("call", maxCapacity2, FalseValue),
+						generateLabelUtilization(redLabelResource, maxCapacity2, blueLabelResource),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.0.maximum_capacity", maxCapacity2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.0.label_utilizations.0.interrupting_label_ids.#", "1"),
+				),
+			},
+			{
+				// Reset to org-level settings, which deletes the user overrides including the labels
+				Config: labelsConfig + GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					"routing_utilization = []",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateUserUtilizationLevel("genesyscloud_user."+userResource1, "Organization"),
+					resource.TestCheckNoResourceAttr("genesyscloud_user."+userResource1, "routing_utilization.%"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func TestAccResourceUserVoicemailPolicies(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource1 = "test-user-voicemail"
+		userName      = "Voicemail Terraform"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		alertTimeout1 = "20"
+		alertTimeout2 = "35"
+		pin1          = "847362"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserVoicemailPolicies(
+						TrueValue,
+						alertTimeout1,
+						FalseValue,
+						NullValue,
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "email", email1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.enabled", TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.alert_timeout_seconds", alertTimeout1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.send_email_notifications", FalseValue),
+				),
+			},
+			{
+				// Update and reset the PIN
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserVoicemailPolicies(
+						TrueValue,
+						alertTimeout2,
+						TrueValue,
+						strconv.Quote(pin1),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.enabled", TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.alert_timeout_seconds", alertTimeout2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.send_email_notifications", TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.pin", pin1),
+				),
+			},
+			{
+				// Omitted settings keep their current values
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserVoicemailPolicies(
+						NullValue,
+						alertTimeout1,
+						NullValue,
+						strconv.Quote(pin1),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.enabled", TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.alert_timeout_seconds", alertTimeout1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.send_email_notifications", TrueValue),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_user." + userResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"voicemail_userpolicies.0.pin"},
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func TestAccResourceUserBiographyAndImage(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource1 = "test-user-bio"
+		userName      = "Bio Terraform"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		biography1    = "Joined the support team in 2019."
+		biography2    = "Leads the billing support team."
+		interest1     = "Cycling"
+		interest2     = "Chess"
+		hobby1        = "Gardening"
+		testFilesDir  = "test_responseasset_data"
+		imagePath1    = fmt.Sprintf("%s/%s", testFilesDir, "yeti-img.png")
+		imagePath2    = fmt.Sprintf("%s/%s", testFilesDir, "genesys-img.png")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserBiography(
+						strconv.Quote(biography1),
+						[]string{strconv.Quote(interest1)},
+						nil,
+					),
+					generateUserProfileImage(imagePath1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.biography", biography1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.interests.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.interests.0", interest1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.hobbies.#", "0"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "profile_image_filepath", imagePath1),
+				),
+			},
+			{
+				// Update biography and replace the image
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserBiography(
+						strconv.Quote(biography2),
+						[]string{strconv.Quote(interest1), strconv.Quote(interest2)},
+						[]string{strconv.Quote(hobby1)},
+					),
+					generateUserProfileImage(imagePath2),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.biography", biography2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.interests.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.hobbies.0", hobby1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "profile_image_filepath", imagePath2),
+				),
+			},
+			{
+				// Remove the biography and the image
+				Config: GenerateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					"biography = []",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "biography.0.biography", ""),
+					resource.TestCheckNoResourceAttr("genesyscloud_user."+userResource1, "profile_image_filepath"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
 func TestAccResourceUserRestore(t *testing.T) {
 	t.Parallel()
 	var (
@@ -1057,6 +1305,31 @@ func generateUserEmployerInfo(offName string, empID string, empType string, date
 	`, offName, empID, empType, dateHire)
 }
 
+func generateUserVoicemailPolicies(enabled string, alertTimeoutSeconds string, sendEmailNotifications string, pin string) string {
+	return fmt.Sprintf(`voicemail_userpolicies {
+		enabled = %s
+		alert_timeout_seconds = %s
+		send_email_notifications = %s
+		pin = %s
+	}
+	`, enabled, alertTimeoutSeconds, sendEmailNotifications, pin)
+}
+
+func generateUserBiography(biography string, interests []string, hobbies []string) string {
+	return fmt.Sprintf(`biography {
+		biography = %s
+		interests = [%s]
+		hobbies = [%s]
+	}
+	`, biography, strings.Join(interests, ", "), strings.Join(hobbies, ", "))
+}
+
+func generateUserProfileImage(filepath string) string {
+	return fmt.Sprintf(`profile_image_filepath = %s
+	profile_image_file_content_hash = filesha256(%s)
+	`, strconv.Quote(filepath), strconv.Quote(filepath))
+}
+
 func generateUserRoutingUtil(nestedBlocks ...string) string {
 	return fmt.Sprintf(`routing_utilization {
 		%s
@@ -0,0 +1,103 @@
+package routing_direct_routing_settings
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_direct_routing_settings.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllRoutingDirectRoutingSettings retrieves the queues that have Direct Routing settings and is used for the exporter
+func getAllRoutingDirectRoutingSettings(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingDirectRoutingSettingsProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	queues, _, err := proxy.getAllRoutingQueues(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get queues: %v", err)
+	}
+
+	for _, queue := range *queues {
+		if queue.DirectRouting == nil {
+			continue
+		}
+		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name}
+	}
+	return resources, nil
+}
+
+func createRoutingDirectRoutingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+	d.SetId(queueId)
+
+	log.Printf("Creating direct routing settings of queue %s", queueId)
+	return updateRoutingDirectRoutingSettings(ctx, d, meta)
+}
+
+func readRoutingDirectRoutingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingDirectRoutingSettingsProxy(sdkConfig)
+
+	log.Printf("Reading direct routing settings of queue %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		queue, resp, getErr := proxy.getRoutingQueue(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read direct routing settings of queue %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read direct routing settings of queue %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingDirectRoutingSettings())
+
+		_ = d.Set("queue_id", *queue.Id)
+		flattenDirectRouting(d, queue.DirectRouting)
+
+		log.Printf("Read direct routing settings of queue %s %s", d.Id(), *queue.Name)
+		return cc.CheckState()
+	})
+}
+
+func updateRoutingDirectRoutingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingDirectRoutingSettingsProxy(sdkConfig)
+
+	log.Printf("Updating direct routing settings of queue %s", d.Id())
+	if _, _, err := proxy.updateRoutingQueueDirectRouting(ctx, d.Id(), buildDirectRouting(d)); err != nil {
+		return diag.Errorf("Failed to update direct routing settings of queue %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Updated direct routing settings of queue %s", d.Id())
+	return readRoutingDirectRoutingSettings(ctx, d, meta)
+}
+
+func deleteRoutingDirectRoutingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingDirectRoutingSettingsProxy(sdkConfig)
+
+	log.Printf("Resetting direct routing settings of queue %s", d.Id())
+	_, resp, err := proxy.updateRoutingQueueDirectRouting(ctx, d.Id(), buildDefaultDirectRouting())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			// The queue was probably deleted along with its settings
+			log.Printf("Queue %s already deleted", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to reset direct routing settings of queue %s: %s", d.Id(), err)
+	}
+	log.Printf("Reset direct routing settings of queue %s", d.Id())
+	return nil
+}
@@ -0,0 +1,138 @@
+package routing_direct_routing_settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_direct_routing_settings_proxy.go file contains the proxy structures and methods that
+interact with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can
+be stubbed out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingDirectRoutingSettingsProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllRoutingQueuesFunc func(ctx context.Context, p *routingDirectRoutingSettingsProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueFunc func(ctx context.Context, p *routingDirectRoutingSettingsProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type updateRoutingQueueDirectRoutingFunc func(ctx context.Context, p *routingDirectRoutingSettingsProxy, queueId string, directRouting *platformclientv2.Directrouting) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+
+// routingDirectRoutingSettingsProxy contains all of the methods that call genesys cloud APIs.
+type routingDirectRoutingSettingsProxy struct {
+	clientConfig                        *platformclientv2.Configuration
+	routingApi                          *platformclientv2.RoutingApi
+	getAllRoutingQueuesAttr             getAllRoutingQueuesFunc
+	getRoutingQueueAttr                 getRoutingQueueFunc
+	updateRoutingQueueDirectRoutingAttr updateRoutingQueueDirectRoutingFunc
+}
+
+// newRoutingDirectRoutingSettingsProxy initializes the direct routing settings proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingDirectRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *routingDirectRoutingSettingsProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	return &routingDirectRoutingSettingsProxy{
+		clientConfig:                        clientConfig,
+		routingApi:                          api,
+		getAllRoutingQueuesAttr:             getAllRoutingQueuesFn,
+		getRoutingQueueAttr:                 getRoutingQueueFn,
+		updateRoutingQueueDirectRoutingAttr: updateRoutingQueueDirectRoutingFn,
+	}
+}
+
+// getRoutingDirectRoutingSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingDirectRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *routingDirectRoutingSettingsProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingDirectRoutingSettingsProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllRoutingQueues retrieves all routing queues
+func (p *routingDirectRoutingSettingsProxy) getAllRoutingQueues(ctx context.Context) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingQueuesAttr(ctx, p)
+}
+
+// getRoutingQueue retrieves a routing queue by id
+func (p *routingDirectRoutingSettingsProxy) getRoutingQueue(ctx context.Context, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueAttr(ctx, p, queueId)
+}
+
+// updateRoutingQueueDirectRouting replaces the direct routing settings of a queue, keeping the rest of its configuration
+func (p *routingDirectRoutingSettingsProxy) updateRoutingQueueDirectRouting(ctx context.Context, queueId string, directRouting *platformclientv2.Directrouting) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueDirectRoutingAttr(ctx, p, queueId, directRouting)
+}
+
+// getAllRoutingQueuesFn is the implementation for retrieving all routing queues in Genesys Cloud
+func getAllRoutingQueuesFn(ctx context.Context, p *routingDirectRoutingSettingsProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	var allQueues []platformclientv2.Queue
+	const pageSize = 100
+
+	queues, resp, err := p.routingApi.GetRoutingQueues(1, pageSize, "", "", nil, nil, nil, false)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get first page of queues: %s", err)
+	}
+	if queues.Entities == nil || len(*queues.Entities) == 0 {
+		return &allQueues, resp, nil
+	}
+	allQueues = append(allQueues, *queues.Entities...)
+
+	for pageNum := 2; pageNum <= *queues.PageCount; pageNum++ {
+		queues, resp, err := p.routingApi.GetRoutingQueues(pageNum, pageSize, "", "", nil, nil, nil, false)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get page %d of queues: %s", pageNum, err)
+		}
+		if queues.Entities == nil || len(*queues.Entities) == 0 {
+			break
+		}
+		allQueues = append(allQueues, *queues.Entities...)
+	}
+	return &allQueues, resp, nil
+}
+
+// getRoutingQueueFn is the implementation for retrieving a routing queue in Genesys Cloud
+func getRoutingQueueFn(ctx context.Context, p *routingDirectRoutingSettingsProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.routingApi.GetRoutingQueue(queueId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get queue %s: %s", queueId, err)
+	}
+	return queue, resp, nil
+}
+
+// updateRoutingQueueDirectRoutingFn is the implementation for updating the direct routing settings of a queue. The API
+// only allows the whole queue to be replaced, so the current queue is read and sent back with the new settings.
+func updateRoutingQueueDirectRoutingFn(ctx context.Context, p *routingDirectRoutingSettingsProxy, queueId string, directRouting *platformclientv2.Directrouting) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.getRoutingQueue(ctx, queueId)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	queueRequest, err := buildQueueRequest(queue)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to build request for queue %s: %s", queueId, err)
+	}
+	queueRequest.DirectRouting = directRouting
+
+	updatedQueue, resp, err := p.routingApi.PutRoutingQueue(queueId, *queueRequest)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update direct routing settings of queue %s: %s", queueId, err)
+	}
+	return updatedQueue, resp, nil
+}
+
+// buildQueueRequest copies a queue into an update request. Both models share the same JSON representation.
+func buildQueueRequest(queue *platformclientv2.Queue) (*platformclientv2.Queuerequest, error) {
+	queueJson, err := json.Marshal(queue)
+	if err != nil {
+		return nil, err
+	}
+	var queueRequest platformclientv2.Queuerequest
+	if err := json.Unmarshal(queueJson, &queueRequest); err != nil {
+		return nil, err
+	}
+	return &queueRequest, nil
+}
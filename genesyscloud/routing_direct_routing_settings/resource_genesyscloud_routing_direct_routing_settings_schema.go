@@ -0,0 +1,98 @@
+package routing_direct_routing_settings
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_routing_direct_routing_settings_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the routing_direct_routing_settings resource.
+3.  The resource exporter configuration for the routing_direct_routing_settings exporter.
+*/
+const resourceName = "genesyscloud_routing_direct_routing_settings"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceRoutingDirectRoutingSettings())
+	regInstance.RegisterExporter(resourceName, RoutingDirectRoutingSettingsExporter())
+}
+
+// ResourceRoutingDirectRoutingSettings registers the genesyscloud_routing_direct_routing_settings resource with Terraform
+func ResourceRoutingDirectRoutingSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Direct Routing Settings. Manages the Direct Routing settings of a queue apart from the queue itself. The ID of the resource is the ID of the queue.
+Deleting the resource restores the default Direct Routing settings of the queue.
+The genesyscloud_routing_queue resource of the queue must set ignore_direct_routing to true. The two resources must not both manage the Direct Routing settings of the same queue.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingDirectRoutingSettings),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingDirectRoutingSettings),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingDirectRoutingSettings),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingDirectRoutingSettings),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_queue_id": {
+				Description: "ID of the queue that Direct Routing interactions go to when the agent is not available. If not set, the queue itself is used as backup.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"agent_wait_seconds": {
+				Description:  "The time in seconds a Direct Routing interaction waits for the agent before it goes to the backup queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultAgentWaitSeconds,
+				ValidateFunc: validation.IntBetween(60, 864000),
+			},
+			"wait_for_agent": {
+				Description: "Whether Direct Routing interactions wait for the targeted agent by default.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"call_use_agent_address_outbound": {
+				Description: "Whether the Direct Routing address of the agent is used for outbound calls on behalf of the queue in place of the queue address.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"email_use_agent_address_outbound": {
+				Description: "Whether the Direct Routing address of the agent is used for outbound emails on behalf of the queue in place of the queue address.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"message_use_agent_address_outbound": {
+				Description: "Whether the Direct Routing address of the agent is used for outbound messages on behalf of the queue in place of the queue address.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+// RoutingDirectRoutingSettingsExporter returns the resourceExporter object used to hold the genesyscloud_routing_direct_routing_settings exporter's config
+func RoutingDirectRoutingSettingsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingDirectRoutingSettings),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_id":        {RefType: "genesyscloud_routing_queue"},
+			"backup_queue_id": {RefType: "genesyscloud_routing_queue"},
+		},
+	}
+}
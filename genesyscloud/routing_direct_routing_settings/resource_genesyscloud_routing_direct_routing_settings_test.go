@@ -0,0 +1,81 @@
+package routing_direct_routing_settings
+
+import (
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+The resource_genesyscloud_routing_direct_routing_settings_test.go contains all of the test cases for running the resource
+tests for routing_direct_routing_settings.
+*/
+
+func TestAccResourceRoutingDirectRoutingSettings(t *testing.T) {
+	t.Parallel()
+	var (
+		queueResourceId       = "test-queue"
+		queueName             = "Terraform Test Queue " + uuid.NewString()
+		queueDescription      = "Queue with direct routing settings"
+		backupQueueResourceId = "test-backup-queue"
+		backupQueueName       = "Terraform Test Backup Queue " + uuid.NewString()
+
+		settingsResourceId = "test-settings"
+		settingsFullName   = resourceName + "." + settingsResourceId
+		queueRef           = "genesyscloud_routing_queue." + queueResourceId + ".id"
+		backupQueueRef     = "genesyscloud_routing_queue." + backupQueueResourceId + ".id"
+	)
+
+	baseConfig := gcloud.GenerateRoutingQueueResourceBasic(queueResourceId, queueName, `description = "`+queueDescription+`"`, "ignore_direct_routing = true") +
+		gcloud.GenerateRoutingQueueResourceBasic(backupQueueResourceId, backupQueueName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + GenerateRoutingDirectRoutingSettingsResource(
+					settingsResourceId,
+					queueRef,
+					"backup_queue_id = "+backupQueueRef,
+					"agent_wait_seconds = 120",
+					"wait_for_agent = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(settingsFullName, "queue_id", "genesyscloud_routing_queue."+queueResourceId, "id"),
+					resource.TestCheckResourceAttrPair(settingsFullName, "backup_queue_id", "genesyscloud_routing_queue."+backupQueueResourceId, "id"),
+					resource.TestCheckResourceAttr(settingsFullName, "agent_wait_seconds", "120"),
+					resource.TestCheckResourceAttr(settingsFullName, "wait_for_agent", "true"),
+					resource.TestCheckResourceAttr(settingsFullName, "call_use_agent_address_outbound", "true"),
+					resource.TestCheckResourceAttr(settingsFullName, "email_use_agent_address_outbound", "true"),
+					resource.TestCheckResourceAttr(settingsFullName, "message_use_agent_address_outbound", "true"),
+				),
+			},
+			{
+				// Updating the settings leaves the rest of the queue alone
+				Config: baseConfig + GenerateRoutingDirectRoutingSettingsResource(
+					settingsResourceId,
+					queueRef,
+					"agent_wait_seconds = 300",
+					"email_use_agent_address_outbound = false",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(settingsFullName, "backup_queue_id", ""),
+					resource.TestCheckResourceAttr(settingsFullName, "agent_wait_seconds", "300"),
+					resource.TestCheckResourceAttr(settingsFullName, "wait_for_agent", "false"),
+					resource.TestCheckResourceAttr(settingsFullName, "email_use_agent_address_outbound", "false"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResourceId, "description", queueDescription),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      settingsFullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
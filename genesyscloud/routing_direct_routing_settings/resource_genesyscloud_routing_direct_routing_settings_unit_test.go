@@ -0,0 +1,76 @@
+package routing_direct_routing_settings
+
+import (
+	"context"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceRoutingDirectRoutingSettingsUpdate(t *testing.T) {
+	queueId := "queue-1"
+	backupQueueId := "queue-2"
+	var directRouting *platformclientv2.Directrouting
+
+	proxy := &routingDirectRoutingSettingsProxy{}
+	proxy.updateRoutingQueueDirectRoutingAttr = func(ctx context.Context, p *routingDirectRoutingSettingsProxy, id string, settings *platformclientv2.Directrouting) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		directRouting = settings
+		return &platformclientv2.Queue{Id: &id, DirectRouting: settings}, nil, nil
+	}
+	proxy.getRoutingQueueAttr = func(ctx context.Context, p *routingDirectRoutingSettingsProxy, id string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Queue{Id: &id, Name: platformclientv2.String("Queue"), DirectRouting: directRouting}, nil, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingDirectRoutingSettings().Schema, map[string]interface{}{
+		"queue_id":                         queueId,
+		"backup_queue_id":                  backupQueueId,
+		"agent_wait_seconds":               120,
+		"email_use_agent_address_outbound": false,
+	})
+
+	diagErr := createRoutingDirectRoutingSettings(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, queueId, d.Id())
+
+	assert.Equal(t, backupQueueId, *directRouting.BackupQueueId)
+	assert.Equal(t, 120, *directRouting.AgentWaitSeconds)
+	assert.False(t, *directRouting.WaitForAgent)
+	assert.True(t, *directRouting.CallMediaSettings.UseAgentAddressOutbound)
+	assert.False(t, *directRouting.EmailMediaSettings.UseAgentAddressOutbound)
+	assert.True(t, *directRouting.MessageMediaSettings.UseAgentAddressOutbound)
+
+	assert.Equal(t, backupQueueId, d.Get("backup_queue_id"))
+	assert.Equal(t, 120, d.Get("agent_wait_seconds"))
+	assert.Equal(t, false, d.Get("email_use_agent_address_outbound"))
+}
+
+func TestUnitBuildQueueRequest(t *testing.T) {
+	queue := &platformclientv2.Queue{
+		Id:                    platformclientv2.String("queue-1"),
+		Name:                  platformclientv2.String("Queue"),
+		Description:           platformclientv2.String("Queue description"),
+		SkillEvaluationMethod: platformclientv2.String("BEST"),
+		QueueFlow:             &platformclientv2.Domainentityref{Id: platformclientv2.String("flow-1")},
+		MemberGroups: &[]platformclientv2.Membergroup{
+			{Id: platformclientv2.String("group-1"), VarType: platformclientv2.String("GROUP")},
+		},
+	}
+
+	queueRequest, err := buildQueueRequest(queue)
+	assert.Nil(t, err)
+
+	// Every setting of the queue is sent back when the direct routing settings are updated
+	assert.Equal(t, "Queue", *queueRequest.Name)
+	assert.Equal(t, "Queue description", *queueRequest.Description)
+	assert.Equal(t, "BEST", *queueRequest.SkillEvaluationMethod)
+	assert.Equal(t, "flow-1", *queueRequest.QueueFlow.Id)
+	assert.Equal(t, "group-1", *(*queueRequest.MemberGroups)[0].Id)
+	assert.Nil(t, queueRequest.DirectRouting)
+}
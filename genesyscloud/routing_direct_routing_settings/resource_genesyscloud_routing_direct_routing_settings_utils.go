@@ -0,0 +1,81 @@
+package routing_direct_routing_settings
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_direct_routing_settings_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// defaultAgentWaitSeconds is the agent wait time that queues start with
+const defaultAgentWaitSeconds = 60
+
+// buildDirectRouting maps the terraform attributes into the Direct Routing settings of a queue
+func buildDirectRouting(d *schema.ResourceData) *platformclientv2.Directrouting {
+	agentWaitSeconds := d.Get("agent_wait_seconds").(int)
+	waitForAgent := d.Get("wait_for_agent").(bool)
+	callUseAgentAddressOutbound := d.Get("call_use_agent_address_outbound").(bool)
+	emailUseAgentAddressOutbound := d.Get("email_use_agent_address_outbound").(bool)
+	messageUseAgentAddressOutbound := d.Get("message_use_agent_address_outbound").(bool)
+
+	directRouting := &platformclientv2.Directrouting{
+		CallMediaSettings:    &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: &callUseAgentAddressOutbound},
+		EmailMediaSettings:   &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: &emailUseAgentAddressOutbound},
+		MessageMediaSettings: &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: &messageUseAgentAddressOutbound},
+		AgentWaitSeconds:     &agentWaitSeconds,
+		WaitForAgent:         &waitForAgent,
+	}
+	if backupQueueId := d.Get("backup_queue_id").(string); backupQueueId != "" {
+		directRouting.BackupQueueId = &backupQueueId
+	}
+	return directRouting
+}
+
+// buildDefaultDirectRouting returns the Direct Routing settings that queues start with
+func buildDefaultDirectRouting() *platformclientv2.Directrouting {
+	return &platformclientv2.Directrouting{
+		CallMediaSettings:    &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: platformclientv2.Bool(true)},
+		EmailMediaSettings:   &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: platformclientv2.Bool(true)},
+		MessageMediaSettings: &platformclientv2.Directroutingmediasettings{UseAgentAddressOutbound: platformclientv2.Bool(true)},
+		AgentWaitSeconds:     platformclientv2.Int(defaultAgentWaitSeconds),
+		WaitForAgent:         platformclientv2.Bool(false),
+	}
+}
+
+// flattenDirectRouting sets the terraform attributes from the Direct Routing settings of a queue
+func flattenDirectRouting(d *schema.ResourceData, directRouting *platformclientv2.Directrouting) {
+	if directRouting == nil {
+		directRouting = buildDefaultDirectRouting()
+	}
+	resourcedata.SetNillableValue(d, "backup_queue_id", directRouting.BackupQueueId)
+	resourcedata.SetNillableValue(d, "agent_wait_seconds", directRouting.AgentWaitSeconds)
+	resourcedata.SetNillableValue(d, "wait_for_agent", directRouting.WaitForAgent)
+	setUseAgentAddressOutbound(d, "call_use_agent_address_outbound", directRouting.CallMediaSettings)
+	setUseAgentAddressOutbound(d, "email_use_agent_address_outbound", directRouting.EmailMediaSettings)
+	setUseAgentAddressOutbound(d, "message_use_agent_address_outbound", directRouting.MessageMediaSettings)
+}
+
+func setUseAgentAddressOutbound(d *schema.ResourceData, key string, mediaSettings *platformclientv2.Directroutingmediasettings) {
+	if mediaSettings != nil && mediaSettings.UseAgentAddressOutbound != nil {
+		_ = d.Set(key, *mediaSettings.UseAgentAddressOutbound)
+	} else {
+		_ = d.Set(key, true)
+	}
+}
+
+// GenerateRoutingDirectRoutingSettingsResource generates a terraform string for a direct routing settings resource
+func GenerateRoutingDirectRoutingSettingsResource(resourceId string, queueId string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_direct_routing_settings" "%s" {
+	queue_id = %s
+	%s
+}
+`, resourceId, queueId, strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,61 @@
+package routing_message_address
+
+import (
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_routing_message_address_init_test.go file is used to initialize the data sources and resources
+   used in testing the routing message address resources.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+	providerResources[openResourceName] = ResourceRoutingMessageAddressOpen()
+	providerResources[whatsAppResourceName] = ResourceRoutingMessageAddressWhatsApp()
+	providerResources[facebookResourceName] = ResourceRoutingMessageAddressFacebook()
+	providerResources[instagramResourceName] = ResourceRoutingMessageAddressInstagram()
+	providerResources["genesyscloud_routing_queue"] = gcloud.ResourceRoutingQueue()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	//There are no data sources for these resources
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the routing_message_address package
+	initTestResources()
+
+	// Run the test suite for the routing_message_address package
+	m.Run()
+}
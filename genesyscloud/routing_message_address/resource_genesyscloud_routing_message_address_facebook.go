@@ -0,0 +1,169 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_facebook.go contains all of the methods that perform the core logic for
+the Facebook address resource.
+*/
+
+// getAllRoutingMessageAddressFacebook retrieves all of the Facebook integrations via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingMessageAddressFacebook(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingMessageAddressProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	integrations, _, err := proxy.getAllFacebookIntegrations(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get facebook integrations: %v", err)
+	}
+
+	for _, integration := range *integrations {
+		resources[*integration.Id] = &resourceExporter.ResourceMeta{Name: *integration.Name}
+	}
+	return resources, nil
+}
+
+// createRoutingMessageAddressFacebook is used by the routing_message_address_facebook resource to create a Facebook integration
+func createRoutingMessageAddressFacebook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	log.Printf("Creating facebook integration %s", name)
+	integration, _, err := proxy.createFacebookIntegration(ctx, &platformclientv2.Facebookintegrationrequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+		PageAccessToken:  stringOrNil(d.Get("page_access_token").(string)),
+		UserAccessToken:  stringOrNil(d.Get("user_access_token").(string)),
+		PageId:           stringOrNil(d.Get("page_id").(string)),
+		AppId:            stringOrNil(d.Get("app_id").(string)),
+		AppSecret:        stringOrNil(d.Get("app_secret").(string)),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create facebook integration %s: %s", name, err)
+	}
+
+	d.SetId(*integration.Id)
+
+	diagErr := waitForIntegrationCreated(ctx, d.Id(), func() (*string, *platformclientv2.Errorbody, error) {
+		integration, _, err := proxy.getFacebookIntegration(ctx, d.Id())
+		if err != nil {
+			return nil, nil, err
+		}
+		return integration.CreateStatus, integration.CreateError, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created facebook integration %s %s", name, d.Id())
+	return readRoutingMessageAddressFacebook(ctx, d, meta)
+}
+
+// readRoutingMessageAddressFacebook is used by the routing_message_address_facebook resource to read a Facebook integration from Genesys Cloud
+func readRoutingMessageAddressFacebook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	log.Printf("Reading facebook integration %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		integration, resp, getErr := proxy.getFacebookIntegration(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read facebook integration %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read facebook integration %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingMessageAddressFacebook())
+
+		resourcedata.SetNillableValue(d, "name", integration.Name)
+		resourcedata.SetNillableValue(d, "page_id", integration.PageId)
+		resourcedata.SetNillableValue(d, "app_id", integration.AppId)
+		setSupportedContentAndMessagingSetting(d, integration.SupportedContent, integration.MessagingSetting)
+
+		if err := readInboundFlow(ctx, proxy, d); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read inbound flow of facebook integration %s: %s", d.Id(), err))
+		}
+
+		log.Printf("Read facebook integration %s %s", d.Id(), *integration.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingMessageAddressFacebook is used by the routing_message_address_facebook resource to update a Facebook integration in Genesys Cloud
+func updateRoutingMessageAddressFacebook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	updateRequest := platformclientv2.Facebookintegrationupdaterequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+	}
+	// Tokens are only sent when they change so that a token refreshed outside of Terraform is not overwritten
+	if d.HasChange("page_access_token") {
+		updateRequest.PageAccessToken = stringOrNil(d.Get("page_access_token").(string))
+	}
+	if d.HasChange("user_access_token") {
+		updateRequest.UserAccessToken = stringOrNil(d.Get("user_access_token").(string))
+	}
+
+	log.Printf("Updating facebook integration %s", name)
+	if _, _, err := proxy.updateFacebookIntegration(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update facebook integration %s: %s", name, err)
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated facebook integration %s", name)
+	return readRoutingMessageAddressFacebook(ctx, d, meta)
+}
+
+// deleteRoutingMessageAddressFacebook is used by the routing_message_address_facebook resource to delete a Facebook integration from Genesys Cloud
+func deleteRoutingMessageAddressFacebook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	if _, err := proxy.deleteFacebookIntegration(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete facebook integration %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getFacebookIntegration(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted facebook integration %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting facebook integration %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Facebook integration %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,169 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_instagram.go contains all of the methods that perform the core logic for
+the Instagram address resource.
+*/
+
+// getAllRoutingMessageAddressInstagram retrieves all of the Instagram integrations via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingMessageAddressInstagram(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingMessageAddressProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	integrations, _, err := proxy.getAllInstagramIntegrations(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get instagram integrations: %v", err)
+	}
+
+	for _, integration := range *integrations {
+		resources[*integration.Id] = &resourceExporter.ResourceMeta{Name: *integration.Name}
+	}
+	return resources, nil
+}
+
+// createRoutingMessageAddressInstagram is used by the routing_message_address_instagram resource to create a Instagram integration
+func createRoutingMessageAddressInstagram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	log.Printf("Creating instagram integration %s", name)
+	integration, _, err := proxy.createInstagramIntegration(ctx, &platformclientv2.Instagramintegrationrequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+		PageAccessToken:  stringOrNil(d.Get("page_access_token").(string)),
+		UserAccessToken:  stringOrNil(d.Get("user_access_token").(string)),
+		PageId:           stringOrNil(d.Get("page_id").(string)),
+		AppId:            stringOrNil(d.Get("app_id").(string)),
+		AppSecret:        stringOrNil(d.Get("app_secret").(string)),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create instagram integration %s: %s", name, err)
+	}
+
+	d.SetId(*integration.Id)
+
+	diagErr := waitForIntegrationCreated(ctx, d.Id(), func() (*string, *platformclientv2.Errorbody, error) {
+		integration, _, err := proxy.getInstagramIntegration(ctx, d.Id())
+		if err != nil {
+			return nil, nil, err
+		}
+		return integration.CreateStatus, integration.CreateError, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created instagram integration %s %s", name, d.Id())
+	return readRoutingMessageAddressInstagram(ctx, d, meta)
+}
+
+// readRoutingMessageAddressInstagram is used by the routing_message_address_instagram resource to read a Instagram integration from Genesys Cloud
+func readRoutingMessageAddressInstagram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	log.Printf("Reading instagram integration %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		integration, resp, getErr := proxy.getInstagramIntegration(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read instagram integration %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read instagram integration %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingMessageAddressInstagram())
+
+		resourcedata.SetNillableValue(d, "name", integration.Name)
+		resourcedata.SetNillableValue(d, "page_id", integration.PageId)
+		resourcedata.SetNillableValue(d, "app_id", integration.AppId)
+		setSupportedContentAndMessagingSetting(d, integration.SupportedContent, integration.MessagingSetting)
+
+		if err := readInboundFlow(ctx, proxy, d); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read inbound flow of instagram integration %s: %s", d.Id(), err))
+		}
+
+		log.Printf("Read instagram integration %s %s", d.Id(), *integration.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingMessageAddressInstagram is used by the routing_message_address_instagram resource to update a Instagram integration in Genesys Cloud
+func updateRoutingMessageAddressInstagram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	updateRequest := platformclientv2.Instagramintegrationupdaterequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+	}
+	// Tokens are only sent when they change so that a token refreshed outside of Terraform is not overwritten
+	if d.HasChange("page_access_token") {
+		updateRequest.PageAccessToken = stringOrNil(d.Get("page_access_token").(string))
+	}
+	if d.HasChange("user_access_token") {
+		updateRequest.UserAccessToken = stringOrNil(d.Get("user_access_token").(string))
+	}
+
+	log.Printf("Updating instagram integration %s", name)
+	if _, _, err := proxy.updateInstagramIntegration(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update instagram integration %s: %s", name, err)
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated instagram integration %s", name)
+	return readRoutingMessageAddressInstagram(ctx, d, meta)
+}
+
+// deleteRoutingMessageAddressInstagram is used by the routing_message_address_instagram resource to delete a Instagram integration from Genesys Cloud
+func deleteRoutingMessageAddressInstagram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	if _, err := proxy.deleteInstagramIntegration(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete instagram integration %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getInstagramIntegration(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted instagram integration %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting instagram integration %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Instagram integration %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,169 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_open.go contains all of the methods that perform the core logic for
+the Open Messaging address resource.
+*/
+
+// getAllRoutingMessageAddressOpen retrieves all of the Open Messaging integrations via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingMessageAddressOpen(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingMessageAddressProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	integrations, _, err := proxy.getAllOpenIntegrations(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get open messaging integrations: %v", err)
+	}
+
+	for _, integration := range *integrations {
+		resources[*integration.Id] = &resourceExporter.ResourceMeta{Name: *integration.Name}
+	}
+	return resources, nil
+}
+
+// createRoutingMessageAddressOpen is used by the routing_message_address_open resource to create an Open Messaging integration
+func createRoutingMessageAddressOpen(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	webhookUrl := d.Get("outbound_notification_webhook_url").(string)
+	secretToken := d.Get("outbound_notification_webhook_signature_secret_token").(string)
+
+	log.Printf("Creating open messaging integration %s", name)
+	integration, _, err := proxy.createOpenIntegration(ctx, &platformclientv2.Openintegrationrequest{
+		Name:                           &name,
+		SupportedContent:               buildSupportedContentReference(d),
+		MessagingSetting:               buildMessagingSettingReference(d),
+		OutboundNotificationWebhookUrl: &webhookUrl,
+		OutboundNotificationWebhookSignatureSecretToken: &secretToken,
+		WebhookHeaders: buildWebhookHeaders(d),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create open messaging integration %s: %s", name, err)
+	}
+
+	d.SetId(*integration.Id)
+
+	diagErr := waitForIntegrationCreated(ctx, d.Id(), func() (*string, *platformclientv2.Errorbody, error) {
+		integration, _, err := proxy.getOpenIntegration(ctx, d.Id())
+		if err != nil {
+			return nil, nil, err
+		}
+		return integration.CreateStatus, integration.CreateError, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created open messaging integration %s %s", name, d.Id())
+	return readRoutingMessageAddressOpen(ctx, d, meta)
+}
+
+// readRoutingMessageAddressOpen is used by the routing_message_address_open resource to read an Open Messaging integration from Genesys Cloud
+func readRoutingMessageAddressOpen(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	log.Printf("Reading open messaging integration %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		integration, resp, getErr := proxy.getOpenIntegration(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read open messaging integration %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read open messaging integration %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingMessageAddressOpen())
+
+		resourcedata.SetNillableValue(d, "name", integration.Name)
+		resourcedata.SetNillableValue(d, "outbound_notification_webhook_url", integration.OutboundNotificationWebhookUrl)
+		resourcedata.SetNillableValue(d, "webhook_headers", integration.WebhookHeaders)
+		setSupportedContentAndMessagingSetting(d, integration.SupportedContent, integration.MessagingSetting)
+
+		if err := readInboundFlow(ctx, proxy, d); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read inbound flow of open messaging integration %s: %s", d.Id(), err))
+		}
+
+		log.Printf("Read open messaging integration %s %s", d.Id(), *integration.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingMessageAddressOpen is used by the routing_message_address_open resource to update an Open Messaging integration in Genesys Cloud
+func updateRoutingMessageAddressOpen(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	webhookUrl := d.Get("outbound_notification_webhook_url").(string)
+
+	updateRequest := platformclientv2.Openintegrationupdaterequest{
+		Name:                           &name,
+		SupportedContent:               buildSupportedContentReference(d),
+		MessagingSetting:               buildMessagingSettingReference(d),
+		OutboundNotificationWebhookUrl: &webhookUrl,
+		WebhookHeaders:                 buildWebhookHeaders(d),
+	}
+	if d.HasChange("outbound_notification_webhook_signature_secret_token") {
+		secretToken := d.Get("outbound_notification_webhook_signature_secret_token").(string)
+		updateRequest.OutboundNotificationWebhookSignatureSecretToken = &secretToken
+	}
+
+	log.Printf("Updating open messaging integration %s", name)
+	if _, _, err := proxy.updateOpenIntegration(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update open messaging integration %s: %s", name, err)
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated open messaging integration %s", name)
+	return readRoutingMessageAddressOpen(ctx, d, meta)
+}
+
+// deleteRoutingMessageAddressOpen is used by the routing_message_address_open resource to delete an Open Messaging integration from Genesys Cloud
+func deleteRoutingMessageAddressOpen(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	if _, err := proxy.deleteOpenIntegration(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete open messaging integration %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getOpenIntegration(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted open messaging integration %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting open messaging integration %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Open messaging integration %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,504 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingMessageAddressProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllOpenIntegrationsFunc func(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Openintegration, *platformclientv2.APIResponse, error)
+type getOpenIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error)
+type createOpenIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Openintegrationrequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error)
+type updateOpenIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Openintegrationupdaterequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error)
+type deleteOpenIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error)
+
+type getAllWhatsAppIntegrationsFunc func(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error)
+type getWhatsAppIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error)
+type createWhatsAppIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Whatsappintegrationrequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error)
+type updateWhatsAppIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Whatsappintegrationupdaterequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error)
+type deleteWhatsAppIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error)
+
+type getAllFacebookIntegrationsFunc func(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
+type getFacebookIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
+type createFacebookIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Facebookintegrationrequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
+type updateFacebookIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Facebookintegrationupdaterequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
+type deleteFacebookIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error)
+
+type getAllInstagramIntegrationsFunc func(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error)
+type getInstagramIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error)
+type createInstagramIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Instagramintegrationrequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error)
+type updateInstagramIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Instagramintegrationupdaterequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error)
+type deleteInstagramIntegrationFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error)
+
+type getRecipientFunc func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error)
+type updateRecipientFunc func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Recipientrequest) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error)
+
+// routingMessageAddressProxy contains all of the methods that call genesys cloud APIs.
+type routingMessageAddressProxy struct {
+	clientConfig     *platformclientv2.Configuration
+	conversationsApi *platformclientv2.ConversationsApi
+	routingApi       *platformclientv2.RoutingApi
+
+	getAllOpenIntegrationsAttr getAllOpenIntegrationsFunc
+	getOpenIntegrationAttr     getOpenIntegrationFunc
+	createOpenIntegrationAttr  createOpenIntegrationFunc
+	updateOpenIntegrationAttr  updateOpenIntegrationFunc
+	deleteOpenIntegrationAttr  deleteOpenIntegrationFunc
+
+	getAllWhatsAppIntegrationsAttr getAllWhatsAppIntegrationsFunc
+	getWhatsAppIntegrationAttr     getWhatsAppIntegrationFunc
+	createWhatsAppIntegrationAttr  createWhatsAppIntegrationFunc
+	updateWhatsAppIntegrationAttr  updateWhatsAppIntegrationFunc
+	deleteWhatsAppIntegrationAttr  deleteWhatsAppIntegrationFunc
+
+	getAllFacebookIntegrationsAttr getAllFacebookIntegrationsFunc
+	getFacebookIntegrationAttr     getFacebookIntegrationFunc
+	createFacebookIntegrationAttr  createFacebookIntegrationFunc
+	updateFacebookIntegrationAttr  updateFacebookIntegrationFunc
+	deleteFacebookIntegrationAttr  deleteFacebookIntegrationFunc
+
+	getAllInstagramIntegrationsAttr getAllInstagramIntegrationsFunc
+	getInstagramIntegrationAttr     getInstagramIntegrationFunc
+	createInstagramIntegrationAttr  createInstagramIntegrationFunc
+	updateInstagramIntegrationAttr  updateInstagramIntegrationFunc
+	deleteInstagramIntegrationAttr  deleteInstagramIntegrationFunc
+
+	getRecipientAttr    getRecipientFunc
+	updateRecipientAttr updateRecipientFunc
+}
+
+// newRoutingMessageAddressProxy initializes the routing message address proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingMessageAddressProxy(clientConfig *platformclientv2.Configuration) *routingMessageAddressProxy {
+	return &routingMessageAddressProxy{
+		clientConfig:     clientConfig,
+		conversationsApi: platformclientv2.NewConversationsApiWithConfig(clientConfig),
+		routingApi:       platformclientv2.NewRoutingApiWithConfig(clientConfig),
+
+		getAllOpenIntegrationsAttr: getAllOpenIntegrationsFn,
+		getOpenIntegrationAttr:     getOpenIntegrationFn,
+		createOpenIntegrationAttr:  createOpenIntegrationFn,
+		updateOpenIntegrationAttr:  updateOpenIntegrationFn,
+		deleteOpenIntegrationAttr:  deleteOpenIntegrationFn,
+
+		getAllWhatsAppIntegrationsAttr: getAllWhatsAppIntegrationsFn,
+		getWhatsAppIntegrationAttr:     getWhatsAppIntegrationFn,
+		createWhatsAppIntegrationAttr:  createWhatsAppIntegrationFn,
+		updateWhatsAppIntegrationAttr:  updateWhatsAppIntegrationFn,
+		deleteWhatsAppIntegrationAttr:  deleteWhatsAppIntegrationFn,
+
+		getAllFacebookIntegrationsAttr: getAllFacebookIntegrationsFn,
+		getFacebookIntegrationAttr:     getFacebookIntegrationFn,
+		createFacebookIntegrationAttr:  createFacebookIntegrationFn,
+		updateFacebookIntegrationAttr:  updateFacebookIntegrationFn,
+		deleteFacebookIntegrationAttr:  deleteFacebookIntegrationFn,
+
+		getAllInstagramIntegrationsAttr: getAllInstagramIntegrationsFn,
+		getInstagramIntegrationAttr:     getInstagramIntegrationFn,
+		createInstagramIntegrationAttr:  createInstagramIntegrationFn,
+		updateInstagramIntegrationAttr:  updateInstagramIntegrationFn,
+		deleteInstagramIntegrationAttr:  deleteInstagramIntegrationFn,
+
+		getRecipientAttr:    getRecipientFn,
+		updateRecipientAttr: updateRecipientFn,
+	}
+}
+
+// getRoutingMessageAddressProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingMessageAddressProxy(clientConfig *platformclientv2.Configuration) *routingMessageAddressProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingMessageAddressProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllOpenIntegrations retrieves all Open Messaging integrations
+func (p *routingMessageAddressProxy) getAllOpenIntegrations(ctx context.Context) (*[]platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	return p.getAllOpenIntegrationsAttr(ctx, p)
+}
+
+// getOpenIntegration retrieves an Open Messaging integration by id
+func (p *routingMessageAddressProxy) getOpenIntegration(ctx context.Context, id string) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	return p.getOpenIntegrationAttr(ctx, p, id)
+}
+
+// createOpenIntegration creates an Open Messaging integration
+func (p *routingMessageAddressProxy) createOpenIntegration(ctx context.Context, body *platformclientv2.Openintegrationrequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	return p.createOpenIntegrationAttr(ctx, p, body)
+}
+
+// updateOpenIntegration updates an Open Messaging integration
+func (p *routingMessageAddressProxy) updateOpenIntegration(ctx context.Context, id string, body *platformclientv2.Openintegrationupdaterequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	return p.updateOpenIntegrationAttr(ctx, p, id, body)
+}
+
+// deleteOpenIntegration deletes an Open Messaging integration
+func (p *routingMessageAddressProxy) deleteOpenIntegration(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteOpenIntegrationAttr(ctx, p, id)
+}
+
+// getAllWhatsAppIntegrations retrieves all WhatsApp integrations
+func (p *routingMessageAddressProxy) getAllWhatsAppIntegrations(ctx context.Context) (*[]platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	return p.getAllWhatsAppIntegrationsAttr(ctx, p)
+}
+
+// getWhatsAppIntegration retrieves a WhatsApp integration by id
+func (p *routingMessageAddressProxy) getWhatsAppIntegration(ctx context.Context, id string) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	return p.getWhatsAppIntegrationAttr(ctx, p, id)
+}
+
+// createWhatsAppIntegration creates a WhatsApp integration
+func (p *routingMessageAddressProxy) createWhatsAppIntegration(ctx context.Context, body *platformclientv2.Whatsappintegrationrequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	return p.createWhatsAppIntegrationAttr(ctx, p, body)
+}
+
+// updateWhatsAppIntegration updates a WhatsApp integration
+func (p *routingMessageAddressProxy) updateWhatsAppIntegration(ctx context.Context, id string, body *platformclientv2.Whatsappintegrationupdaterequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	return p.updateWhatsAppIntegrationAttr(ctx, p, id, body)
+}
+
+// deleteWhatsAppIntegration deletes a WhatsApp integration
+func (p *routingMessageAddressProxy) deleteWhatsAppIntegration(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWhatsAppIntegrationAttr(ctx, p, id)
+}
+
+// getAllFacebookIntegrations retrieves all Facebook integrations
+func (p *routingMessageAddressProxy) getAllFacebookIntegrations(ctx context.Context) (*[]platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	return p.getAllFacebookIntegrationsAttr(ctx, p)
+}
+
+// getFacebookIntegration retrieves a Facebook integration by id
+func (p *routingMessageAddressProxy) getFacebookIntegration(ctx context.Context, id string) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	return p.getFacebookIntegrationAttr(ctx, p, id)
+}
+
+// createFacebookIntegration creates a Facebook integration
+func (p *routingMessageAddressProxy) createFacebookIntegration(ctx context.Context, body *platformclientv2.Facebookintegrationrequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	return p.createFacebookIntegrationAttr(ctx, p, body)
+}
+
+// updateFacebookIntegration updates a Facebook integration
+func (p *routingMessageAddressProxy) updateFacebookIntegration(ctx context.Context, id string, body *platformclientv2.Facebookintegrationupdaterequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	return p.updateFacebookIntegrationAttr(ctx, p, id, body)
+}
+
+// deleteFacebookIntegration deletes a Facebook integration
+func (p *routingMessageAddressProxy) deleteFacebookIntegration(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteFacebookIntegrationAttr(ctx, p, id)
+}
+
+// getAllInstagramIntegrations retrieves all Instagram integrations
+func (p *routingMessageAddressProxy) getAllInstagramIntegrations(ctx context.Context) (*[]platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	return p.getAllInstagramIntegrationsAttr(ctx, p)
+}
+
+// getInstagramIntegration retrieves an Instagram integration by id
+func (p *routingMessageAddressProxy) getInstagramIntegration(ctx context.Context, id string) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	return p.getInstagramIntegrationAttr(ctx, p, id)
+}
+
+// createInstagramIntegration creates an Instagram integration
+func (p *routingMessageAddressProxy) createInstagramIntegration(ctx context.Context, body *platformclientv2.Instagramintegrationrequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	return p.createInstagramIntegrationAttr(ctx, p, body)
+}
+
+// updateInstagramIntegration updates an Instagram integration
+func (p *routingMessageAddressProxy) updateInstagramIntegration(ctx context.Context, id string, body *platformclientv2.Instagramintegrationupdaterequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	return p.updateInstagramIntegrationAttr(ctx, p, id, body)
+}
+
+// deleteInstagramIntegration deletes an Instagram integration
+func (p *routingMessageAddressProxy) deleteInstagramIntegration(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteInstagramIntegrationAttr(ctx, p, id)
+}
+
+// getRecipient retrieves the message recipient of an integration. Recipients share the id of their integration.
+func (p *routingMessageAddressProxy) getRecipient(ctx context.Context, id string) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+	return p.getRecipientAttr(ctx, p, id)
+}
+
+// updateRecipient updates the message recipient of an integration
+func (p *routingMessageAddressProxy) updateRecipient(ctx context.Context, id string, body *platformclientv2.Recipientrequest) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+	return p.updateRecipientAttr(ctx, p, id, body)
+}
+
+// getAllOpenIntegrationsFn is the implementation for retrieving all Open Messaging integrations in Genesys Cloud
+func getAllOpenIntegrationsFn(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	var allIntegrations []platformclientv2.Openintegration
+	const pageSize = 100
+
+	integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsOpen(pageSize, 1, "", "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get open messaging integrations: %s", err)
+	}
+	if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+		return &allIntegrations, resp, nil
+	}
+	allIntegrations = append(allIntegrations, *integrations.Entities...)
+
+	for pageNum := 2; pageNum <= *integrations.PageCount; pageNum++ {
+		integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsOpen(pageSize, pageNum, "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get open messaging integrations: %s", err)
+		}
+		if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+			break
+		}
+		allIntegrations = append(allIntegrations, *integrations.Entities...)
+	}
+	return &allIntegrations, resp, nil
+}
+
+// getOpenIntegrationFn is the implementation for retrieving an Open Messaging integration in Genesys Cloud
+func getOpenIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsOpenIntegrationId(id, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get open messaging integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// createOpenIntegrationFn is the implementation for creating an Open Messaging integration in Genesys Cloud
+func createOpenIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Openintegrationrequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PostConversationsMessagingIntegrationsOpen(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create open messaging integration: %s", err)
+	}
+	return integration, resp, nil
+}
+
+// updateOpenIntegrationFn is the implementation for updating an Open Messaging integration in Genesys Cloud
+func updateOpenIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Openintegrationupdaterequest) (*platformclientv2.Openintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PatchConversationsMessagingIntegrationsOpenIntegrationId(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update open messaging integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// deleteOpenIntegrationFn is the implementation for deleting an Open Messaging integration in Genesys Cloud
+func deleteOpenIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.conversationsApi.DeleteConversationsMessagingIntegrationsOpenIntegrationId(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete open messaging integration %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getAllWhatsAppIntegrationsFn is the implementation for retrieving all WhatsApp integrations in Genesys Cloud
+func getAllWhatsAppIntegrationsFn(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	var allIntegrations []platformclientv2.Whatsappintegration
+	const pageSize = 100
+
+	integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsWhatsapp(pageSize, 1, "", "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get whatsapp integrations: %s", err)
+	}
+	if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+		return &allIntegrations, resp, nil
+	}
+	allIntegrations = append(allIntegrations, *integrations.Entities...)
+
+	for pageNum := 2; pageNum <= *integrations.PageCount; pageNum++ {
+		integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsWhatsapp(pageSize, pageNum, "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get whatsapp integrations: %s", err)
+		}
+		if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+			break
+		}
+		allIntegrations = append(allIntegrations, *integrations.Entities...)
+	}
+	return &allIntegrations, resp, nil
+}
+
+// getWhatsAppIntegrationFn is the implementation for retrieving a WhatsApp integration in Genesys Cloud
+func getWhatsAppIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsWhatsappIntegrationId(id, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get whatsapp integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// createWhatsAppIntegrationFn is the implementation for creating a WhatsApp integration in Genesys Cloud
+func createWhatsAppIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Whatsappintegrationrequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PostConversationsMessagingIntegrationsWhatsapp(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create whatsapp integration: %s", err)
+	}
+	return integration, resp, nil
+}
+
+// updateWhatsAppIntegrationFn is the implementation for updating a WhatsApp integration in Genesys Cloud
+func updateWhatsAppIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Whatsappintegrationupdaterequest) (*platformclientv2.Whatsappintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PatchConversationsMessagingIntegrationsWhatsappIntegrationId(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update whatsapp integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// deleteWhatsAppIntegrationFn is the implementation for deleting a WhatsApp integration in Genesys Cloud
+func deleteWhatsAppIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.conversationsApi.DeleteConversationsMessagingIntegrationsWhatsappIntegrationId(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete whatsapp integration %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getAllFacebookIntegrationsFn is the implementation for retrieving all Facebook integrations in Genesys Cloud
+func getAllFacebookIntegrationsFn(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	var allIntegrations []platformclientv2.Facebookintegration
+	const pageSize = 100
+
+	integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsFacebook(pageSize, 1, "", "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get facebook integrations: %s", err)
+	}
+	if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+		return &allIntegrations, resp, nil
+	}
+	allIntegrations = append(allIntegrations, *integrations.Entities...)
+
+	for pageNum := 2; pageNum <= *integrations.PageCount; pageNum++ {
+		integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsFacebook(pageSize, pageNum, "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get facebook integrations: %s", err)
+		}
+		if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+			break
+		}
+		allIntegrations = append(allIntegrations, *integrations.Entities...)
+	}
+	return &allIntegrations, resp, nil
+}
+
+// getFacebookIntegrationFn is the implementation for retrieving a Facebook integration in Genesys Cloud
+func getFacebookIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsFacebookIntegrationId(id, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get facebook integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// createFacebookIntegrationFn is the implementation for creating a Facebook integration in Genesys Cloud
+func createFacebookIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Facebookintegrationrequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PostConversationsMessagingIntegrationsFacebook(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create facebook integration: %s", err)
+	}
+	return integration, resp, nil
+}
+
+// updateFacebookIntegrationFn is the implementation for updating a Facebook integration in Genesys Cloud
+func updateFacebookIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Facebookintegrationupdaterequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PatchConversationsMessagingIntegrationsFacebookIntegrationId(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update facebook integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// deleteFacebookIntegrationFn is the implementation for deleting a Facebook integration in Genesys Cloud
+func deleteFacebookIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.conversationsApi.DeleteConversationsMessagingIntegrationsFacebookIntegrationId(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete facebook integration %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getAllInstagramIntegrationsFn is the implementation for retrieving all Instagram integrations in Genesys Cloud
+func getAllInstagramIntegrationsFn(ctx context.Context, p *routingMessageAddressProxy) (*[]platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	var allIntegrations []platformclientv2.Instagramintegration
+	const pageSize = 100
+
+	integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsInstagram(pageSize, 1, "", "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get instagram integrations: %s", err)
+	}
+	if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+		return &allIntegrations, resp, nil
+	}
+	allIntegrations = append(allIntegrations, *integrations.Entities...)
+
+	for pageNum := 2; pageNum <= *integrations.PageCount; pageNum++ {
+		integrations, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsInstagram(pageSize, pageNum, "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get instagram integrations: %s", err)
+		}
+		if integrations.Entities == nil || len(*integrations.Entities) == 0 {
+			break
+		}
+		allIntegrations = append(allIntegrations, *integrations.Entities...)
+	}
+	return &allIntegrations, resp, nil
+}
+
+// getInstagramIntegrationFn is the implementation for retrieving an Instagram integration in Genesys Cloud
+func getInstagramIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.GetConversationsMessagingIntegrationsInstagramIntegrationId(id, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get instagram integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// createInstagramIntegrationFn is the implementation for creating an Instagram integration in Genesys Cloud
+func createInstagramIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, body *platformclientv2.Instagramintegrationrequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PostConversationsMessagingIntegrationsInstagram(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create instagram integration: %s", err)
+	}
+	return integration, resp, nil
+}
+
+// updateInstagramIntegrationFn is the implementation for updating an Instagram integration in Genesys Cloud
+func updateInstagramIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Instagramintegrationupdaterequest) (*platformclientv2.Instagramintegration, *platformclientv2.APIResponse, error) {
+	integration, resp, err := p.conversationsApi.PatchConversationsMessagingIntegrationsInstagramIntegrationId(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update instagram integration %s: %s", id, err)
+	}
+	return integration, resp, nil
+}
+
+// deleteInstagramIntegrationFn is the implementation for deleting an Instagram integration in Genesys Cloud
+func deleteInstagramIntegrationFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.conversationsApi.DeleteConversationsMessagingIntegrationsInstagramIntegrationId(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete instagram integration %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getRecipientFn is the implementation for retrieving a message recipient in Genesys Cloud
+func getRecipientFn(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+	recipient, resp, err := p.routingApi.GetRoutingMessageRecipient(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get message recipient %s: %s", id, err)
+	}
+	return recipient, resp, nil
+}
+
+// updateRecipientFn is the implementation for updating a message recipient in Genesys Cloud
+func updateRecipientFn(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Recipientrequest) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+	recipient, resp, err := p.routingApi.PutRoutingMessageRecipient(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update message recipient %s: %s", id, err)
+	}
+	return recipient, resp, nil
+}
@@ -0,0 +1,256 @@
+package routing_message_address
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_routing_message_address_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resources and Exporters for the package.
+2.  The resource schema definitions for the Open Messaging, WhatsApp, Facebook and Instagram message address resources.
+3.  The resource exporter configuration for each of the message address exporters.
+*/
+const (
+	openResourceName      = "genesyscloud_routing_message_address_open"
+	whatsAppResourceName  = "genesyscloud_routing_message_address_whatsapp"
+	facebookResourceName  = "genesyscloud_routing_message_address_facebook"
+	instagramResourceName = "genesyscloud_routing_message_address_instagram"
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(openResourceName, ResourceRoutingMessageAddressOpen())
+	regInstance.RegisterExporter(openResourceName, RoutingMessageAddressOpenExporter())
+	regInstance.RegisterResource(whatsAppResourceName, ResourceRoutingMessageAddressWhatsApp())
+	regInstance.RegisterExporter(whatsAppResourceName, RoutingMessageAddressWhatsAppExporter())
+	regInstance.RegisterResource(facebookResourceName, ResourceRoutingMessageAddressFacebook())
+	regInstance.RegisterExporter(facebookResourceName, RoutingMessageAddressFacebookExporter())
+	regInstance.RegisterResource(instagramResourceName, ResourceRoutingMessageAddressInstagram())
+	regInstance.RegisterExporter(instagramResourceName, RoutingMessageAddressInstagramExporter())
+}
+
+// messageAddressSchema returns the attributes shared by all messaging integrations merged with the attributes of a
+// single integration type
+func messageAddressSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	commonAttributes := map[string]*schema.Schema{
+		"name": {
+			Description: "Name of the messaging integration.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"supported_content_id": {
+			Description: "ID of the supported content profile that defines the attachments allowed on this address.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"messaging_setting_id": {
+			Description: "ID of the messaging setting applied to this address.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"inbound_flow_id": {
+			Description: "ID of the inbound message flow that routes messages received at this address. If not set, this resource will not manage the flow of the address.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+	for name, attribute := range attributes {
+		commonAttributes[name] = attribute
+	}
+	return commonAttributes
+}
+
+// ResourceRoutingMessageAddressOpen registers the genesyscloud_routing_message_address_open resource with Terraform
+func ResourceRoutingMessageAddressOpen() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Open Messaging integration. The ID of the resource is the ID of its message recipient, which queues reference as their outbound Open Messaging address.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingMessageAddressOpen),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingMessageAddressOpen),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingMessageAddressOpen),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingMessageAddressOpen),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: messageAddressSchema(map[string]*schema.Schema{
+			"outbound_notification_webhook_url": {
+				Description:  "The URL that Genesys Cloud sends outbound messages and events to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"outbound_notification_webhook_signature_secret_token": {
+				Description: "The secret used to sign the outbound webhook requests. The token is write-only, so changes made outside of Terraform are not detected.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"webhook_headers": {
+				Description: "Headers added to each outbound webhook request.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+// ResourceRoutingMessageAddressWhatsApp registers the genesyscloud_routing_message_address_whatsapp resource with Terraform
+func ResourceRoutingMessageAddressWhatsApp() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud WhatsApp integration. The ID of the resource is the ID of its message recipient, which queues reference as their outbound WhatsApp address.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingMessageAddressWhatsApp),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingMessageAddressWhatsApp),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingMessageAddressWhatsApp),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingMessageAddressWhatsApp),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: messageAddressSchema(map[string]*schema.Schema{
+			"phone_number": {
+				Description: "The phone number of the WhatsApp Business account in E.164 format. Changing the phone number creates a new integration.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"waba_certificate": {
+				Description: "The certificate of the WhatsApp Business account. The certificate is write-only, so changes made outside of Terraform are not detected.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+		}),
+	}
+}
+
+// ResourceRoutingMessageAddressFacebook registers the genesyscloud_routing_message_address_facebook resource with Terraform
+func ResourceRoutingMessageAddressFacebook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Facebook Messenger integration.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingMessageAddressFacebook),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingMessageAddressFacebook),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingMessageAddressFacebook),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingMessageAddressFacebook),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema:        messageAddressSchema(metaIntegrationSchema()),
+	}
+}
+
+// ResourceRoutingMessageAddressInstagram registers the genesyscloud_routing_message_address_instagram resource with Terraform
+func ResourceRoutingMessageAddressInstagram() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Instagram integration.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingMessageAddressInstagram),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingMessageAddressInstagram),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingMessageAddressInstagram),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingMessageAddressInstagram),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema:        messageAddressSchema(metaIntegrationSchema()),
+	}
+}
+
+// metaIntegrationSchema returns the attributes of the integrations connected through a Meta app. Facebook and
+// Instagram integrations are created with the same credentials.
+func metaIntegrationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"page_id": {
+			Description: "The ID of the Facebook page. Changing the page creates a new integration.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+		},
+		"app_id": {
+			Description: "The ID of the Meta app. Changing the app creates a new integration.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+		},
+		"app_secret": {
+			Description: "The secret of the Meta app. The secret is write-only, so changes made outside of Terraform are not detected.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+		},
+		"page_access_token": {
+			Description: "The access token of the Facebook page. Either page_access_token or user_access_token must be set. The token is write-only, so changes made outside of Terraform are not detected.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"user_access_token": {
+			Description: "The access token of a user that manages the Facebook page. The token is write-only, so changes made outside of Terraform are not detected.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+// RoutingMessageAddressOpenExporter returns the resourceExporter object used to hold the genesyscloud_routing_message_address_open exporter's config
+func RoutingMessageAddressOpenExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingMessageAddressOpen),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"inbound_flow_id": {RefType: "genesyscloud_flow"},
+		},
+		UnResolvableAttributes: map[string]*schema.Schema{
+			"outbound_notification_webhook_signature_secret_token": ResourceRoutingMessageAddressOpen().Schema["outbound_notification_webhook_signature_secret_token"],
+		},
+	}
+}
+
+// RoutingMessageAddressWhatsAppExporter returns the resourceExporter object used to hold the genesyscloud_routing_message_address_whatsapp exporter's config
+func RoutingMessageAddressWhatsAppExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingMessageAddressWhatsApp),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"inbound_flow_id": {RefType: "genesyscloud_flow"},
+		},
+		UnResolvableAttributes: map[string]*schema.Schema{
+			"waba_certificate": ResourceRoutingMessageAddressWhatsApp().Schema["waba_certificate"],
+		},
+	}
+}
+
+// RoutingMessageAddressFacebookExporter returns the resourceExporter object used to hold the genesyscloud_routing_message_address_facebook exporter's config
+func RoutingMessageAddressFacebookExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingMessageAddressFacebook),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"inbound_flow_id": {RefType: "genesyscloud_flow"},
+		},
+	}
+}
+
+// RoutingMessageAddressInstagramExporter returns the resourceExporter object used to hold the genesyscloud_routing_message_address_instagram exporter's config
+func RoutingMessageAddressInstagramExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingMessageAddressInstagram),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"inbound_flow_id": {RefType: "genesyscloud_flow"},
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_test.go contains all of the test cases for running the resource
+tests for the routing message address resources. WhatsApp, Facebook and Instagram integrations need credentials of
+real third party accounts, so only the Open Messaging address is tested here.
+*/
+
+func TestAccResourceRoutingMessageAddressOpen(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId   = "test-open-address"
+		fullName     = openResourceName + "." + resourceId
+		name1        = "Terraform Open Messaging " + uuid.NewString()
+		name2        = "Terraform Open Messaging " + uuid.NewString()
+		webhookUrl1  = "https://example.com/messages"
+		webhookUrl2  = "https://example.com/messages/v2"
+		secretToken1 = uuid.NewString()
+		secretToken2 = uuid.NewString()
+
+		queueResourceId = "test-queue"
+		queueName       = "Terraform Open Messaging Queue " + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateRoutingMessageAddressOpenResource(resourceId, name1, webhookUrl1, secretToken1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "outbound_notification_webhook_url", webhookUrl1),
+					resource.TestCheckResourceAttr(fullName, "outbound_notification_webhook_signature_secret_token", secretToken1),
+					resource.TestCheckResourceAttr(fullName, "webhook_headers.%", "0"),
+				),
+			},
+			{
+				// Update and reference the address from a queue
+				Config: GenerateRoutingMessageAddressOpenResource(
+					resourceId,
+					name2,
+					webhookUrl2,
+					secretToken2,
+					`webhook_headers = {
+		"X-Terraform-Test" = "true"
+	}`,
+				) + gcloud.GenerateRoutingQueueResourceBasic(
+					queueResourceId,
+					queueName,
+					"outbound_messaging_open_messaging_recipient_id = "+fullName+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "outbound_notification_webhook_url", webhookUrl2),
+					resource.TestCheckResourceAttr(fullName, "webhook_headers.%", "1"),
+					resource.TestCheckResourceAttr(fullName, "webhook_headers.X-Terraform-Test", "true"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResourceId, "outbound_messaging_open_messaging_recipient_id", fullName, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            fullName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"outbound_notification_webhook_signature_secret_token"},
+			},
+		},
+		CheckDestroy: testVerifyOpenMessagingAddressDestroyed,
+	})
+}
+
+func testVerifyOpenMessagingAddressDestroyed(state *terraform.State) error {
+	proxy := newRoutingMessageAddressProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != openResourceName {
+			continue
+		}
+
+		integration, resp, err := proxy.getOpenIntegration(context.Background(), rs.Primary.ID)
+		if integration != nil {
+			return fmt.Errorf("Open messaging integration (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Integration not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All integrations destroyed
+	return nil
+}
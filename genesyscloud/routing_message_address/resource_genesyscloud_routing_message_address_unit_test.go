@@ -0,0 +1,58 @@
+package routing_message_address
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitWaitForIntegrationCreated(t *testing.T) {
+	statuses := []string{"Initiated", "Initiated", "Completed"}
+	calls := 0
+	diagErr := waitForIntegrationCreated(context.Background(), "integration-1", func() (*string, *platformclientv2.Errorbody, error) {
+		status := statuses[calls]
+		calls++
+		return &status, nil, nil
+	})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, len(statuses), calls)
+
+	diagErr = waitForIntegrationCreated(context.Background(), "integration-2", func() (*string, *platformclientv2.Errorbody, error) {
+		return platformclientv2.String("Error"), &platformclientv2.Errorbody{Message: platformclientv2.String("invalid certificate")}, nil
+	})
+	assert.NotNil(t, diagErr)
+	assert.Contains(t, diagErr[0].Summary, "invalid certificate")
+}
+
+func TestUnitUpdateInboundFlow(t *testing.T) {
+	integrationId := "integration-1"
+	flowId := "flow-1"
+	var updatedFlowId *string
+
+	proxy := &routingMessageAddressProxy{}
+	proxy.updateRecipientAttr = func(ctx context.Context, p *routingMessageAddressProxy, id string, body *platformclientv2.Recipientrequest) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, integrationId, id)
+		updatedFlowId = body.Flow.Id
+		return &platformclientv2.Recipient{Id: &id}, nil, nil
+	}
+	proxy.getRecipientAttr = func(ctx context.Context, p *routingMessageAddressProxy, id string) (*platformclientv2.Recipient, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Recipient{Id: &id, Flow: &platformclientv2.Flow{Id: &flowId}}, nil, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingMessageAddressOpen().Schema, map[string]interface{}{
+		"name":            "Open Messaging",
+		"inbound_flow_id": flowId,
+	})
+	d.SetId(integrationId)
+
+	diagErr := updateInboundFlow(context.Background(), proxy, d)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, flowId, *updatedFlowId)
+
+	_ = d.Set("inbound_flow_id", nil)
+	assert.Nil(t, readInboundFlow(context.Background(), proxy, d))
+	assert.Equal(t, flowId, d.Get("inbound_flow_id").(string))
+}
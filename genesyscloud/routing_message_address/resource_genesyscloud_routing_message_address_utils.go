@@ -0,0 +1,152 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// Integrations are provisioned asynchronously and report their progress in createStatus
+const (
+	createStatusInitiated = "Initiated"
+	createStatusError     = "Error"
+)
+
+func buildSupportedContentReference(d *schema.ResourceData) *platformclientv2.Supportedcontentreference {
+	if supportedContentId := d.Get("supported_content_id").(string); supportedContentId != "" {
+		return &platformclientv2.Supportedcontentreference{Id: &supportedContentId}
+	}
+	return nil
+}
+
+func buildMessagingSettingReference(d *schema.ResourceData) *platformclientv2.Messagingsettingrequestreference {
+	if messagingSettingId := d.Get("messaging_setting_id").(string); messagingSettingId != "" {
+		return &platformclientv2.Messagingsettingrequestreference{Id: &messagingSettingId}
+	}
+	return nil
+}
+
+// buildWebhookHeaders converts the webhook_headers map into the string map expected by the API
+func buildWebhookHeaders(d *schema.ResourceData) *map[string]string {
+	headers := make(map[string]string)
+	for key, value := range d.Get("webhook_headers").(map[string]interface{}) {
+		headers[key] = value.(string)
+	}
+	return &headers
+}
+
+// setSupportedContentAndMessagingSetting sets the references shared by all messaging integrations
+func setSupportedContentAndMessagingSetting(d *schema.ResourceData, supportedContent *platformclientv2.Supportedcontentreference, messagingSetting *platformclientv2.Messagingsettingreference) {
+	if supportedContent != nil && supportedContent.Id != nil {
+		_ = d.Set("supported_content_id", *supportedContent.Id)
+	} else {
+		_ = d.Set("supported_content_id", nil)
+	}
+	if messagingSetting != nil && messagingSetting.Id != nil {
+		_ = d.Set("messaging_setting_id", *messagingSetting.Id)
+	} else {
+		_ = d.Set("messaging_setting_id", nil)
+	}
+}
+
+// stringOrNil returns nil for empty strings so that write-only values are only sent when they are set
+func stringOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// waitForIntegrationCreated polls an integration until it is no longer being provisioned
+func waitForIntegrationCreated(ctx context.Context, id string, getCreateStatus func() (*string, *platformclientv2.Errorbody, error)) diag.Diagnostics {
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		createStatus, createError, err := getCreateStatus()
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if createStatus == nil {
+			return nil
+		}
+		switch *createStatus {
+		case createStatusInitiated:
+			return retry.RetryableError(fmt.Errorf("messaging integration %s is still being created", id))
+		case createStatusError:
+			message := "unknown error"
+			if createError != nil && createError.Message != nil {
+				message = *createError.Message
+			}
+			return retry.NonRetryableError(fmt.Errorf("messaging integration %s failed to be created: %s", id, message))
+		}
+		return nil
+	})
+}
+
+// readInboundFlow sets the inbound flow of the message recipient that belongs to an integration
+func readInboundFlow(ctx context.Context, proxy *routingMessageAddressProxy, d *schema.ResourceData) error {
+	recipient, resp, err := proxy.getRecipient(ctx, d.Id())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			// The recipient is created shortly after the integration
+			_ = d.Set("inbound_flow_id", nil)
+			return nil
+		}
+		return err
+	}
+	if recipient.Flow != nil && recipient.Flow.Id != nil {
+		_ = d.Set("inbound_flow_id", *recipient.Flow.Id)
+	} else {
+		_ = d.Set("inbound_flow_id", nil)
+	}
+	return nil
+}
+
+// updateInboundFlow sets the inbound flow of the message recipient that belongs to an integration
+func updateInboundFlow(ctx context.Context, proxy *routingMessageAddressProxy, d *schema.ResourceData) diag.Diagnostics {
+	if !d.HasChange("inbound_flow_id") {
+		return nil
+	}
+	flowId := d.Get("inbound_flow_id").(string)
+
+	recipientRequest := platformclientv2.Recipientrequest{}
+	if flowId != "" {
+		recipientRequest.Flow = &platformclientv2.Recipientflow{Id: &flowId}
+	}
+
+	log.Printf("Updating inbound flow of messaging integration %s", d.Id())
+	// The recipient is created asynchronously, so it may not exist yet right after the integration is created
+	return gcloud.WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.updateRecipient(ctx, d.Id(), &recipientRequest)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// GenerateRoutingMessageAddressOpenResource generates a terraform string for an Open Messaging address resource
+func GenerateRoutingMessageAddressOpenResource(resourceId string, name string, webhookUrl string, secretToken string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_message_address_open" "%s" {
+	name                                                  = "%s"
+	outbound_notification_webhook_url                     = "%s"
+	outbound_notification_webhook_signature_secret_token = "%s"
+	%s
+}
+`, resourceId, name, webhookUrl, secretToken, strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,159 @@
+package routing_message_address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_message_address_whatsapp.go contains all of the methods that perform the core logic for
+the WhatsApp address resource.
+*/
+
+// getAllRoutingMessageAddressWhatsApp retrieves all of the WhatsApp integrations via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingMessageAddressWhatsApp(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingMessageAddressProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	integrations, _, err := proxy.getAllWhatsAppIntegrations(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get whatsapp integrations: %v", err)
+	}
+
+	for _, integration := range *integrations {
+		resources[*integration.Id] = &resourceExporter.ResourceMeta{Name: *integration.Name}
+	}
+	return resources, nil
+}
+
+// createRoutingMessageAddressWhatsApp is used by the routing_message_address_whatsapp resource to create a WhatsApp integration
+func createRoutingMessageAddressWhatsApp(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	phoneNumber := d.Get("phone_number").(string)
+	wabaCertificate := d.Get("waba_certificate").(string)
+
+	log.Printf("Creating whatsapp integration %s", name)
+	integration, _, err := proxy.createWhatsAppIntegration(ctx, &platformclientv2.Whatsappintegrationrequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+		PhoneNumber:      &phoneNumber,
+		WabaCertificate:  &wabaCertificate,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create whatsapp integration %s: %s", name, err)
+	}
+
+	d.SetId(*integration.Id)
+
+	diagErr := waitForIntegrationCreated(ctx, d.Id(), func() (*string, *platformclientv2.Errorbody, error) {
+		integration, _, err := proxy.getWhatsAppIntegration(ctx, d.Id())
+		if err != nil {
+			return nil, nil, err
+		}
+		return integration.CreateStatus, integration.CreateError, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created whatsapp integration %s %s", name, d.Id())
+	return readRoutingMessageAddressWhatsApp(ctx, d, meta)
+}
+
+// readRoutingMessageAddressWhatsApp is used by the routing_message_address_whatsapp resource to read a WhatsApp integration from Genesys Cloud
+func readRoutingMessageAddressWhatsApp(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	log.Printf("Reading whatsapp integration %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		integration, resp, getErr := proxy.getWhatsAppIntegration(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read whatsapp integration %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read whatsapp integration %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingMessageAddressWhatsApp())
+
+		resourcedata.SetNillableValue(d, "name", integration.Name)
+		resourcedata.SetNillableValue(d, "phone_number", integration.PhoneNumber)
+		setSupportedContentAndMessagingSetting(d, integration.SupportedContent, integration.MessagingSetting)
+
+		if err := readInboundFlow(ctx, proxy, d); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read inbound flow of whatsapp integration %s: %s", d.Id(), err))
+		}
+
+		log.Printf("Read whatsapp integration %s %s", d.Id(), *integration.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingMessageAddressWhatsApp is used by the routing_message_address_whatsapp resource to update a WhatsApp integration in Genesys Cloud
+func updateRoutingMessageAddressWhatsApp(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	log.Printf("Updating whatsapp integration %s", name)
+	_, _, err := proxy.updateWhatsAppIntegration(ctx, d.Id(), &platformclientv2.Whatsappintegrationupdaterequest{
+		Name:             &name,
+		SupportedContent: buildSupportedContentReference(d),
+		MessagingSetting: buildMessagingSettingReference(d),
+	})
+	if err != nil {
+		return diag.Errorf("Failed to update whatsapp integration %s: %s", name, err)
+	}
+
+	if diagErr := updateInboundFlow(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated whatsapp integration %s", name)
+	return readRoutingMessageAddressWhatsApp(ctx, d, meta)
+}
+
+// deleteRoutingMessageAddressWhatsApp is used by the routing_message_address_whatsapp resource to delete a WhatsApp integration from Genesys Cloud
+func deleteRoutingMessageAddressWhatsApp(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingMessageAddressProxy(sdkConfig)
+
+	if _, err := proxy.deleteWhatsAppIntegration(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete whatsapp integration %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getWhatsAppIntegration(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted whatsapp integration %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting whatsapp integration %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Whatsapp integration %s still exists", d.Id()))
+	})
+}
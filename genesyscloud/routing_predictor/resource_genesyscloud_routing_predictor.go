@@ -0,0 +1,149 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllRoutingPredictors retrieves all of the routing predictors via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingPredictors(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingPredictorProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	predictors, _, err := proxy.getAllRoutingPredictors(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get routing predictors: %v", err)
+	}
+
+	for _, predictor := range *predictors {
+		// Predictors have no name, so the KPI is combined with the id to keep the exported names unique
+		resources[*predictor.Id] = &resourceExporter.ResourceMeta{Name: *predictor.Kpi + "_" + *predictor.Id}
+	}
+	return resources, nil
+}
+
+// createRoutingPredictor is used by the routing_predictor resource to create a Genesys Cloud routing predictor
+func createRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	kpi := d.Get("kpi").(string)
+	queueIds := lists.BuildSdkStringList(d, "queue_ids")
+
+	createRequest := platformclientv2.Createpredictorrequest{
+		QueueIds:                queueIds,
+		Kpi:                     &kpi,
+		Schedule:                buildPredictorSchedule(d),
+		WorkloadBalancingConfig: buildPredictorWorkloadBalancing(d),
+	}
+	if routingTimeout, ok := d.GetOk("routing_timeout_seconds"); ok {
+		routingTimeoutSeconds := routingTimeout.(int)
+		createRequest.RoutingTimeoutSeconds = &routingTimeoutSeconds
+	}
+
+	log.Printf("Creating routing predictor for KPI %s", kpi)
+	predictor, _, err := proxy.createRoutingPredictor(ctx, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create routing predictor for KPI %s: %s", kpi, err)
+	}
+
+	d.SetId(*predictor.Id)
+	log.Printf("Created routing predictor %s", *predictor.Id)
+	return readRoutingPredictor(ctx, d, meta)
+}
+
+// readRoutingPredictor is used by the routing_predictor resource to read a routing predictor from Genesys Cloud
+func readRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	log.Printf("Reading routing predictor %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		predictor, resp, getErr := proxy.getRoutingPredictor(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read routing predictor %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read routing predictor %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingPredictor())
+
+		if predictor.Queues != nil {
+			_ = d.Set("queue_ids", flattenPredictorQueueIds(predictor.Queues))
+		} else {
+			_ = d.Set("queue_ids", nil)
+		}
+		resourcedata.SetNillableValue(d, "kpi", predictor.Kpi)
+		resourcedata.SetNillableValue(d, "routing_timeout_seconds", predictor.RoutingTimeoutSeconds)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "schedule", predictor.Schedule, flattenPredictorSchedule)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "workload_balancing_config", predictor.WorkloadBalancingConfig, flattenPredictorWorkloadBalancing)
+		resourcedata.SetNillableValue(d, "state", predictor.State)
+
+		log.Printf("Read routing predictor %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingPredictor is used by the routing_predictor resource to update a routing predictor in Genesys Cloud
+func updateRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	updateRequest := platformclientv2.Patchpredictorrequest{
+		Schedule:                buildPredictorSchedule(d),
+		WorkloadBalancingConfig: buildPredictorWorkloadBalancing(d),
+	}
+	if routingTimeout, ok := d.GetOk("routing_timeout_seconds"); ok {
+		routingTimeoutSeconds := routingTimeout.(int)
+		updateRequest.RoutingTimeoutSeconds = &routingTimeoutSeconds
+	}
+
+	log.Printf("Updating routing predictor %s", d.Id())
+	if _, _, err := proxy.updateRoutingPredictor(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update routing predictor %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Updated routing predictor %s", d.Id())
+	return readRoutingPredictor(ctx, d, meta)
+}
+
+// deleteRoutingPredictor is used by the routing_predictor resource to delete a routing predictor from Genesys Cloud
+func deleteRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	if _, err := proxy.deleteRoutingPredictor(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete routing predictor %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getRoutingPredictor(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted routing predictor %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting routing predictor %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Routing predictor %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,159 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingPredictorProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllRoutingPredictorsFunc func(ctx context.Context, p *routingPredictorProxy) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type createRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, body *platformclientv2.Createpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type getRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type updateRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, id string, body *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type deleteRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.APIResponse, error)
+
+// routingPredictorProxy contains all of the methods that call genesys cloud APIs.
+type routingPredictorProxy struct {
+	clientConfig                *platformclientv2.Configuration
+	routingApi                  *platformclientv2.RoutingApi
+	getAllRoutingPredictorsAttr getAllRoutingPredictorsFunc
+	createRoutingPredictorAttr  createRoutingPredictorFunc
+	getRoutingPredictorAttr     getRoutingPredictorFunc
+	updateRoutingPredictorAttr  updateRoutingPredictorFunc
+	deleteRoutingPredictorAttr  deleteRoutingPredictorFunc
+}
+
+// newRoutingPredictorProxy initializes the routing predictor proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingPredictorProxy(clientConfig *platformclientv2.Configuration) *routingPredictorProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	return &routingPredictorProxy{
+		clientConfig:                clientConfig,
+		routingApi:                  api,
+		getAllRoutingPredictorsAttr: getAllRoutingPredictorsFn,
+		createRoutingPredictorAttr:  createRoutingPredictorFn,
+		getRoutingPredictorAttr:     getRoutingPredictorFn,
+		updateRoutingPredictorAttr:  updateRoutingPredictorFn,
+		deleteRoutingPredictorAttr:  deleteRoutingPredictorFn,
+	}
+}
+
+// getRoutingPredictorProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingPredictorProxy(clientConfig *platformclientv2.Configuration) *routingPredictorProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingPredictorProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllRoutingPredictors retrieves all Genesys Cloud routing predictors
+func (p *routingPredictorProxy) getAllRoutingPredictors(ctx context.Context) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingPredictorsAttr(ctx, p)
+}
+
+// createRoutingPredictor creates a Genesys Cloud routing predictor
+func (p *routingPredictorProxy) createRoutingPredictor(ctx context.Context, body *platformclientv2.Createpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.createRoutingPredictorAttr(ctx, p, body)
+}
+
+// getRoutingPredictor retrieves a Genesys Cloud routing predictor by id
+func (p *routingPredictorProxy) getRoutingPredictor(ctx context.Context, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.getRoutingPredictorAttr(ctx, p, id)
+}
+
+// updateRoutingPredictor updates a Genesys Cloud routing predictor
+func (p *routingPredictorProxy) updateRoutingPredictor(ctx context.Context, id string, body *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.updateRoutingPredictorAttr(ctx, p, id, body)
+}
+
+// deleteRoutingPredictor deletes a Genesys Cloud routing predictor by id
+func (p *routingPredictorProxy) deleteRoutingPredictor(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteRoutingPredictorAttr(ctx, p, id)
+}
+
+// getAllRoutingPredictorsFn is the implementation for retrieving all routing predictors in Genesys Cloud
+func getAllRoutingPredictorsFn(ctx context.Context, p *routingPredictorProxy) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	var (
+		after         string
+		allPredictors []platformclientv2.Predictor
+		resp          *platformclientv2.APIResponse
+	)
+
+	const pageSize = 100
+	for {
+		predictors, apiResp, err := p.routingApi.GetRoutingPredictors("", after, "", strconv.Itoa(pageSize), nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get routing predictors: %s", err)
+		}
+
+		if predictors.Entities == nil || len(*predictors.Entities) == 0 {
+			break
+		}
+		allPredictors = append(allPredictors, *predictors.Entities...)
+
+		if predictors.NextUri == nil || *predictors.NextUri == "" {
+			break
+		}
+
+		u, err := url.Parse(*predictors.NextUri)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get routing predictors: %s", err)
+		}
+		after = u.Query().Get("after")
+		if after == "" {
+			break
+		}
+	}
+
+	return &allPredictors, resp, nil
+}
+
+// createRoutingPredictorFn is the implementation for creating a routing predictor in Genesys Cloud
+func createRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, body *platformclientv2.Createpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	predictor, resp, err := p.routingApi.PostRoutingPredictors(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create routing predictor: %s", err)
+	}
+	return predictor, resp, nil
+}
+
+// getRoutingPredictorFn is the implementation for retrieving a routing predictor in Genesys Cloud
+func getRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	predictor, resp, err := p.routingApi.GetRoutingPredictor(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve routing predictor by id %s: %s", id, err)
+	}
+	return predictor, resp, nil
+}
+
+// updateRoutingPredictorFn is the implementation for updating a routing predictor in Genesys Cloud
+func updateRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, id string, body *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	predictor, resp, err := p.routingApi.PatchRoutingPredictor(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update routing predictor %s: %s", id, err)
+	}
+	return predictor, resp, nil
+}
+
+// deleteRoutingPredictorFn is the implementation for deleting a routing predictor in Genesys Cloud
+func deleteRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.DeleteRoutingPredictor(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete routing predictor %s: %s", id, err)
+	}
+	return resp, nil
+}
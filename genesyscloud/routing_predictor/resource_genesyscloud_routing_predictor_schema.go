@@ -0,0 +1,137 @@
+package routing_predictor
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_routing_predictor_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the routing_predictor resource.
+3.  The resource exporter configuration for the routing_predictor exporter.
+*/
+const resourceName = "genesyscloud_routing_predictor"
+
+var (
+	predictorScheduleResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"schedule_type": {
+				Description: "The predictor schedule type.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"date_started": {
+				Description: "Date time indicating when the predictor schedule was started. Date time is represented as an ISO-8601 string. For example: yyyy-MM-ddTHH:mm:ss.SSSSSS",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	predictorWorkloadBalancingResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Flag to activate and deactivate workload balancing.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"minimum_occupancy": {
+				Description:  "Desired minimum occupancy threshold of agents. Must be between 0 and 100.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"maximum_occupancy": {
+				Description:  "Desired maximum occupancy threshold of agents. Must be between 0 and 100.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceRoutingPredictor())
+	regInstance.RegisterExporter(resourceName, RoutingPredictorExporter())
+}
+
+// ResourceRoutingPredictor registers the genesyscloud_routing_predictor resource with Terraform
+func ResourceRoutingPredictor() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Predictor. A predictor enables predictive routing on its queues, ranking agents by how likely they are to improve the selected KPI.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingPredictor),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingPredictor),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingPredictor),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingPredictor),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_ids": {
+				Description: "The queue IDs associated with the predictor. Changing the queues creates a new predictor.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"kpi": {
+				Description: "The KPI that the predictor attempts to maximize/minimize. The available KPIs are listed by GET /api/v2/routing/predictors/keyperformanceindicators. Changing the KPI creates a new predictor.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"routing_timeout_seconds": {
+				Description:  "Number of seconds allocated to predictive routing before attempting a different routing method. This is a value between 12 and 900 seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(12, 900),
+			},
+			"schedule": {
+				Description: "The predictor schedule that determines when the predictor is used for routing interactions.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        predictorScheduleResource,
+			},
+			"workload_balancing_config": {
+				Description: "The predictor balancing configuration to enable workload balancing.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        predictorWorkloadBalancingResource,
+			},
+			"state": {
+				Description: "The predictor state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// RoutingPredictorExporter returns the resourceExporter object used to hold the genesyscloud_routing_predictor exporter's config
+func RoutingPredictorExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingPredictors),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_ids": {RefType: "genesyscloud_routing_queue"},
+		},
+		ExcludedAttributes: []string{"state", "schedule.date_started"},
+	}
+}
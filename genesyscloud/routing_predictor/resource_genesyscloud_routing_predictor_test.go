@@ -0,0 +1,105 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor_test.go contains all of the test cases for running the resource
+tests for routing_predictor. The org running the test needs a predictive routing license.
+*/
+
+func TestAccResourceRoutingPredictor(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId      = "test-predictor"
+		fullName        = resourceName + "." + resourceId
+		queueResourceId = "test-queue"
+		queueName       = "Terraform Predictor Queue " + uuid.NewString()
+		queueRef        = "genesyscloud_routing_queue." + queueResourceId + ".id"
+		kpi             = "Handle Time"
+	)
+
+	queueConfig := gcloud.GenerateRoutingQueueResourceBasic(queueResourceId, queueName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: queueConfig + GenerateRoutingPredictorResource(
+					resourceId,
+					[]string{queueRef},
+					kpi,
+					"routing_timeout_seconds = 60",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "kpi", kpi),
+					resource.TestCheckResourceAttr(fullName, "queue_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "queue_ids.*", "genesyscloud_routing_queue."+queueResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "routing_timeout_seconds", "60"),
+					resource.TestCheckResourceAttrSet(fullName, "state"),
+				),
+			},
+			{
+				// Update
+				Config: queueConfig + GenerateRoutingPredictorResource(
+					resourceId,
+					[]string{queueRef},
+					kpi,
+					"routing_timeout_seconds = 120",
+					`workload_balancing_config {
+		enabled           = true
+		minimum_occupancy = 20
+		maximum_occupancy = 80
+	}`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "routing_timeout_seconds", "120"),
+					resource.TestCheckResourceAttr(fullName, "workload_balancing_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(fullName, "workload_balancing_config.0.minimum_occupancy", "20"),
+					resource.TestCheckResourceAttr(fullName, "workload_balancing_config.0.maximum_occupancy", "80"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyRoutingPredictorDestroyed,
+	})
+}
+
+func testVerifyRoutingPredictorDestroyed(state *terraform.State) error {
+	proxy := newRoutingPredictorProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		predictor, resp, err := proxy.getRoutingPredictor(context.Background(), rs.Primary.ID)
+		if predictor != nil {
+			return fmt.Errorf("Routing predictor (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Predictor not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All predictors destroyed
+	return nil
+}
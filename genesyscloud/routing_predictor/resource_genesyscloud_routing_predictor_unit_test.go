@@ -0,0 +1,80 @@
+package routing_predictor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceRoutingPredictorCreate(t *testing.T) {
+	predictorId := uuid.NewString()
+	queueId := uuid.NewString()
+	kpi := "Handle Time"
+	state := "Active"
+	var created *platformclientv2.Createpredictorrequest
+
+	proxy := &routingPredictorProxy{}
+	proxy.createRoutingPredictorAttr = func(ctx context.Context, p *routingPredictorProxy, body *platformclientv2.Createpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		created = body
+		return &platformclientv2.Predictor{Id: &predictorId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getRoutingPredictorAttr = func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, predictorId, id)
+		return &platformclientv2.Predictor{
+			Id:                      &predictorId,
+			Queues:                  &[]platformclientv2.Addressableentityref{{Id: &queueId}},
+			Kpi:                     created.Kpi,
+			RoutingTimeoutSeconds:   created.RoutingTimeoutSeconds,
+			WorkloadBalancingConfig: created.WorkloadBalancingConfig,
+			State:                   &state,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingPredictor().Schema, map[string]interface{}{
+		"queue_ids":               []interface{}{queueId},
+		"kpi":                     kpi,
+		"routing_timeout_seconds": 60,
+		"workload_balancing_config": []interface{}{
+			map[string]interface{}{"enabled": true, "minimum_occupancy": 20, "maximum_occupancy": 80},
+		},
+	})
+
+	diagErr := createRoutingPredictor(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, []string{queueId}, *created.QueueIds)
+	assert.Equal(t, kpi, *created.Kpi)
+	assert.Equal(t, 60, *created.RoutingTimeoutSeconds)
+	assert.Nil(t, created.Schedule)
+	assert.Equal(t, 80, *created.WorkloadBalancingConfig.MaximumOccupancy)
+
+	assert.Equal(t, predictorId, d.Id())
+	assert.Equal(t, state, d.Get("state").(string))
+	assert.Equal(t, []interface{}{queueId}, d.Get("queue_ids").(*schema.Set).List())
+	assert.Equal(t, 20, d.Get("workload_balancing_config.0.minimum_occupancy").(int))
+}
+
+func TestUnitBuildPredictorWorkloadBalancing(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRoutingPredictor().Schema, map[string]interface{}{
+		"queue_ids": []interface{}{uuid.NewString()},
+		"kpi":       "Handle Time",
+		"workload_balancing_config": []interface{}{
+			map[string]interface{}{"enabled": true, "minimum_occupancy": 0},
+		},
+	})
+
+	// A configured 0 is sent, while the threshold that is not configured is left out
+	balancing := buildPredictorWorkloadBalancing(d)
+	assert.True(t, *balancing.Enabled)
+	assert.Equal(t, 0, *balancing.MinimumOccupancy)
+	assert.Nil(t, balancing.MaximumOccupancy)
+}
@@ -0,0 +1,98 @@
+package routing_predictor
+
+import (
+	"fmt"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/leekchan/timeutil"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// buildPredictorSchedule maps the schedule block into a Genesys Cloud *platformclientv2.Predictorschedule
+func buildPredictorSchedule(d *schema.ResourceData) *platformclientv2.Predictorschedule {
+	scheduleList := d.Get("schedule").([]interface{})
+	if len(scheduleList) == 0 || scheduleList[0] == nil {
+		return nil
+	}
+	scheduleMap := scheduleList[0].(map[string]interface{})
+	scheduleType := scheduleMap["schedule_type"].(string)
+	return &platformclientv2.Predictorschedule{ScheduleType: &scheduleType}
+}
+
+// buildPredictorWorkloadBalancing maps the workload_balancing_config block into a Genesys Cloud *platformclientv2.Predictorworkloadbalancing
+func buildPredictorWorkloadBalancing(d *schema.ResourceData) *platformclientv2.Predictorworkloadbalancing {
+	balancingList := d.Get("workload_balancing_config").([]interface{})
+	if len(balancingList) == 0 || balancingList[0] == nil {
+		return nil
+	}
+	balancingMap := balancingList[0].(map[string]interface{})
+	enabled := balancingMap["enabled"].(bool)
+	balancing := &platformclientv2.Predictorworkloadbalancing{Enabled: &enabled}
+
+	// Thresholds that are not configured are left out rather than sent as 0. GetOkExists is used because 0 is a valid
+	// threshold, see resourcedata.GetNillableBool.
+	if minimumOccupancy, ok := d.GetOkExists("workload_balancing_config.0.minimum_occupancy"); ok {
+		balancing.MinimumOccupancy = platformclientv2.Int(minimumOccupancy.(int))
+	}
+	if maximumOccupancy, ok := d.GetOkExists("workload_balancing_config.0.maximum_occupancy"); ok {
+		balancing.MaximumOccupancy = platformclientv2.Int(maximumOccupancy.(int))
+	}
+	return balancing
+}
+
+// flattenPredictorQueueIds maps the queues of a predictor to a set of queue ids
+func flattenPredictorQueueIds(queues *[]platformclientv2.Addressableentityref) *schema.Set {
+	queueIds := make([]string, 0)
+	for _, queue := range *queues {
+		if queue.Id != nil {
+			queueIds = append(queueIds, *queue.Id)
+		}
+	}
+	return lists.StringListToSet(queueIds)
+}
+
+// flattenPredictorSchedule maps a Genesys Cloud *platformclientv2.Predictorschedule into a []interface{}
+func flattenPredictorSchedule(schedule *platformclientv2.Predictorschedule) []interface{} {
+	scheduleMap := make(map[string]interface{})
+	if schedule.ScheduleType != nil {
+		scheduleMap["schedule_type"] = *schedule.ScheduleType
+	}
+	if schedule.DateStarted != nil {
+		scheduleMap["date_started"] = timeutil.Strftime(schedule.DateStarted, resourcedata.TimeWriteFormat)
+	}
+	return []interface{}{scheduleMap}
+}
+
+// flattenPredictorWorkloadBalancing maps a Genesys Cloud *platformclientv2.Predictorworkloadbalancing into a []interface{}
+func flattenPredictorWorkloadBalancing(balancing *platformclientv2.Predictorworkloadbalancing) []interface{} {
+	balancingMap := make(map[string]interface{})
+	if balancing.Enabled != nil {
+		balancingMap["enabled"] = *balancing.Enabled
+	}
+	if balancing.MinimumOccupancy != nil {
+		balancingMap["minimum_occupancy"] = *balancing.MinimumOccupancy
+	}
+	if balancing.MaximumOccupancy != nil {
+		balancingMap["maximum_occupancy"] = *balancing.MaximumOccupancy
+	}
+	return []interface{}{balancingMap}
+}
+
+// GenerateRoutingPredictorResource generates a terraform string for a routing predictor resource
+func GenerateRoutingPredictorResource(resourceId string, queueIds []string, kpi string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_predictor" "%s" {
+	queue_ids = [%s]
+	kpi       = "%s"
+	%s
+}
+`, resourceId, strings.Join(queueIds, ", "), kpi, strings.Join(extraAttrs, "\n"))
+}
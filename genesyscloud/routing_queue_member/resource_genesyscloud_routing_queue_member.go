@@ -0,0 +1,152 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_member.go contains all of the methods that perform the core logic for a resource.
+*/
+
+func createRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+
+	log.Printf("Adding members to queue %s", queueId)
+	if diagErr := applyRoutingQueueMembers(ctx, d, meta, nil); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(uuid.NewString())
+	log.Printf("Added members to queue %s", queueId)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func readRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+
+	log.Printf("Reading members of queue %s", queueId)
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := proxy.getRoutingQueue(ctx, queueId)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read queue %s: %s", queueId, getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", queueId, getErr))
+		}
+
+		members, _, getErr := proxy.getRoutingQueueDirectMembers(ctx, queueId)
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read members of queue %s: %s", queueId, getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueueMember())
+
+		managed := buildMemberRingNums(d.Get("members").(*schema.Set))
+		_ = d.Set("members", flattenManagedMembers(managed, queueMemberRingNums(*members)))
+
+		log.Printf("Read members of queue %s", queueId)
+		return cc.CheckState()
+	})
+}
+
+func updateRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+
+	oldMembers, _ := d.GetChange("members")
+	previous := buildMemberRingNums(oldMembers.(*schema.Set))
+
+	log.Printf("Updating members of queue %s", queueId)
+	if diagErr := applyRoutingQueueMembers(ctx, d, meta, previous); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated members of queue %s", queueId)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func deleteRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+
+	_, resp, err := proxy.getRoutingQueue(ctx, queueId)
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			// The queue was probably deleted which removed its members
+			log.Printf("Queue %s already deleted", queueId)
+			return nil
+		}
+		return diag.Errorf("Failed to read queue %s: %s", queueId, err)
+	}
+
+	var userIds []string
+	for userId := range buildMemberRingNums(d.Get("members").(*schema.Set)) {
+		userIds = append(userIds, userId)
+	}
+
+	log.Printf("Removing members from queue %s", queueId)
+	if diagErr := updateMembersInChunks(ctx, proxy, queueId, userIds, true); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed members from queue %s", queueId)
+	return nil
+}
+
+// applyRoutingQueueMembers adds, removes and updates the ring numbers of the members managed by this resource.
+// previous holds the members managed before this apply so that users dropped from the configuration are removed.
+func applyRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, previous map[string]int) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+
+	currentMembers, _, err := proxy.getRoutingQueueDirectMembers(ctx, queueId)
+	if err != nil {
+		return diag.Errorf("Failed to read members of queue %s: %s", queueId, err)
+	}
+
+	desired := buildMemberRingNums(d.Get("members").(*schema.Set))
+	adds, removes, ringNumUpdates := diffMembers(desired, previous, queueMemberRingNums(*currentMembers))
+
+	if diagErr := updateMembersInChunks(ctx, proxy, queueId, removes, true); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := updateMembersInChunks(ctx, proxy, queueId, adds, false); diagErr != nil {
+		return diagErr
+	}
+	for _, userId := range ringNumUpdates {
+		if _, err := proxy.updateRoutingQueueMemberRingNum(ctx, queueId, userId, desired[userId]); err != nil {
+			return diag.Errorf("%s", err)
+		}
+	}
+	return nil
+}
+
+func updateMembersInChunks(ctx context.Context, proxy *routingQueueMemberProxy, queueId string, userIds []string, remove bool) diag.Diagnostics {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	chunks := chunksProcess.ChunkItems(userIds, platformWritableEntityFunc, maxMembersPerRequest)
+	chunkProcessor := func(chunk []platformclientv2.Writableentity) diag.Diagnostics {
+		if _, err := proxy.updateRoutingQueueMembers(ctx, queueId, chunk, remove); err != nil {
+			return diag.Errorf("Failed to update members in queue %s: %s", queueId, err)
+		}
+		return nil
+	}
+	return chunksProcess.ProcessChunks(chunks, chunkProcessor)
+}
@@ -0,0 +1,132 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_member_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingQueueMemberProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getRoutingQueueFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueDirectMembersFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error)
+type updateRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error)
+type updateRoutingQueueMemberRingNumFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string, userId string, ringNum int) (*platformclientv2.APIResponse, error)
+
+// routingQueueMemberProxy contains all of the methods that call genesys cloud APIs.
+type routingQueueMemberProxy struct {
+	clientConfig                        *platformclientv2.Configuration
+	routingApi                          *platformclientv2.RoutingApi
+	getRoutingQueueAttr                 getRoutingQueueFunc
+	getRoutingQueueDirectMembersAttr    getRoutingQueueDirectMembersFunc
+	updateRoutingQueueMembersAttr       updateRoutingQueueMembersFunc
+	updateRoutingQueueMemberRingNumAttr updateRoutingQueueMemberRingNumFunc
+}
+
+// newRoutingQueueMemberProxy initializes the routing queue member proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingQueueMemberProxy(clientConfig *platformclientv2.Configuration) *routingQueueMemberProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	return &routingQueueMemberProxy{
+		clientConfig:                        clientConfig,
+		routingApi:                          api,
+		getRoutingQueueAttr:                 getRoutingQueueFn,
+		getRoutingQueueDirectMembersAttr:    getRoutingQueueDirectMembersFn,
+		updateRoutingQueueMembersAttr:       updateRoutingQueueMembersFn,
+		updateRoutingQueueMemberRingNumAttr: updateRoutingQueueMemberRingNumFn,
+	}
+}
+
+// getRoutingQueueMemberProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingQueueMemberProxy(clientConfig *platformclientv2.Configuration) *routingQueueMemberProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingQueueMemberProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getRoutingQueue retrieves a routing queue by id
+func (p *routingQueueMemberProxy) getRoutingQueue(ctx context.Context, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueAttr(ctx, p, queueId)
+}
+
+// getRoutingQueueDirectMembers retrieves the users added directly to a queue, leaving out members from groups, teams and skill groups
+func (p *routingQueueMemberProxy) getRoutingQueueDirectMembers(ctx context.Context, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueDirectMembersAttr(ctx, p, queueId)
+}
+
+// updateRoutingQueueMembers adds or removes members of a queue
+func (p *routingQueueMemberProxy) updateRoutingQueueMembers(ctx context.Context, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueMembersAttr(ctx, p, queueId, members, remove)
+}
+
+// updateRoutingQueueMemberRingNum sets the ring number of a member of a queue
+func (p *routingQueueMemberProxy) updateRoutingQueueMemberRingNum(ctx context.Context, queueId string, userId string, ringNum int) (*platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueMemberRingNumAttr(ctx, p, queueId, userId, ringNum)
+}
+
+// getRoutingQueueFn is the implementation for retrieving a routing queue in Genesys Cloud
+func getRoutingQueueFn(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.routingApi.GetRoutingQueue(queueId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get queue %s: %s", queueId, err)
+	}
+	return queue, resp, nil
+}
+
+// getRoutingQueueDirectMembersFn is the implementation for retrieving the direct members of a queue. The API always
+// filters members on their joined status, so joined and unjoined members are read separately.
+func getRoutingQueueDirectMembersFn(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	var members []platformclientv2.Queuemember
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for _, joined := range []bool{true, false} {
+		for pageNum := 1; ; pageNum++ {
+			memberListing, membersResp, err := p.routingApi.GetRoutingQueueMembers(queueId, pageNum, pageSize, "", nil, "", nil, nil, nil, nil, nil, "user", joined)
+			resp = membersResp
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get members of queue %s: %s", queueId, err)
+			}
+			if memberListing.Entities == nil || len(*memberListing.Entities) == 0 {
+				break
+			}
+			members = append(members, *memberListing.Entities...)
+			// The listing has no page count, so a short page is the last one
+			if len(*memberListing.Entities) < pageSize {
+				break
+			}
+		}
+	}
+	return &members, resp, nil
+}
+
+// updateRoutingQueueMembersFn is the implementation for adding or removing members of a queue
+func updateRoutingQueueMembersFn(ctx context.Context, p *routingQueueMemberProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.PostRoutingQueueMembers(queueId, members, remove)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update members of queue %s: %s", queueId, err)
+	}
+	return resp, nil
+}
+
+// updateRoutingQueueMemberRingNumFn is the implementation for setting the ring number of a member of a queue
+func updateRoutingQueueMemberRingNumFn(ctx context.Context, p *routingQueueMemberProxy, queueId string, userId string, ringNum int) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.PatchRoutingQueueMember(queueId, userId, platformclientv2.Queuemember{
+		Id:         &userId,
+		RingNumber: &ringNum,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to update ring number of user %s in queue %s: %s", userId, queueId, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package routing_queue_member
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+Defines the resource schema for the routing_queue_member package. Several of these resources can manage members of the
+same queue, each one only changing the users it lists.
+*/
+const resourceName = "genesyscloud_routing_queue_member"
+
+var memberResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"user_id": {
+			Description: "User ID",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"ring_num": {
+			Description:  "Ring number between 1 and 6 for this user in the queue.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 6),
+		},
+	},
+}
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceRoutingQueueMember())
+	//No Datasource defined
+	//No Exporter defined
+}
+
+// ResourceRoutingQueueMember returns the resource schema definition
+func ResourceRoutingQueueMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Member. Adds users directly to a queue and manages their ring numbers.
+Only the listed users are changed; other direct members and members that come from groups, teams and skill groups are left alone, so several of these resources can share a queue. Set ignore_members on the genesyscloud_routing_queue resource so that it does not manage the members as well.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingQueueMember),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingQueueMember),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingQueueMember),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingQueueMember),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"members": {
+				Description: "Users added to the queue by this resource.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        memberResource,
+			},
+		},
+	}
+}
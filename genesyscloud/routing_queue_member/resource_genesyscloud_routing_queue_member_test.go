@@ -0,0 +1,107 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_member_test.go contains all of the test cases for running the resource
+tests for routing_queue_member.
+*/
+
+func TestAccResourceRoutingQueueMember(t *testing.T) {
+	t.Parallel()
+	var (
+		queueResourceId = "test-queue"
+		queueName       = "Terraform Test Queue " + uuid.NewString()
+		queueRef        = "genesyscloud_routing_queue." + queueResourceId + ".id"
+
+		user1ResourceId = "test-user-1"
+		user1Email      = "terraform-" + uuid.NewString() + "@example.com"
+		user2ResourceId = "test-user-2"
+		user2Email      = "terraform-" + uuid.NewString() + "@example.com"
+
+		teamAResourceId = "team-a"
+		teamAFullName   = "genesyscloud_routing_queue_member." + teamAResourceId
+		teamBResourceId = "team-b"
+		teamBFullName   = "genesyscloud_routing_queue_member." + teamBResourceId
+	)
+
+	baseConfig := gcloud.GenerateRoutingQueueResourceBasic(queueResourceId, queueName, "ignore_members = true") +
+		gcloud.GenerateBasicUserResource(user1ResourceId, user1Email, "Terraform Queue Member 1") +
+		gcloud.GenerateBasicUserResource(user2ResourceId, user2Email, "Terraform Queue Member 2")
+
+	user1Ref := "genesyscloud_user." + user1ResourceId + ".id"
+	user2Ref := "genesyscloud_user." + user2ResourceId + ".id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Two resources each manage one member of the same queue
+				Config: baseConfig +
+					GenerateRoutingQueueMemberResource(teamAResourceId, queueRef, GenerateMemberBlock(user1Ref, "1")) +
+					GenerateRoutingQueueMemberResource(teamBResourceId, queueRef, GenerateMemberBlock(user2Ref, "3")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(teamAFullName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(teamAFullName, "members.0.user_id", "genesyscloud_user."+user1ResourceId, "id"),
+					resource.TestCheckResourceAttr(teamAFullName, "members.0.ring_num", "1"),
+					resource.TestCheckResourceAttr(teamBFullName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(teamBFullName, "members.0.user_id", "genesyscloud_user."+user2ResourceId, "id"),
+					resource.TestCheckResourceAttr(teamBFullName, "members.0.ring_num", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResourceId, "members.#", "0"),
+				),
+			},
+			{
+				// Changing the ring number of one resource leaves the other member alone
+				Config: baseConfig +
+					GenerateRoutingQueueMemberResource(teamAResourceId, queueRef, GenerateMemberBlock(user1Ref, "2")) +
+					GenerateRoutingQueueMemberResource(teamBResourceId, queueRef, GenerateMemberBlock(user2Ref, "3")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(teamAFullName, "members.0.ring_num", "2"),
+					resource.TestCheckResourceAttr(teamBFullName, "members.0.ring_num", "3"),
+				),
+			},
+			{
+				// Removing one resource only removes its member
+				Config: baseConfig +
+					GenerateRoutingQueueMemberResource(teamBResourceId, queueRef, GenerateMemberBlock(user2Ref, "3")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(teamBFullName, "members.#", "1"),
+					testVerifyQueueMemberCount(teamBFullName, 1),
+				),
+			},
+		},
+	})
+}
+
+// testVerifyQueueMemberCount checks the number of direct members of the queue of a routing queue member resource
+func testVerifyQueueMemberCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find %s in state", resourceName)
+		}
+		queueId := resourceState.Primary.Attributes["queue_id"]
+
+		proxy := newRoutingQueueMemberProxy(platformclientv2.GetDefaultConfiguration())
+		members, _, err := proxy.getRoutingQueueDirectMembers(context.Background(), queueId)
+		if err != nil {
+			return err
+		}
+		if len(*members) != expected {
+			return fmt.Errorf("Expected %d members in queue %s, found %d", expected, queueId, len(*members))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package routing_queue_member
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDiffRoutingQueueMembers(t *testing.T) {
+	desired := map[string]int{"user-1": 1, "user-2": 3, "user-3": 2}
+	previous := map[string]int{"user-1": 1, "user-2": 1, "user-4": 1}
+	// user-5 and user-6 are members managed elsewhere
+	current := map[string]int{"user-1": 1, "user-2": 1, "user-4": 1, "user-5": 1, "user-6": 4}
+
+	adds, removes, ringNumUpdates := diffMembers(desired, previous, current)
+	assert.Equal(t, []string{"user-3"}, adds)
+	assert.Equal(t, []string{"user-4"}, removes)
+	assert.Equal(t, []string{"user-2", "user-3"}, ringNumUpdates)
+
+	managed := flattenManagedMembers(desired, current)
+	var userIds []string
+	for _, member := range managed.List() {
+		userIds = append(userIds, member.(map[string]interface{})["user_id"].(string))
+	}
+	sort.Strings(userIds)
+	// user-3 is not a member yet and is left out so that the next apply adds it
+	assert.Equal(t, []string{"user-1", "user-2"}, userIds)
+}
+
+func TestUnitApplyRoutingQueueMembers(t *testing.T) {
+	queueId := "queue-1"
+	updates := make(map[bool][]string)
+	ringNums := make(map[string]int)
+
+	proxy := &routingQueueMemberProxy{}
+	proxy.getRoutingQueueDirectMembersAttr = func(ctx context.Context, p *routingQueueMemberProxy, id string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		return &[]platformclientv2.Queuemember{
+			{Id: platformclientv2.String("user-1"), RingNumber: platformclientv2.Int(1)},
+			{Id: platformclientv2.String("user-2"), RingNumber: platformclientv2.Int(1)},
+			{Id: platformclientv2.String("other-team-user"), RingNumber: platformclientv2.Int(2)},
+		}, nil, nil
+	}
+	proxy.updateRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueMemberProxy, id string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+		for _, member := range members {
+			updates[remove] = append(updates[remove], *member.Id)
+		}
+		return nil, nil
+	}
+	proxy.updateRoutingQueueMemberRingNumAttr = func(ctx context.Context, p *routingQueueMemberProxy, id string, userId string, ringNum int) (*platformclientv2.APIResponse, error) {
+		ringNums[userId] = ringNum
+		return nil, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueMember().Schema, map[string]interface{}{
+		"queue_id": queueId,
+		"members": []interface{}{
+			map[string]interface{}{"user_id": "user-1", "ring_num": 4},
+			map[string]interface{}{"user_id": "user-3", "ring_num": 1},
+		},
+	})
+
+	diagErr := applyRoutingQueueMembers(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}, map[string]int{"user-1": 1, "user-2": 1})
+	assert.Nil(t, diagErr)
+
+	// The member managed by another resource is never removed
+	assert.Equal(t, map[bool][]string{true: {"user-2"}, false: {"user-3"}}, updates)
+	assert.Equal(t, map[string]int{"user-1": 4}, ringNums)
+}
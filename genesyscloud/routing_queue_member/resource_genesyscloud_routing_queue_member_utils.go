@@ -0,0 +1,107 @@
+package routing_queue_member
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// The API restricts member adds and removes to 100 users per call
+const maxMembersPerRequest = 100
+
+// buildMemberRingNums returns the ring number of each user of a members set
+func buildMemberRingNums(members *schema.Set) map[string]int {
+	ringNums := make(map[string]int)
+	if members == nil {
+		return ringNums
+	}
+	for _, member := range members.List() {
+		memberMap := member.(map[string]interface{})
+		ringNums[memberMap["user_id"].(string)] = memberMap["ring_num"].(int)
+	}
+	return ringNums
+}
+
+// flattenManagedMembers returns the members of the queue that are managed by this resource, with their current ring
+// numbers. Managed users that are no longer direct members are left out so that the next apply adds them again.
+func flattenManagedMembers(managed map[string]int, current map[string]int) *schema.Set {
+	memberSet := schema.NewSet(schema.HashResource(memberResource), []interface{}{})
+	for userId := range managed {
+		if ringNum, isMember := current[userId]; isMember {
+			memberSet.Add(map[string]interface{}{
+				"user_id":  userId,
+				"ring_num": ringNum,
+			})
+		}
+	}
+	return memberSet
+}
+
+// diffMembers compares the desired members with the current direct members of the queue. Only users in desired or
+// previous are considered, so members managed elsewhere are never changed.
+func diffMembers(desired map[string]int, previous map[string]int, current map[string]int) (adds []string, removes []string, ringNumUpdates []string) {
+	for userId, ringNum := range desired {
+		currentRingNum, isMember := current[userId]
+		if !isMember {
+			adds = append(adds, userId)
+			if ringNum != 1 {
+				ringNumUpdates = append(ringNumUpdates, userId)
+			}
+		} else if currentRingNum != ringNum {
+			ringNumUpdates = append(ringNumUpdates, userId)
+		}
+	}
+	for userId := range previous {
+		if _, ok := desired[userId]; ok {
+			continue
+		}
+		if _, isMember := current[userId]; isMember {
+			removes = append(removes, userId)
+		}
+	}
+	sort.Strings(adds)
+	sort.Strings(removes)
+	sort.Strings(ringNumUpdates)
+	return adds, removes, ringNumUpdates
+}
+
+// queueMemberRingNums returns the ring number of each direct member of a queue
+func queueMemberRingNums(members []platformclientv2.Queuemember) map[string]int {
+	ringNums := make(map[string]int)
+	for _, member := range members {
+		if member.Id == nil {
+			continue
+		}
+		ringNum := 1
+		if member.RingNumber != nil {
+			ringNum = *member.RingNumber
+		}
+		ringNums[*member.Id] = ringNum
+	}
+	return ringNums
+}
+
+func platformWritableEntityFunc(userId string) platformclientv2.Writableentity {
+	return platformclientv2.Writableentity{Id: &userId}
+}
+
+// GenerateRoutingQueueMemberResource generates a terraform string for a routing queue member resource
+func GenerateRoutingQueueMemberResource(resourceId string, queueId string, memberBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_member" "%s" {
+	queue_id = %s
+	%s
+}
+`, resourceId, queueId, strings.Join(memberBlocks, "\n"))
+}
+
+// GenerateMemberBlock generates a terraform string for a member block
+func GenerateMemberBlock(userId string, ringNum string) string {
+	return fmt.Sprintf(`members {
+		user_id  = %s
+		ring_num = %s
+	}
+	`, userId, ringNum)
+}
@@ -0,0 +1,125 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_dictionaryfeedback.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllSpeechAndTextAnalyticsDictionaryFeedbacks retrieves all of the speech and text analytics dictionary feedback terms via Terraform in the Genesys Cloud and is used for the exporter
+func getAllSpeechAndTextAnalyticsDictionaryFeedbacks(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getSpeechAndTextAnalyticsDictionaryFeedbackProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	feedbacks, _, err := proxy.getAllSpeechAndTextAnalyticsDictionaryFeedbacks(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get speech and text analytics dictionary feedback: %v", err)
+	}
+
+	for _, feedback := range *feedbacks {
+		// The same term can be defined once per dialect
+		resources[*feedback.Id] = &resourceExporter.ResourceMeta{Name: *feedback.Term + "_" + *feedback.Dialect}
+	}
+	return resources, nil
+}
+
+// createSpeechAndTextAnalyticsDictionaryFeedback is used by the speechandtextanalytics_dictionaryfeedback resource to create a Genesys Cloud speech and text analytics dictionary feedback term
+func createSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsDictionaryFeedbackProxy(sdkConfig)
+
+	feedbackRequest := getDictionaryFeedbackFromResourceData(d)
+
+	log.Printf("Creating speech and text analytics dictionary feedback %s", *feedbackRequest.Term)
+	feedback, _, err := proxy.createSpeechAndTextAnalyticsDictionaryFeedback(ctx, feedbackRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create speech and text analytics dictionary feedback %s: %s", *feedbackRequest.Term, err)
+	}
+
+	d.SetId(*feedback.Id)
+
+	log.Printf("Created speech and text analytics dictionary feedback %s %s", *feedbackRequest.Term, *feedback.Id)
+	return readSpeechAndTextAnalyticsDictionaryFeedback(ctx, d, meta)
+}
+
+// readSpeechAndTextAnalyticsDictionaryFeedback is used by the speechandtextanalytics_dictionaryfeedback resource to read a speech and text analytics dictionary feedback term from Genesys Cloud
+func readSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsDictionaryFeedbackProxy(sdkConfig)
+
+	log.Printf("Reading speech and text analytics dictionary feedback %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		feedback, resp, getErr := proxy.getSpeechAndTextAnalyticsDictionaryFeedback(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read speech and text analytics dictionary feedback %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read speech and text analytics dictionary feedback %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSpeechAndTextAnalyticsDictionaryFeedback())
+
+		resourcedata.SetNillableValue(d, "term", feedback.Term)
+		resourcedata.SetNillableValue(d, "dialect", feedback.Dialect)
+		resourcedata.SetNillableValue(d, "boost_value", flattenBoostValue(feedback.BoostValue))
+		_ = d.Set("example_phrases", flattenExamplePhrases(feedback.ExamplePhrases))
+		resourcedata.SetNillableValue(d, "sounds_like", feedback.SoundsLike)
+
+		log.Printf("Read speech and text analytics dictionary feedback %s %s", d.Id(), *feedback.Term)
+		return cc.CheckState()
+	})
+}
+
+// updateSpeechAndTextAnalyticsDictionaryFeedback is used by the speechandtextanalytics_dictionaryfeedback resource to update a speech and text analytics dictionary feedback term in Genesys Cloud
+func updateSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsDictionaryFeedbackProxy(sdkConfig)
+
+	feedbackRequest := getDictionaryFeedbackFromResourceData(d)
+
+	log.Printf("Updating speech and text analytics dictionary feedback %s", *feedbackRequest.Term)
+	if _, _, err := proxy.updateSpeechAndTextAnalyticsDictionaryFeedback(ctx, d.Id(), feedbackRequest); err != nil {
+		return diag.Errorf("Failed to update speech and text analytics dictionary feedback %s: %s", *feedbackRequest.Term, err)
+	}
+
+	log.Printf("Updated speech and text analytics dictionary feedback %s", *feedbackRequest.Term)
+	return readSpeechAndTextAnalyticsDictionaryFeedback(ctx, d, meta)
+}
+
+// deleteSpeechAndTextAnalyticsDictionaryFeedback is used by the speechandtextanalytics_dictionaryfeedback resource to delete a speech and text analytics dictionary feedback term from Genesys Cloud
+func deleteSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsDictionaryFeedbackProxy(sdkConfig)
+
+	if _, err := proxy.deleteSpeechAndTextAnalyticsDictionaryFeedback(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete speech and text analytics dictionary feedback %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getSpeechAndTextAnalyticsDictionaryFeedback(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted speech and text analytics dictionary feedback %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting speech and text analytics dictionary feedback %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Speech and text analytics dictionary feedback %s still exists", d.Id()))
+	})
+}
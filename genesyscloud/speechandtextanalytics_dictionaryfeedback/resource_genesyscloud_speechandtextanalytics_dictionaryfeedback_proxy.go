@@ -0,0 +1,158 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_dictionaryfeedback_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *speechAndTextAnalyticsDictionaryFeedbackProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllSpeechAndTextAnalyticsDictionaryFeedbacksFunc func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy) (*[]platformclientv2.Listeddictionaryfeedback, *platformclientv2.APIResponse, error)
+type createSpeechAndTextAnalyticsDictionaryFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsDictionaryFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error)
+type updateSpeechAndTextAnalyticsDictionaryFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error)
+type deleteSpeechAndTextAnalyticsDictionaryFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string) (*platformclientv2.APIResponse, error)
+
+// speechAndTextAnalyticsDictionaryFeedbackProxy contains all of the methods that call genesys cloud APIs.
+type speechAndTextAnalyticsDictionaryFeedbackProxy struct {
+	clientConfig                                        *platformclientv2.Configuration
+	speechTextAnalyticsApi                              *platformclientv2.SpeechTextAnalyticsApi
+	getAllSpeechAndTextAnalyticsDictionaryFeedbacksAttr getAllSpeechAndTextAnalyticsDictionaryFeedbacksFunc
+	createSpeechAndTextAnalyticsDictionaryFeedbackAttr  createSpeechAndTextAnalyticsDictionaryFeedbackFunc
+	getSpeechAndTextAnalyticsDictionaryFeedbackAttr     getSpeechAndTextAnalyticsDictionaryFeedbackFunc
+	updateSpeechAndTextAnalyticsDictionaryFeedbackAttr  updateSpeechAndTextAnalyticsDictionaryFeedbackFunc
+	deleteSpeechAndTextAnalyticsDictionaryFeedbackAttr  deleteSpeechAndTextAnalyticsDictionaryFeedbackFunc
+}
+
+// newSpeechAndTextAnalyticsDictionaryFeedbackProxy initializes the speech and text analytics dictionary feedback proxy with all of the data needed to communicate with Genesys Cloud
+func newSpeechAndTextAnalyticsDictionaryFeedbackProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsDictionaryFeedbackProxy {
+	api := platformclientv2.NewSpeechTextAnalyticsApiWithConfig(clientConfig)
+	return &speechAndTextAnalyticsDictionaryFeedbackProxy{
+		clientConfig:           clientConfig,
+		speechTextAnalyticsApi: api,
+		getAllSpeechAndTextAnalyticsDictionaryFeedbacksAttr: getAllSpeechAndTextAnalyticsDictionaryFeedbacksFn,
+		createSpeechAndTextAnalyticsDictionaryFeedbackAttr:  createSpeechAndTextAnalyticsDictionaryFeedbackFn,
+		getSpeechAndTextAnalyticsDictionaryFeedbackAttr:     getSpeechAndTextAnalyticsDictionaryFeedbackFn,
+		updateSpeechAndTextAnalyticsDictionaryFeedbackAttr:  updateSpeechAndTextAnalyticsDictionaryFeedbackFn,
+		deleteSpeechAndTextAnalyticsDictionaryFeedbackAttr:  deleteSpeechAndTextAnalyticsDictionaryFeedbackFn,
+	}
+}
+
+// getSpeechAndTextAnalyticsDictionaryFeedbackProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSpeechAndTextAnalyticsDictionaryFeedbackProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsDictionaryFeedbackProxy {
+	if internalProxy == nil {
+		internalProxy = newSpeechAndTextAnalyticsDictionaryFeedbackProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllSpeechAndTextAnalyticsDictionaryFeedbacks retrieves all Genesys Cloud speech and text analytics dictionary feedback terms
+func (p *speechAndTextAnalyticsDictionaryFeedbackProxy) getAllSpeechAndTextAnalyticsDictionaryFeedbacks(ctx context.Context) (*[]platformclientv2.Listeddictionaryfeedback, *platformclientv2.APIResponse, error) {
+	return p.getAllSpeechAndTextAnalyticsDictionaryFeedbacksAttr(ctx, p)
+}
+
+// createSpeechAndTextAnalyticsDictionaryFeedback creates a Genesys Cloud speech and text analytics dictionary feedback term
+func (p *speechAndTextAnalyticsDictionaryFeedbackProxy) createSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	return p.createSpeechAndTextAnalyticsDictionaryFeedbackAttr(ctx, p, body)
+}
+
+// getSpeechAndTextAnalyticsDictionaryFeedback retrieves a Genesys Cloud speech and text analytics dictionary feedback term by id
+func (p *speechAndTextAnalyticsDictionaryFeedbackProxy) getSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, id string) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsDictionaryFeedbackAttr(ctx, p, id)
+}
+
+// updateSpeechAndTextAnalyticsDictionaryFeedback updates a Genesys Cloud speech and text analytics dictionary feedback term
+func (p *speechAndTextAnalyticsDictionaryFeedbackProxy) updateSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, id string, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	return p.updateSpeechAndTextAnalyticsDictionaryFeedbackAttr(ctx, p, id, body)
+}
+
+// deleteSpeechAndTextAnalyticsDictionaryFeedback deletes a Genesys Cloud speech and text analytics dictionary feedback term by id
+func (p *speechAndTextAnalyticsDictionaryFeedbackProxy) deleteSpeechAndTextAnalyticsDictionaryFeedback(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteSpeechAndTextAnalyticsDictionaryFeedbackAttr(ctx, p, id)
+}
+
+// getAllSpeechAndTextAnalyticsDictionaryFeedbacksFn is the implementation for retrieving all speech and text analytics dictionary feedback terms in Genesys Cloud
+func getAllSpeechAndTextAnalyticsDictionaryFeedbacksFn(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy) (*[]platformclientv2.Listeddictionaryfeedback, *platformclientv2.APIResponse, error) {
+	var (
+		nextPage     string
+		allFeedbacks []platformclientv2.Listeddictionaryfeedback
+		resp         *platformclientv2.APIResponse
+	)
+
+	const pageSize = 100
+	for {
+		feedbacks, apiResp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsDictionaryfeedback("", nextPage, pageSize)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics dictionary feedback: %s", err)
+		}
+
+		if feedbacks.Entities == nil || len(*feedbacks.Entities) == 0 {
+			break
+		}
+		allFeedbacks = append(allFeedbacks, *feedbacks.Entities...)
+
+		if feedbacks.NextUri == nil || *feedbacks.NextUri == "" {
+			break
+		}
+
+		u, err := url.Parse(*feedbacks.NextUri)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics dictionary feedback: %s", err)
+		}
+		nextPage = u.Query().Get("nextPage")
+		if nextPage == "" {
+			break
+		}
+	}
+
+	return &allFeedbacks, resp, nil
+}
+
+// createSpeechAndTextAnalyticsDictionaryFeedbackFn is the implementation for creating a speech and text analytics dictionary feedback term in Genesys Cloud
+func createSpeechAndTextAnalyticsDictionaryFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	feedback, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsDictionaryfeedback(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create speech and text analytics dictionary feedback: %s", err)
+	}
+	return feedback, resp, nil
+}
+
+// getSpeechAndTextAnalyticsDictionaryFeedbackFn is the implementation for retrieving a speech and text analytics dictionary feedback term in Genesys Cloud
+func getSpeechAndTextAnalyticsDictionaryFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	feedback, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsDictionaryfeedbackDictionaryFeedbackId(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics dictionary feedback by id %s: %s", id, err)
+	}
+	return feedback, resp, nil
+}
+
+// updateSpeechAndTextAnalyticsDictionaryFeedbackFn is the implementation for updating a speech and text analytics dictionary feedback term in Genesys Cloud
+func updateSpeechAndTextAnalyticsDictionaryFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+	feedback, resp, err := p.speechTextAnalyticsApi.PutSpeechandtextanalyticsDictionaryfeedbackDictionaryFeedbackId(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update speech and text analytics dictionary feedback %s: %s", id, err)
+	}
+	return feedback, resp, nil
+}
+
+// deleteSpeechAndTextAnalyticsDictionaryFeedbackFn is the implementation for deleting a speech and text analytics dictionary feedback term in Genesys Cloud
+func deleteSpeechAndTextAnalyticsDictionaryFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.speechTextAnalyticsApi.DeleteSpeechandtextanalyticsDictionaryfeedbackDictionaryFeedbackId(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete speech and text analytics dictionary feedback %s: %s", id, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_speechandtextanalytics_dictionaryfeedback_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the speechandtextanalytics_dictionaryfeedback resource.
+3.  The resource exporter configuration for the speechandtextanalytics_dictionaryfeedback exporter.
+*/
+const resourceName = "genesyscloud_speechandtextanalytics_dictionaryfeedback"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceSpeechAndTextAnalyticsDictionaryFeedback())
+	regInstance.RegisterExporter(resourceName, SpeechAndTextAnalyticsDictionaryFeedbackExporter())
+}
+
+// ResourceSpeechAndTextAnalyticsDictionaryFeedback registers the genesyscloud_speechandtextanalytics_dictionaryfeedback resource with Terraform
+func ResourceSpeechAndTextAnalyticsDictionaryFeedback() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Speech and Text Analytics Dictionary Feedback. Dictionary feedback terms improve the transcription of words that are specific to an organization.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createSpeechAndTextAnalyticsDictionaryFeedback),
+		ReadContext:   gcloud.ReadWithPooledClient(readSpeechAndTextAnalyticsDictionaryFeedback),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateSpeechAndTextAnalyticsDictionaryFeedback),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteSpeechAndTextAnalyticsDictionaryFeedback),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"term": {
+				Description: "The dictionary term.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"dialect": {
+				Description: "The dialect of the dictionary term, e.g. en-US. Changing the dialect will cause the term to be dropped and recreated with a new ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"boost_value": {
+				Description:  "How much the term is boosted during transcription, between 1.0 and 10.0. If not set, Genesys Cloud assigns a default boost value.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(1.0, 10.0),
+			},
+			"example_phrases": {
+				Description: "Phrases in which the term is used. Between 3 and 20 phrases are required.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    3,
+				MaxItems:    20,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sounds_like": {
+				Description: "Up to 10 words that sound like the term.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    10,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// SpeechAndTextAnalyticsDictionaryFeedbackExporter returns the resourceExporter object used to hold the genesyscloud_speechandtextanalytics_dictionaryfeedback exporter's config
+func SpeechAndTextAnalyticsDictionaryFeedbackExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllSpeechAndTextAnalyticsDictionaryFeedbacks),
+	}
+}
@@ -0,0 +1,112 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_dictionaryfeedback_test.go contains all of the test cases for running the resource
+tests for speechandtextanalytics_dictionaryfeedback. The org running the test needs a speech and text analytics license.
+*/
+
+func TestAccResourceSpeechAndTextAnalyticsDictionaryFeedback(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId = "test-dictionary-feedback"
+		fullName   = resourceName + "." + resourceId
+		// Terms may only contain letters
+		term = "terraform" + strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' {
+				return r
+			}
+			return -1
+		}, uuid.NewString())
+		examplePhrases1 = []string{
+			"we deploy with " + term,
+			"the " + term + " plan failed",
+			"run " + term + " apply",
+		}
+		examplePhrases2 = append(examplePhrases1, "upgrade "+term+" today")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateSpeechAndTextAnalyticsDictionaryFeedbackResource(
+					resourceId,
+					term,
+					"en-US",
+					examplePhrases1,
+					"boost_value = 2.5",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "term", term),
+					resource.TestCheckResourceAttr(fullName, "dialect", "en-US"),
+					resource.TestCheckResourceAttr(fullName, "boost_value", "2.5"),
+					resource.TestCheckResourceAttr(fullName, "example_phrases.#", "3"),
+					resource.TestCheckTypeSetElemAttr(fullName, "example_phrases.*", examplePhrases1[0]),
+					resource.TestCheckResourceAttr(fullName, "sounds_like.#", "0"),
+				),
+			},
+			{
+				// Update
+				Config: GenerateSpeechAndTextAnalyticsDictionaryFeedbackResource(
+					resourceId,
+					term,
+					"en-US",
+					examplePhrases2,
+					"boost_value = 5",
+					`sounds_like = ["terra form"]`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "boost_value", "5"),
+					resource.TestCheckResourceAttr(fullName, "example_phrases.#", "4"),
+					resource.TestCheckResourceAttr(fullName, "sounds_like.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "sounds_like.0", "terra form"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySpeechAndTextAnalyticsDictionaryFeedbackDestroyed,
+	})
+}
+
+func testVerifySpeechAndTextAnalyticsDictionaryFeedbackDestroyed(state *terraform.State) error {
+	proxy := newSpeechAndTextAnalyticsDictionaryFeedbackProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		feedback, resp, err := proxy.getSpeechAndTextAnalyticsDictionaryFeedback(context.Background(), rs.Primary.ID)
+		if feedback != nil {
+			return fmt.Errorf("Speech and text analytics dictionary feedback (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Dictionary feedback not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All dictionary feedback terms destroyed
+	return nil
+}
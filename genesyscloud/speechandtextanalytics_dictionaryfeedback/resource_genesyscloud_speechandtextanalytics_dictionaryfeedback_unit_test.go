@@ -0,0 +1,67 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceSpeechAndTextAnalyticsDictionaryFeedbackCreate(t *testing.T) {
+	feedbackId := uuid.NewString()
+	var created *platformclientv2.Dictionaryfeedback
+
+	proxy := &speechAndTextAnalyticsDictionaryFeedbackProxy{}
+	proxy.createSpeechAndTextAnalyticsDictionaryFeedbackAttr = func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, body *platformclientv2.Dictionaryfeedback) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+		created = body
+		return &platformclientv2.Dictionaryfeedback{Id: &feedbackId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsDictionaryFeedbackAttr = func(ctx context.Context, p *speechAndTextAnalyticsDictionaryFeedbackProxy, id string) (*platformclientv2.Dictionaryfeedback, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, feedbackId, id)
+		examplePhrases := make([]platformclientv2.Dictionaryfeedbackexamplephrase, 0)
+		for _, phrase := range *created.ExamplePhrases {
+			examplePhrases = append(examplePhrases, platformclientv2.Dictionaryfeedbackexamplephrase{
+				Phrase: phrase.Phrase,
+				Source: platformclientv2.String("Manual"),
+			})
+		}
+		// The API assigns a default boost value when none is given
+		boostValue := float32(1.3)
+		return &platformclientv2.Dictionaryfeedback{
+			Id:             &feedbackId,
+			Term:           created.Term,
+			Dialect:        created.Dialect,
+			BoostValue:     &boostValue,
+			ExamplePhrases: &examplePhrases,
+			SoundsLike:     created.SoundsLike,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceSpeechAndTextAnalyticsDictionaryFeedback().Schema, map[string]interface{}{
+		"term":            "genesys",
+		"dialect":         "en-US",
+		"example_phrases": []interface{}{"call genesys", "genesys cloud", "the genesys platform"},
+		"sounds_like":     []interface{}{"genesis"},
+	})
+
+	diagErr := createSpeechAndTextAnalyticsDictionaryFeedback(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Nil(t, created.BoostValue)
+	assert.Len(t, *created.ExamplePhrases, 3)
+	assert.Equal(t, []string{"genesis"}, *created.SoundsLike)
+
+	assert.Equal(t, feedbackId, d.Id())
+	assert.Equal(t, 1.3, d.Get("boost_value").(float64))
+	assert.Equal(t, 3, d.Get("example_phrases").(*schema.Set).Len())
+	assert.True(t, d.Get("example_phrases").(*schema.Set).Contains("genesys cloud"))
+	assert.Equal(t, []interface{}{"genesis"}, d.Get("sounds_like").([]interface{}))
+}
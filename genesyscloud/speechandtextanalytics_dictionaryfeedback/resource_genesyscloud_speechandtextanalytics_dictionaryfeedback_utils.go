@@ -0,0 +1,86 @@
+package speechandtextanalytics_dictionaryfeedback
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_dictionaryfeedback_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getDictionaryFeedbackFromResourceData maps data from schema ResourceData object to a *platformclientv2.Dictionaryfeedback
+func getDictionaryFeedbackFromResourceData(d *schema.ResourceData) *platformclientv2.Dictionaryfeedback {
+	term := d.Get("term").(string)
+	dialect := d.Get("dialect").(string)
+	examplePhrases := buildExamplePhrases(d.Get("example_phrases").(*schema.Set))
+	soundsLike := lists.InterfaceListToStrings(d.Get("sounds_like").([]interface{}))
+
+	feedback := &platformclientv2.Dictionaryfeedback{
+		Term:           &term,
+		Dialect:        &dialect,
+		ExamplePhrases: &examplePhrases,
+		SoundsLike:     &soundsLike,
+	}
+	if boostValue, ok := d.GetOk("boost_value"); ok {
+		value := float32(boostValue.(float64))
+		feedback.BoostValue = &value
+	}
+	return feedback
+}
+
+// buildExamplePhrases maps the example_phrases set into a list of Genesys Cloud platformclientv2.Dictionaryfeedbackexamplephrase
+func buildExamplePhrases(phrasesSet *schema.Set) []platformclientv2.Dictionaryfeedbackexamplephrase {
+	phrases := make([]platformclientv2.Dictionaryfeedbackexamplephrase, 0)
+	for _, phrase := range phrasesSet.List() {
+		text := phrase.(string)
+		phrases = append(phrases, platformclientv2.Dictionaryfeedbackexamplephrase{Phrase: &text})
+	}
+	return phrases
+}
+
+// flattenExamplePhrases maps a list of Genesys Cloud platformclientv2.Dictionaryfeedbackexamplephrase into an example_phrases set
+func flattenExamplePhrases(phrases *[]platformclientv2.Dictionaryfeedbackexamplephrase) *schema.Set {
+	if phrases == nil {
+		return nil
+	}
+
+	phraseList := make([]string, 0)
+	for _, phrase := range *phrases {
+		if phrase.Phrase != nil {
+			phraseList = append(phraseList, *phrase.Phrase)
+		}
+	}
+	return lists.StringListToSet(phraseList)
+}
+
+// flattenBoostValue converts the float32 boost value returned by the API without picking up float64 rounding noise
+func flattenBoostValue(boostValue *float32) *float64 {
+	if boostValue == nil {
+		return nil
+	}
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(float64(*boostValue), 'f', -1, 32), 64)
+	return &value
+}
+
+// GenerateSpeechAndTextAnalyticsDictionaryFeedbackResource generates a terraform string for a speech and text analytics dictionary feedback resource
+func GenerateSpeechAndTextAnalyticsDictionaryFeedbackResource(resourceId string, term string, dialect string, examplePhrases []string, extraAttrs ...string) string {
+	quotedPhrases := make([]string, 0, len(examplePhrases))
+	for _, phrase := range examplePhrases {
+		quotedPhrases = append(quotedPhrases, strconv.Quote(phrase))
+	}
+	return fmt.Sprintf(`resource "genesyscloud_speechandtextanalytics_dictionaryfeedback" "%s" {
+	term            = "%s"
+	dialect         = "%s"
+	example_phrases = [%s]
+	%s
+}
+`, resourceId, term, dialect, strings.Join(quotedPhrases, ", "), strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,60 @@
+package speechandtextanalytics_program
+
+import (
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	speechAndTextAnalyticsTopic "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_topic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_speechandtextanalytics_program_init_test.go file is used to initialize the data sources and resources
+   used in testing the speech and text analytics program resource.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+	providerResources[resourceName] = ResourceSpeechAndTextAnalyticsProgram()
+	providerResources["genesyscloud_speechandtextanalytics_topic"] = speechAndTextAnalyticsTopic.ResourceSpeechAndTextAnalyticsTopic()
+	providerResources["genesyscloud_routing_queue"] = gcloud.ResourceRoutingQueue()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	//There are no data sources for this resource
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the speechandtextanalytics_program package
+	initTestResources()
+
+	// Run the test suite for the speechandtextanalytics_program package
+	m.Run()
+}
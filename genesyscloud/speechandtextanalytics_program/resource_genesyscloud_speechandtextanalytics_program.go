@@ -0,0 +1,160 @@
+package speechandtextanalytics_program
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_program.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllSpeechAndTextAnalyticsPrograms retrieves all of the speech and text analytics programs via Terraform in the Genesys Cloud and is used for the exporter
+func getAllSpeechAndTextAnalyticsPrograms(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getSpeechAndTextAnalyticsProgramProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	programs, _, err := proxy.getAllSpeechAndTextAnalyticsPrograms(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get speech and text analytics programs: %v", err)
+	}
+
+	for _, program := range *programs {
+		resources[*program.Id] = &resourceExporter.ResourceMeta{Name: *program.Name}
+	}
+	return resources, nil
+}
+
+// createSpeechAndTextAnalyticsProgram is used by the speechandtextanalytics_program resource to create a Genesys Cloud speech and text analytics program
+func createSpeechAndTextAnalyticsProgram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsProgramProxy(sdkConfig)
+
+	programRequest := getProgramRequestFromResourceData(d)
+
+	log.Printf("Creating speech and text analytics program %s", *programRequest.Name)
+	program, _, err := proxy.createSpeechAndTextAnalyticsProgram(ctx, programRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create speech and text analytics program %s: %s", *programRequest.Name, err)
+	}
+
+	d.SetId(*program.Id)
+
+	if d.Get("queue_ids").(*schema.Set).Len() > 0 || d.Get("flow_ids").(*schema.Set).Len() > 0 {
+		if _, _, err := proxy.updateSpeechAndTextAnalyticsProgramMappings(ctx, d.Id(), getProgramMappingsRequestFromResourceData(d)); err != nil {
+			return diag.Errorf("Failed to set mappings of speech and text analytics program %s: %s", *programRequest.Name, err)
+		}
+	}
+
+	if d.Get("published").(bool) {
+		if diagErr := publishProgram(ctx, proxy, d.Id()); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Created speech and text analytics program %s %s", *programRequest.Name, *program.Id)
+	return readSpeechAndTextAnalyticsProgram(ctx, d, meta)
+}
+
+// readSpeechAndTextAnalyticsProgram is used by the speechandtextanalytics_program resource to read a speech and text analytics program from Genesys Cloud
+func readSpeechAndTextAnalyticsProgram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsProgramProxy(sdkConfig)
+
+	log.Printf("Reading speech and text analytics program %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		program, resp, getErr := proxy.getSpeechAndTextAnalyticsProgram(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read speech and text analytics program %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read speech and text analytics program %s: %s", d.Id(), getErr))
+		}
+
+		mappings, _, getErr := proxy.getSpeechAndTextAnalyticsProgramMappings(ctx, d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read mappings of speech and text analytics program %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSpeechAndTextAnalyticsProgram())
+
+		resourcedata.SetNillableValue(d, "name", program.Name)
+		resourcedata.SetNillableValue(d, "description", program.Description)
+		resourcedata.SetNillableValue(d, "published", program.Published)
+		if program.Tags != nil {
+			_ = d.Set("tags", lists.StringListToSet(*program.Tags))
+		} else {
+			_ = d.Set("tags", nil)
+		}
+		_ = d.Set("topic_ids", flattenTopicIds(program.Topics))
+		_ = d.Set("queue_ids", flattenEntityRefIds(mappings.Queues))
+		_ = d.Set("flow_ids", flattenEntityRefIds(mappings.Flows))
+
+		log.Printf("Read speech and text analytics program %s %s", d.Id(), *program.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateSpeechAndTextAnalyticsProgram is used by the speechandtextanalytics_program resource to update a speech and text analytics program in Genesys Cloud
+func updateSpeechAndTextAnalyticsProgram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsProgramProxy(sdkConfig)
+
+	programRequest := getProgramRequestFromResourceData(d)
+
+	log.Printf("Updating speech and text analytics program %s", *programRequest.Name)
+	if _, _, err := proxy.updateSpeechAndTextAnalyticsProgram(ctx, d.Id(), programRequest); err != nil {
+		return diag.Errorf("Failed to update speech and text analytics program %s: %s", *programRequest.Name, err)
+	}
+
+	if d.HasChanges("queue_ids", "flow_ids") {
+		if _, _, err := proxy.updateSpeechAndTextAnalyticsProgramMappings(ctx, d.Id(), getProgramMappingsRequestFromResourceData(d)); err != nil {
+			return diag.Errorf("Failed to update mappings of speech and text analytics program %s: %s", *programRequest.Name, err)
+		}
+	}
+
+	if d.Get("published").(bool) {
+		if diagErr := publishProgram(ctx, proxy, d.Id()); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated speech and text analytics program %s", *programRequest.Name)
+	return readSpeechAndTextAnalyticsProgram(ctx, d, meta)
+}
+
+// deleteSpeechAndTextAnalyticsProgram is used by the speechandtextanalytics_program resource to delete a speech and text analytics program from Genesys Cloud
+func deleteSpeechAndTextAnalyticsProgram(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsProgramProxy(sdkConfig)
+
+	if _, err := proxy.deleteSpeechAndTextAnalyticsProgram(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete speech and text analytics program %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getSpeechAndTextAnalyticsProgram(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted speech and text analytics program %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting speech and text analytics program %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Speech and text analytics program %s still exists", d.Id()))
+	})
+}
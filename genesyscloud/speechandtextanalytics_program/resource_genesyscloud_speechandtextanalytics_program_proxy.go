@@ -0,0 +1,226 @@
+package speechandtextanalytics_program
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_program_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *speechAndTextAnalyticsProgramProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllSpeechAndTextAnalyticsProgramsFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy) (*[]platformclientv2.Listedprogram, *platformclientv2.APIResponse, error)
+type createSpeechAndTextAnalyticsProgramFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsProgramFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Program, *platformclientv2.APIResponse, error)
+type updateSpeechAndTextAnalyticsProgramFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error)
+type deleteSpeechAndTextAnalyticsProgramFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsProgramMappingsFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error)
+type updateSpeechAndTextAnalyticsProgramMappingsFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string, body *platformclientv2.Programmappingsrequest) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error)
+type publishSpeechAndTextAnalyticsProgramsFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, programIds []string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsProgramsPublishJobFunc func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, jobId string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error)
+
+// speechAndTextAnalyticsProgramProxy contains all of the methods that call genesys cloud APIs.
+type speechAndTextAnalyticsProgramProxy struct {
+	clientConfig                                    *platformclientv2.Configuration
+	speechTextAnalyticsApi                          *platformclientv2.SpeechTextAnalyticsApi
+	getAllSpeechAndTextAnalyticsProgramsAttr        getAllSpeechAndTextAnalyticsProgramsFunc
+	createSpeechAndTextAnalyticsProgramAttr         createSpeechAndTextAnalyticsProgramFunc
+	getSpeechAndTextAnalyticsProgramAttr            getSpeechAndTextAnalyticsProgramFunc
+	updateSpeechAndTextAnalyticsProgramAttr         updateSpeechAndTextAnalyticsProgramFunc
+	deleteSpeechAndTextAnalyticsProgramAttr         deleteSpeechAndTextAnalyticsProgramFunc
+	getSpeechAndTextAnalyticsProgramMappingsAttr    getSpeechAndTextAnalyticsProgramMappingsFunc
+	updateSpeechAndTextAnalyticsProgramMappingsAttr updateSpeechAndTextAnalyticsProgramMappingsFunc
+	publishSpeechAndTextAnalyticsProgramsAttr       publishSpeechAndTextAnalyticsProgramsFunc
+	getSpeechAndTextAnalyticsProgramsPublishJobAttr getSpeechAndTextAnalyticsProgramsPublishJobFunc
+}
+
+// newSpeechAndTextAnalyticsProgramProxy initializes the speech and text analytics program proxy with all of the data needed to communicate with Genesys Cloud
+func newSpeechAndTextAnalyticsProgramProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsProgramProxy {
+	api := platformclientv2.NewSpeechTextAnalyticsApiWithConfig(clientConfig)
+	return &speechAndTextAnalyticsProgramProxy{
+		clientConfig:                                    clientConfig,
+		speechTextAnalyticsApi:                          api,
+		getAllSpeechAndTextAnalyticsProgramsAttr:        getAllSpeechAndTextAnalyticsProgramsFn,
+		createSpeechAndTextAnalyticsProgramAttr:         createSpeechAndTextAnalyticsProgramFn,
+		getSpeechAndTextAnalyticsProgramAttr:            getSpeechAndTextAnalyticsProgramFn,
+		updateSpeechAndTextAnalyticsProgramAttr:         updateSpeechAndTextAnalyticsProgramFn,
+		deleteSpeechAndTextAnalyticsProgramAttr:         deleteSpeechAndTextAnalyticsProgramFn,
+		getSpeechAndTextAnalyticsProgramMappingsAttr:    getSpeechAndTextAnalyticsProgramMappingsFn,
+		updateSpeechAndTextAnalyticsProgramMappingsAttr: updateSpeechAndTextAnalyticsProgramMappingsFn,
+		publishSpeechAndTextAnalyticsProgramsAttr:       publishSpeechAndTextAnalyticsProgramsFn,
+		getSpeechAndTextAnalyticsProgramsPublishJobAttr: getSpeechAndTextAnalyticsProgramsPublishJobFn,
+	}
+}
+
+// getSpeechAndTextAnalyticsProgramProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSpeechAndTextAnalyticsProgramProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsProgramProxy {
+	if internalProxy == nil {
+		internalProxy = newSpeechAndTextAnalyticsProgramProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllSpeechAndTextAnalyticsPrograms retrieves all Genesys Cloud speech and text analytics programs
+func (p *speechAndTextAnalyticsProgramProxy) getAllSpeechAndTextAnalyticsPrograms(ctx context.Context) (*[]platformclientv2.Listedprogram, *platformclientv2.APIResponse, error) {
+	return p.getAllSpeechAndTextAnalyticsProgramsAttr(ctx, p)
+}
+
+// createSpeechAndTextAnalyticsProgram creates a Genesys Cloud speech and text analytics program
+func (p *speechAndTextAnalyticsProgramProxy) createSpeechAndTextAnalyticsProgram(ctx context.Context, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	return p.createSpeechAndTextAnalyticsProgramAttr(ctx, p, body)
+}
+
+// getSpeechAndTextAnalyticsProgram retrieves a Genesys Cloud speech and text analytics program by id
+func (p *speechAndTextAnalyticsProgramProxy) getSpeechAndTextAnalyticsProgram(ctx context.Context, id string) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsProgramAttr(ctx, p, id)
+}
+
+// updateSpeechAndTextAnalyticsProgram updates a Genesys Cloud speech and text analytics program
+func (p *speechAndTextAnalyticsProgramProxy) updateSpeechAndTextAnalyticsProgram(ctx context.Context, id string, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	return p.updateSpeechAndTextAnalyticsProgramAttr(ctx, p, id, body)
+}
+
+// deleteSpeechAndTextAnalyticsProgram deletes a Genesys Cloud speech and text analytics program by id
+func (p *speechAndTextAnalyticsProgramProxy) deleteSpeechAndTextAnalyticsProgram(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteSpeechAndTextAnalyticsProgramAttr(ctx, p, id)
+}
+
+// getSpeechAndTextAnalyticsProgramMappings retrieves the queues and flows mapped to a Genesys Cloud speech and text analytics program
+func (p *speechAndTextAnalyticsProgramProxy) getSpeechAndTextAnalyticsProgramMappings(ctx context.Context, id string) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsProgramMappingsAttr(ctx, p, id)
+}
+
+// updateSpeechAndTextAnalyticsProgramMappings sets the queues and flows mapped to a Genesys Cloud speech and text analytics program
+func (p *speechAndTextAnalyticsProgramProxy) updateSpeechAndTextAnalyticsProgramMappings(ctx context.Context, id string, body *platformclientv2.Programmappingsrequest) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+	return p.updateSpeechAndTextAnalyticsProgramMappingsAttr(ctx, p, id, body)
+}
+
+// publishSpeechAndTextAnalyticsPrograms starts a job that publishes Genesys Cloud speech and text analytics programs
+func (p *speechAndTextAnalyticsProgramProxy) publishSpeechAndTextAnalyticsPrograms(ctx context.Context, programIds []string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+	return p.publishSpeechAndTextAnalyticsProgramsAttr(ctx, p, programIds)
+}
+
+// getSpeechAndTextAnalyticsProgramsPublishJob retrieves a Genesys Cloud speech and text analytics programs publish job by id
+func (p *speechAndTextAnalyticsProgramProxy) getSpeechAndTextAnalyticsProgramsPublishJob(ctx context.Context, jobId string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsProgramsPublishJobAttr(ctx, p, jobId)
+}
+
+// getAllSpeechAndTextAnalyticsProgramsFn is the implementation for retrieving all speech and text analytics programs in Genesys Cloud
+func getAllSpeechAndTextAnalyticsProgramsFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy) (*[]platformclientv2.Listedprogram, *platformclientv2.APIResponse, error) {
+	var (
+		nextPage    string
+		allPrograms []platformclientv2.Listedprogram
+		resp        *platformclientv2.APIResponse
+	)
+
+	const pageSize = 100
+	for {
+		programs, apiResp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsPrograms(nextPage, pageSize, "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics programs: %s", err)
+		}
+
+		if programs.Entities == nil || len(*programs.Entities) == 0 {
+			break
+		}
+		allPrograms = append(allPrograms, *programs.Entities...)
+
+		if programs.NextUri == nil || *programs.NextUri == "" {
+			break
+		}
+
+		u, err := url.Parse(*programs.NextUri)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics programs: %s", err)
+		}
+		nextPage = u.Query().Get("nextPage")
+		if nextPage == "" {
+			break
+		}
+	}
+
+	return &allPrograms, resp, nil
+}
+
+// createSpeechAndTextAnalyticsProgramFn is the implementation for creating a speech and text analytics program in Genesys Cloud
+func createSpeechAndTextAnalyticsProgramFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	program, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsPrograms(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create speech and text analytics program: %s", err)
+	}
+	return program, resp, nil
+}
+
+// getSpeechAndTextAnalyticsProgramFn is the implementation for retrieving a speech and text analytics program in Genesys Cloud
+func getSpeechAndTextAnalyticsProgramFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	program, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsProgram(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics program by id %s: %s", id, err)
+	}
+	return program, resp, nil
+}
+
+// updateSpeechAndTextAnalyticsProgramFn is the implementation for updating a speech and text analytics program in Genesys Cloud
+func updateSpeechAndTextAnalyticsProgramFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+	program, resp, err := p.speechTextAnalyticsApi.PutSpeechandtextanalyticsProgram(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update speech and text analytics program %s: %s", id, err)
+	}
+	return program, resp, nil
+}
+
+// deleteSpeechAndTextAnalyticsProgramFn is the implementation for deleting a speech and text analytics program in Genesys Cloud
+func deleteSpeechAndTextAnalyticsProgramFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.speechTextAnalyticsApi.DeleteSpeechandtextanalyticsProgram(id, false)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete speech and text analytics program %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getSpeechAndTextAnalyticsProgramMappingsFn is the implementation for retrieving the mappings of a speech and text analytics program in Genesys Cloud
+func getSpeechAndTextAnalyticsProgramMappingsFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+	mappings, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsProgramMappings(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve mappings of speech and text analytics program %s: %s", id, err)
+	}
+	return mappings, resp, nil
+}
+
+// updateSpeechAndTextAnalyticsProgramMappingsFn is the implementation for setting the mappings of a speech and text analytics program in Genesys Cloud
+func updateSpeechAndTextAnalyticsProgramMappingsFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string, body *platformclientv2.Programmappingsrequest) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+	mappings, resp, err := p.speechTextAnalyticsApi.PutSpeechandtextanalyticsProgramMappings(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update mappings of speech and text analytics program %s: %s", id, err)
+	}
+	return mappings, resp, nil
+}
+
+// publishSpeechAndTextAnalyticsProgramsFn is the implementation for starting a speech and text analytics programs publish job in Genesys Cloud
+func publishSpeechAndTextAnalyticsProgramsFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, programIds []string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+	job, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsProgramsPublishjobs(platformclientv2.Programjobrequest{ProgramIds: &programIds})
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to publish speech and text analytics programs %v: %s", programIds, err)
+	}
+	return job, resp, nil
+}
+
+// getSpeechAndTextAnalyticsProgramsPublishJobFn is the implementation for retrieving a speech and text analytics programs publish job in Genesys Cloud
+func getSpeechAndTextAnalyticsProgramsPublishJobFn(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, jobId string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+	job, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsProgramsPublishjob(jobId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics programs publish job %s: %s", jobId, err)
+	}
+	return job, resp, nil
+}
@@ -0,0 +1,94 @@
+package speechandtextanalytics_program
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesycloud_speechandtextanalytics_program_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the speechandtextanalytics_program resource.
+3.  The resource exporter configuration for the speechandtextanalytics_program exporter.
+*/
+const resourceName = "genesyscloud_speechandtextanalytics_program"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceSpeechAndTextAnalyticsProgram())
+	regInstance.RegisterExporter(resourceName, SpeechAndTextAnalyticsProgramExporter())
+}
+
+// ResourceSpeechAndTextAnalyticsProgram registers the genesyscloud_speechandtextanalytics_program resource with Terraform
+func ResourceSpeechAndTextAnalyticsProgram() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Speech and Text Analytics Program. Programs hold the set of topics that are detected in the interactions of the queues and flows mapped to them.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createSpeechAndTextAnalyticsProgram),
+		ReadContext:   gcloud.ReadWithPooledClient(readSpeechAndTextAnalyticsProgram),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateSpeechAndTextAnalyticsProgram),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteSpeechAndTextAnalyticsProgram),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The program name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The program description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": {
+				Description: "The program tags.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"topic_ids": {
+				Description: "The IDs of the topics of the program.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"queue_ids": {
+				Description: "The IDs of the queues mapped to the program.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"flow_ids": {
+				Description: "The IDs of the flows mapped to the program.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"published": {
+				Description: "Whether the program is published. Changes to a program are only applied to interactions once the program is published. Publishing starts a publish job which is waited on until it completes.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// SpeechAndTextAnalyticsProgramExporter returns the resourceExporter object used to hold the genesyscloud_speechandtextanalytics_program exporter's config
+func SpeechAndTextAnalyticsProgramExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllSpeechAndTextAnalyticsPrograms),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"topic_ids": {RefType: "genesyscloud_speechandtextanalytics_topic"},
+			"queue_ids": {RefType: "genesyscloud_routing_queue"},
+			"flow_ids":  {RefType: "genesyscloud_flow"},
+		},
+	}
+}
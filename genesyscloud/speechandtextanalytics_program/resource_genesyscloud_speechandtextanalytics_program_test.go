@@ -0,0 +1,112 @@
+package speechandtextanalytics_program
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	speechAndTextAnalyticsTopic "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_topic"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_program_test.go contains all of the test cases for running the resource
+tests for speechandtextanalytics_program. The org running the test needs a speech and text analytics license.
+*/
+
+func TestAccResourceSpeechAndTextAnalyticsProgram(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId      = "test-program"
+		fullName        = resourceName + "." + resourceId
+		name1           = "Terraform Program " + uuid.NewString()
+		name2           = "Terraform Program " + uuid.NewString()
+		topicResourceId = "test-topic"
+		topicRef        = "genesyscloud_speechandtextanalytics_topic." + topicResourceId + ".id"
+		queueResourceId = "test-queue"
+		queueRef        = "genesyscloud_routing_queue." + queueResourceId + ".id"
+	)
+
+	baseConfig := speechAndTextAnalyticsTopic.GenerateSpeechAndTextAnalyticsTopicResource(
+		topicResourceId,
+		"Terraform Topic "+uuid.NewString(),
+		"en-US",
+		speechAndTextAnalyticsTopic.GeneratePhrase("cancel my subscription"),
+	) + gcloud.GenerateRoutingQueueResourceBasic(queueResourceId, "Terraform STA Queue "+uuid.NewString())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: baseConfig + GenerateSpeechAndTextAnalyticsProgramResource(
+					resourceId,
+					name1,
+					`description = "Terraform program"`,
+					"topic_ids = ["+topicRef+"]",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "description", "Terraform program"),
+					resource.TestCheckResourceAttr(fullName, "topic_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "topic_ids.*", "genesyscloud_speechandtextanalytics_topic."+topicResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "queue_ids.#", "0"),
+					resource.TestCheckResourceAttr(fullName, "published", "false"),
+				),
+			},
+			{
+				// Update with a queue mapping and publish
+				Config: baseConfig + GenerateSpeechAndTextAnalyticsProgramResource(
+					resourceId,
+					name2,
+					`tags = ["terraform"]`,
+					"topic_ids = ["+topicRef+"]",
+					"queue_ids = ["+queueRef+"]",
+					"published = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "queue_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "queue_ids.*", "genesyscloud_routing_queue."+queueResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "published", "true"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySpeechAndTextAnalyticsProgramDestroyed,
+	})
+}
+
+func testVerifySpeechAndTextAnalyticsProgramDestroyed(state *terraform.State) error {
+	proxy := newSpeechAndTextAnalyticsProgramProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		program, resp, err := proxy.getSpeechAndTextAnalyticsProgram(context.Background(), rs.Primary.ID)
+		if program != nil {
+			return fmt.Errorf("Speech and text analytics program (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Program not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All programs destroyed
+	return nil
+}
@@ -0,0 +1,90 @@
+package speechandtextanalytics_program
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceSpeechAndTextAnalyticsProgramCreate(t *testing.T) {
+	programId := uuid.NewString()
+	topicId := uuid.NewString()
+	queueId := uuid.NewString()
+	flowId := uuid.NewString()
+	jobId := uuid.NewString()
+	var (
+		created   *platformclientv2.Programrequest
+		mappings  *platformclientv2.Programmappingsrequest
+		published bool
+	)
+
+	proxy := &speechAndTextAnalyticsProgramProxy{}
+	proxy.createSpeechAndTextAnalyticsProgramAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, body *platformclientv2.Programrequest) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+		created = body
+		return &platformclientv2.Program{Id: &programId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateSpeechAndTextAnalyticsProgramMappingsAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string, body *platformclientv2.Programmappingsrequest) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, programId, id)
+		mappings = body
+		return &platformclientv2.Programmappings{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.publishSpeechAndTextAnalyticsProgramsAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, programIds []string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, []string{programId}, programIds)
+		return &platformclientv2.Programjob{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsProgramsPublishJobAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Programjob, *platformclientv2.APIResponse, error) {
+		published = true
+		return &platformclientv2.Programjob{Id: &jobId, State: platformclientv2.String("Completed")}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsProgramAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Program, *platformclientv2.APIResponse, error) {
+		topics := make([]platformclientv2.Basetopicentitiy, 0)
+		for _, id := range *created.TopicIds {
+			topicId := id
+			topics = append(topics, platformclientv2.Basetopicentitiy{Id: &topicId})
+		}
+		return &platformclientv2.Program{
+			Id:          &programId,
+			Name:        created.Name,
+			Description: created.Description,
+			Tags:        created.Tags,
+			Topics:      &topics,
+			Published:   &published,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsProgramMappingsAttr = func(ctx context.Context, p *speechAndTextAnalyticsProgramProxy, id string) (*platformclientv2.Programmappings, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Programmappings{
+			Queues: &[]platformclientv2.Addressableentityref{{Id: &(*mappings.QueueIds)[0]}},
+			Flows:  &[]platformclientv2.Addressableentityref{{Id: &(*mappings.FlowIds)[0]}},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceSpeechAndTextAnalyticsProgram().Schema, map[string]interface{}{
+		"name":      "Program",
+		"topic_ids": []interface{}{topicId},
+		"queue_ids": []interface{}{queueId},
+		"flow_ids":  []interface{}{flowId},
+		"published": true,
+	})
+
+	diagErr := createSpeechAndTextAnalyticsProgram(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, []string{topicId}, *created.TopicIds)
+	assert.Equal(t, []string{queueId}, *mappings.QueueIds)
+	assert.Equal(t, []string{flowId}, *mappings.FlowIds)
+
+	assert.Equal(t, programId, d.Id())
+	assert.True(t, d.Get("published").(bool))
+	assert.True(t, d.Get("topic_ids").(*schema.Set).Contains(topicId))
+	assert.True(t, d.Get("queue_ids").(*schema.Set).Contains(queueId))
+	assert.True(t, d.Get("flow_ids").(*schema.Set).Contains(flowId))
+}
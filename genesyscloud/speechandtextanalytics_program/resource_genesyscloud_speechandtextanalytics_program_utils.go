@@ -0,0 +1,112 @@
+package speechandtextanalytics_program
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_program_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getProgramRequestFromResourceData maps data from schema ResourceData object to a *platformclientv2.Programrequest
+func getProgramRequestFromResourceData(d *schema.ResourceData) *platformclientv2.Programrequest {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+
+	return &platformclientv2.Programrequest{
+		Name:        &name,
+		Description: &description,
+		Tags:        lists.SetToStringList(d.Get("tags").(*schema.Set)),
+		TopicIds:    lists.SetToStringList(d.Get("topic_ids").(*schema.Set)),
+	}
+}
+
+// getProgramMappingsRequestFromResourceData maps queue_ids and flow_ids to a *platformclientv2.Programmappingsrequest
+func getProgramMappingsRequestFromResourceData(d *schema.ResourceData) *platformclientv2.Programmappingsrequest {
+	return &platformclientv2.Programmappingsrequest{
+		QueueIds: lists.SetToStringList(d.Get("queue_ids").(*schema.Set)),
+		FlowIds:  lists.SetToStringList(d.Get("flow_ids").(*schema.Set)),
+	}
+}
+
+// flattenTopicIds maps a list of Genesys Cloud platformclientv2.Basetopicentitiy into a set of ids
+func flattenTopicIds(topics *[]platformclientv2.Basetopicentitiy) *schema.Set {
+	topicIds := make([]string, 0)
+	if topics != nil {
+		for _, topic := range *topics {
+			if topic.Id != nil {
+				topicIds = append(topicIds, *topic.Id)
+			}
+		}
+	}
+	return lists.StringListToSet(topicIds)
+}
+
+// flattenEntityRefIds maps a list of Genesys Cloud platformclientv2.Addressableentityref into a set of ids
+func flattenEntityRefIds(refs *[]platformclientv2.Addressableentityref) *schema.Set {
+	ids := make([]string, 0)
+	if refs != nil {
+		for _, ref := range *refs {
+			if ref.Id != nil {
+				ids = append(ids, *ref.Id)
+			}
+		}
+	}
+	return lists.StringListToSet(ids)
+}
+
+// publishProgram starts a publish job for a program and waits until the job completes
+func publishProgram(ctx context.Context, proxy *speechAndTextAnalyticsProgramProxy, programId string) diag.Diagnostics {
+	log.Printf("Publishing speech and text analytics program %s", programId)
+	job, _, err := proxy.publishSpeechAndTextAnalyticsPrograms(ctx, []string{programId})
+	if err != nil {
+		return diag.Errorf("Failed to publish speech and text analytics program %s: %s", programId, err)
+	}
+
+	diagErr := gcloud.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		job, _, err := proxy.getSpeechAndTextAnalyticsProgramsPublishJob(ctx, *job.Id)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read publish job of speech and text analytics program %s: %s", programId, err))
+		}
+
+		state := ""
+		if job.State != nil {
+			state = *job.State
+		}
+		switch state {
+		case "Completed":
+			return nil
+		case "Failed":
+			return retry.NonRetryableError(fmt.Errorf("publish job %s of speech and text analytics program %s failed", *job.Id, programId))
+		}
+		return retry.RetryableError(fmt.Errorf("publish job %s of speech and text analytics program %s is %s", *job.Id, programId, strings.ToLower(state)))
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Published speech and text analytics program %s", programId)
+	return nil
+}
+
+// GenerateSpeechAndTextAnalyticsProgramResource generates a terraform string for a speech and text analytics program resource
+func GenerateSpeechAndTextAnalyticsProgramResource(resourceId string, name string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_speechandtextanalytics_program" "%s" {
+	name = "%s"
+	%s
+}
+`, resourceId, name, strings.Join(extraAttrs, "\n"))
+}
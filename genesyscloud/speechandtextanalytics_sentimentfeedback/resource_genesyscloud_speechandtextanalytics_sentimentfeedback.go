@@ -0,0 +1,113 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_sentimentfeedback.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllSpeechAndTextAnalyticsSentimentFeedbacks retrieves all of the speech and text analytics sentiment feedback via Terraform in the Genesys Cloud and is used for the exporter
+func getAllSpeechAndTextAnalyticsSentimentFeedbacks(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getSpeechAndTextAnalyticsSentimentFeedbackProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	feedbacks, _, err := proxy.getAllSpeechAndTextAnalyticsSentimentFeedbacks(ctx, "")
+	if err != nil {
+		return nil, diag.Errorf("Failed to get speech and text analytics sentiment feedback: %v", err)
+	}
+
+	for _, feedback := range *feedbacks {
+		// The same phrase can be given feedback once per dialect
+		resources[*feedback.Id] = &resourceExporter.ResourceMeta{Name: *feedback.Phrase + "_" + *feedback.Dialect}
+	}
+	return resources, nil
+}
+
+// createSpeechAndTextAnalyticsSentimentFeedback is used by the speechandtextanalytics_sentimentfeedback resource to create a Genesys Cloud speech and text analytics sentiment feedback
+func createSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsSentimentFeedbackProxy(sdkConfig)
+
+	phrase := d.Get("phrase").(string)
+	dialect := d.Get("dialect").(string)
+	feedbackValue := d.Get("feedback_value").(string)
+
+	log.Printf("Creating speech and text analytics sentiment feedback %s", phrase)
+	feedback, _, err := proxy.createSpeechAndTextAnalyticsSentimentFeedback(ctx, &platformclientv2.Sentimentfeedback{
+		Phrase:        &phrase,
+		Dialect:       &dialect,
+		FeedbackValue: &feedbackValue,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to create speech and text analytics sentiment feedback %s: %s", phrase, err)
+	}
+
+	d.SetId(*feedback.Id)
+
+	log.Printf("Created speech and text analytics sentiment feedback %s %s", phrase, *feedback.Id)
+	return readSpeechAndTextAnalyticsSentimentFeedback(ctx, d, meta)
+}
+
+// readSpeechAndTextAnalyticsSentimentFeedback is used by the speechandtextanalytics_sentimentfeedback resource to read a speech and text analytics sentiment feedback from Genesys Cloud
+func readSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsSentimentFeedbackProxy(sdkConfig)
+
+	log.Printf("Reading speech and text analytics sentiment feedback %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		feedback, resp, getErr := proxy.getSpeechAndTextAnalyticsSentimentFeedback(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read speech and text analytics sentiment feedback %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read speech and text analytics sentiment feedback %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSpeechAndTextAnalyticsSentimentFeedback())
+
+		resourcedata.SetNillableValue(d, "phrase", feedback.Phrase)
+		resourcedata.SetNillableValue(d, "dialect", feedback.Dialect)
+		resourcedata.SetNillableValue(d, "feedback_value", feedback.FeedbackValue)
+
+		log.Printf("Read speech and text analytics sentiment feedback %s %s", d.Id(), *feedback.Phrase)
+		return cc.CheckState()
+	})
+}
+
+// deleteSpeechAndTextAnalyticsSentimentFeedback is used by the speechandtextanalytics_sentimentfeedback resource to delete a speech and text analytics sentiment feedback from Genesys Cloud
+func deleteSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsSentimentFeedbackProxy(sdkConfig)
+
+	if _, err := proxy.deleteSpeechAndTextAnalyticsSentimentFeedback(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete speech and text analytics sentiment feedback %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getSpeechAndTextAnalyticsSentimentFeedback(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted speech and text analytics sentiment feedback %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting speech and text analytics sentiment feedback %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Speech and text analytics sentiment feedback %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,125 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_sentimentfeedback_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *speechAndTextAnalyticsSentimentFeedbackProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllSpeechAndTextAnalyticsSentimentFeedbacksFunc func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, dialect string) (*[]platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error)
+type createSpeechAndTextAnalyticsSentimentFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, body *platformclientv2.Sentimentfeedback) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsSentimentFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, id string) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error)
+type deleteSpeechAndTextAnalyticsSentimentFeedbackFunc func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, id string) (*platformclientv2.APIResponse, error)
+
+// speechAndTextAnalyticsSentimentFeedbackProxy contains all of the methods that call genesys cloud APIs.
+type speechAndTextAnalyticsSentimentFeedbackProxy struct {
+	clientConfig                                       *platformclientv2.Configuration
+	speechTextAnalyticsApi                             *platformclientv2.SpeechTextAnalyticsApi
+	getAllSpeechAndTextAnalyticsSentimentFeedbacksAttr getAllSpeechAndTextAnalyticsSentimentFeedbacksFunc
+	createSpeechAndTextAnalyticsSentimentFeedbackAttr  createSpeechAndTextAnalyticsSentimentFeedbackFunc
+	getSpeechAndTextAnalyticsSentimentFeedbackAttr     getSpeechAndTextAnalyticsSentimentFeedbackFunc
+	deleteSpeechAndTextAnalyticsSentimentFeedbackAttr  deleteSpeechAndTextAnalyticsSentimentFeedbackFunc
+}
+
+// newSpeechAndTextAnalyticsSentimentFeedbackProxy initializes the speech and text analytics sentiment feedback proxy with all of the data needed to communicate with Genesys Cloud
+func newSpeechAndTextAnalyticsSentimentFeedbackProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsSentimentFeedbackProxy {
+	api := platformclientv2.NewSpeechTextAnalyticsApiWithConfig(clientConfig)
+	return &speechAndTextAnalyticsSentimentFeedbackProxy{
+		clientConfig:           clientConfig,
+		speechTextAnalyticsApi: api,
+		getAllSpeechAndTextAnalyticsSentimentFeedbacksAttr: getAllSpeechAndTextAnalyticsSentimentFeedbacksFn,
+		createSpeechAndTextAnalyticsSentimentFeedbackAttr:  createSpeechAndTextAnalyticsSentimentFeedbackFn,
+		getSpeechAndTextAnalyticsSentimentFeedbackAttr:     getSpeechAndTextAnalyticsSentimentFeedbackFn,
+		deleteSpeechAndTextAnalyticsSentimentFeedbackAttr:  deleteSpeechAndTextAnalyticsSentimentFeedbackFn,
+	}
+}
+
+// getSpeechAndTextAnalyticsSentimentFeedbackProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSpeechAndTextAnalyticsSentimentFeedbackProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsSentimentFeedbackProxy {
+	if internalProxy == nil {
+		internalProxy = newSpeechAndTextAnalyticsSentimentFeedbackProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllSpeechAndTextAnalyticsSentimentFeedbacks retrieves all Genesys Cloud speech and text analytics sentiment feedback of a dialect, or of every dialect if none is given
+func (p *speechAndTextAnalyticsSentimentFeedbackProxy) getAllSpeechAndTextAnalyticsSentimentFeedbacks(ctx context.Context, dialect string) (*[]platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	return p.getAllSpeechAndTextAnalyticsSentimentFeedbacksAttr(ctx, p, dialect)
+}
+
+// createSpeechAndTextAnalyticsSentimentFeedback creates a Genesys Cloud speech and text analytics sentiment feedback
+func (p *speechAndTextAnalyticsSentimentFeedbackProxy) createSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, body *platformclientv2.Sentimentfeedback) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	return p.createSpeechAndTextAnalyticsSentimentFeedbackAttr(ctx, p, body)
+}
+
+// getSpeechAndTextAnalyticsSentimentFeedback retrieves a Genesys Cloud speech and text analytics sentiment feedback by id
+func (p *speechAndTextAnalyticsSentimentFeedbackProxy) getSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, id string) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsSentimentFeedbackAttr(ctx, p, id)
+}
+
+// deleteSpeechAndTextAnalyticsSentimentFeedback deletes a Genesys Cloud speech and text analytics sentiment feedback by id
+func (p *speechAndTextAnalyticsSentimentFeedbackProxy) deleteSpeechAndTextAnalyticsSentimentFeedback(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteSpeechAndTextAnalyticsSentimentFeedbackAttr(ctx, p, id)
+}
+
+// getAllSpeechAndTextAnalyticsSentimentFeedbacksFn is the implementation for retrieving all speech and text analytics sentiment feedback in Genesys Cloud
+func getAllSpeechAndTextAnalyticsSentimentFeedbacksFn(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, dialect string) (*[]platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	// The sentiment feedback listing is not paged
+	feedbacks, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsSentimentfeedback(dialect)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get speech and text analytics sentiment feedback: %s", err)
+	}
+
+	allFeedbacks := make([]platformclientv2.Sentimentfeedback, 0)
+	if feedbacks.Entities != nil {
+		allFeedbacks = append(allFeedbacks, *feedbacks.Entities...)
+	}
+	return &allFeedbacks, resp, nil
+}
+
+// createSpeechAndTextAnalyticsSentimentFeedbackFn is the implementation for creating a speech and text analytics sentiment feedback in Genesys Cloud
+func createSpeechAndTextAnalyticsSentimentFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, body *platformclientv2.Sentimentfeedback) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	feedback, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsSentimentfeedback(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create speech and text analytics sentiment feedback: %s", err)
+	}
+	return feedback, resp, nil
+}
+
+// getSpeechAndTextAnalyticsSentimentFeedbackFn is the implementation for retrieving a speech and text analytics sentiment feedback in Genesys Cloud.
+// There is no API to get a single sentiment feedback, so it is looked up in the list of all sentiment feedback.
+func getSpeechAndTextAnalyticsSentimentFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, id string) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+	feedbacks, resp, err := getAllSpeechAndTextAnalyticsSentimentFeedbacksFn(ctx, p, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics sentiment feedback by id %s: %s", id, err)
+	}
+
+	for _, feedback := range *feedbacks {
+		if feedback.Id != nil && *feedback.Id == id {
+			return &feedback, resp, nil
+		}
+	}
+	return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("Unable to find speech and text analytics sentiment feedback with id %s", id)
+}
+
+// deleteSpeechAndTextAnalyticsSentimentFeedbackFn is the implementation for deleting a speech and text analytics sentiment feedback in Genesys Cloud
+func deleteSpeechAndTextAnalyticsSentimentFeedbackFn(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.speechTextAnalyticsApi.DeleteSpeechandtextanalyticsSentimentfeedbackSentimentFeedbackId(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete speech and text analytics sentiment feedback %s: %s", id, err)
+	}
+	return resp, nil
+}
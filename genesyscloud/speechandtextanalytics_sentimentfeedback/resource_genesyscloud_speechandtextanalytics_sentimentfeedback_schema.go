@@ -0,0 +1,69 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_speechandtextanalytics_sentimentfeedback_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the speechandtextanalytics_sentimentfeedback resource.
+3.  The resource exporter configuration for the speechandtextanalytics_sentimentfeedback exporter.
+*/
+const resourceName = "genesyscloud_speechandtextanalytics_sentimentfeedback"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceSpeechAndTextAnalyticsSentimentFeedback())
+	regInstance.RegisterExporter(resourceName, SpeechAndTextAnalyticsSentimentFeedbackExporter())
+}
+
+// ResourceSpeechAndTextAnalyticsSentimentFeedback registers the genesyscloud_speechandtextanalytics_sentimentfeedback resource with Terraform
+func ResourceSpeechAndTextAnalyticsSentimentFeedback() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Speech and Text Analytics Sentiment Feedback. Sentiment feedback overrides the sentiment that is detected for a phrase.
+Sentiment feedback cannot be updated, so changing any attribute will cause the feedback to be dropped and recreated with a new ID.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createSpeechAndTextAnalyticsSentimentFeedback),
+		ReadContext:   gcloud.ReadWithPooledClient(readSpeechAndTextAnalyticsSentimentFeedback),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteSpeechAndTextAnalyticsSentimentFeedback),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"phrase": {
+				Description: "The phrase the sentiment feedback is given for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dialect": {
+				Description: "The dialect of the phrase, e.g. en-US.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"feedback_value": {
+				Description:  "The sentiment of the phrase.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Positive", "Neutral", "Negative"}, false),
+			},
+		},
+	}
+}
+
+// SpeechAndTextAnalyticsSentimentFeedbackExporter returns the resourceExporter object used to hold the genesyscloud_speechandtextanalytics_sentimentfeedback exporter's config
+func SpeechAndTextAnalyticsSentimentFeedbackExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllSpeechAndTextAnalyticsSentimentFeedbacks),
+	}
+}
@@ -0,0 +1,81 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_sentimentfeedback_test.go contains all of the test cases for running the resource
+tests for speechandtextanalytics_sentimentfeedback. The org running the test needs a speech and text analytics license.
+*/
+
+func TestAccResourceSpeechAndTextAnalyticsSentimentFeedback(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId = "test-sentiment-feedback"
+		fullName   = resourceName + "." + resourceId
+		phrase     = "terraform works " + uuid.NewString()[:8]
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateSpeechAndTextAnalyticsSentimentFeedbackResource(resourceId, phrase, "en-US", "Positive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "phrase", phrase),
+					resource.TestCheckResourceAttr(fullName, "dialect", "en-US"),
+					resource.TestCheckResourceAttr(fullName, "feedback_value", "Positive"),
+				),
+			},
+			{
+				// Replace
+				Config: GenerateSpeechAndTextAnalyticsSentimentFeedbackResource(resourceId, phrase, "en-US", "Negative"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "phrase", phrase),
+					resource.TestCheckResourceAttr(fullName, "feedback_value", "Negative"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySpeechAndTextAnalyticsSentimentFeedbackDestroyed,
+	})
+}
+
+func testVerifySpeechAndTextAnalyticsSentimentFeedbackDestroyed(state *terraform.State) error {
+	proxy := newSpeechAndTextAnalyticsSentimentFeedbackProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		feedback, resp, err := proxy.getSpeechAndTextAnalyticsSentimentFeedback(context.Background(), rs.Primary.ID)
+		if feedback != nil {
+			return fmt.Errorf("Speech and text analytics sentiment feedback (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Sentiment feedback not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All sentiment feedback destroyed
+	return nil
+}
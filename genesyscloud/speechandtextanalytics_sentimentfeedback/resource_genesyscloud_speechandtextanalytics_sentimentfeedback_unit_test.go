@@ -0,0 +1,52 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceSpeechAndTextAnalyticsSentimentFeedbackCreate(t *testing.T) {
+	feedbackId := uuid.NewString()
+	var created *platformclientv2.Sentimentfeedback
+
+	proxy := &speechAndTextAnalyticsSentimentFeedbackProxy{}
+	proxy.createSpeechAndTextAnalyticsSentimentFeedbackAttr = func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, body *platformclientv2.Sentimentfeedback) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+		created = body
+		return &platformclientv2.Sentimentfeedback{Id: &feedbackId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsSentimentFeedbackAttr = func(ctx context.Context, p *speechAndTextAnalyticsSentimentFeedbackProxy, id string) (*platformclientv2.Sentimentfeedback, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, feedbackId, id)
+		return &platformclientv2.Sentimentfeedback{
+			Id:            &feedbackId,
+			Phrase:        created.Phrase,
+			Dialect:       created.Dialect,
+			FeedbackValue: created.FeedbackValue,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceSpeechAndTextAnalyticsSentimentFeedback().Schema, map[string]interface{}{
+		"phrase":         "that was a close call",
+		"dialect":        "en-US",
+		"feedback_value": "Neutral",
+	})
+
+	diagErr := createSpeechAndTextAnalyticsSentimentFeedback(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, "that was a close call", *created.Phrase)
+	assert.Equal(t, "en-US", *created.Dialect)
+	assert.Equal(t, "Neutral", *created.FeedbackValue)
+
+	assert.Equal(t, feedbackId, d.Id())
+	assert.Equal(t, "Neutral", d.Get("feedback_value"))
+}
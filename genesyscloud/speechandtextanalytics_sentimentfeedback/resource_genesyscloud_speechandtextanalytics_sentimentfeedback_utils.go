@@ -0,0 +1,20 @@
+package speechandtextanalytics_sentimentfeedback
+
+import (
+	"fmt"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_sentimentfeedback_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// GenerateSpeechAndTextAnalyticsSentimentFeedbackResource generates a terraform string for a speech and text analytics sentiment feedback resource
+func GenerateSpeechAndTextAnalyticsSentimentFeedbackResource(resourceId string, phrase string, dialect string, feedbackValue string) string {
+	return fmt.Sprintf(`resource "genesyscloud_speechandtextanalytics_sentimentfeedback" "%s" {
+	phrase         = "%s"
+	dialect        = "%s"
+	feedback_value = "%s"
+}
+`, resourceId, phrase, dialect, feedbackValue)
+}
@@ -0,0 +1,58 @@
+package speechandtextanalytics_settings
+
+import (
+	"sync"
+	speechAndTextAnalyticsProgram "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_program"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_speechandtextanalytics_settings_init_test.go file is used to initialize the data sources and resources
+   used in testing the speech and text analytics settings resource.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+	providerResources[resourceName] = ResourceSpeechAndTextAnalyticsSettings()
+	providerResources["genesyscloud_speechandtextanalytics_program"] = speechAndTextAnalyticsProgram.ResourceSpeechAndTextAnalyticsProgram()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	//There are no data sources for this resource
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the speechandtextanalytics_settings package
+	initTestResources()
+
+	// Run the test suite for the speechandtextanalytics_settings package
+	m.Run()
+}
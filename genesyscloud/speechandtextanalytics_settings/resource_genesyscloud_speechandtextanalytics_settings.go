@@ -0,0 +1,84 @@
+package speechandtextanalytics_settings
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_settings.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllSpeechAndTextAnalyticsSettings returns the single settings object of the organization and is used for the exporter
+func getAllSpeechAndTextAnalyticsSettings(_ context.Context, _ *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	resources["0"] = &resourceExporter.ResourceMeta{Name: "speechandtextanalytics_settings"}
+	return resources, nil
+}
+
+func createSpeechAndTextAnalyticsSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Creating speech and text analytics settings")
+	d.SetId("settings")
+	return updateSpeechAndTextAnalyticsSettings(ctx, d, meta)
+}
+
+func readSpeechAndTextAnalyticsSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsSettingsProxy(sdkConfig)
+
+	log.Printf("Reading speech and text analytics settings")
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		settings, resp, getErr := proxy.getSpeechAndTextAnalyticsSettings(ctx)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read speech and text analytics settings: %s", getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read speech and text analytics settings: %s", getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSpeechAndTextAnalyticsSettings())
+
+		if settings.DefaultProgram != nil && settings.DefaultProgram.Id != nil {
+			_ = d.Set("default_program_id", *settings.DefaultProgram.Id)
+		} else {
+			_ = d.Set("default_program_id", nil)
+		}
+		resourcedata.SetNillableValue(d, "expected_dialects", settings.ExpectedDialects)
+		resourcedata.SetNillableValue(d, "text_analytics_enabled", settings.TextAnalyticsEnabled)
+		resourcedata.SetNillableValue(d, "agent_empathy_enabled", settings.AgentEmpathyEnabled)
+
+		log.Printf("Read speech and text analytics settings")
+		return cc.CheckState()
+	})
+}
+
+func updateSpeechAndTextAnalyticsSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsSettingsProxy(sdkConfig)
+
+	log.Printf("Updating speech and text analytics settings")
+	if _, _, err := proxy.updateSpeechAndTextAnalyticsSettings(ctx, getSettingsRequestFromResourceData(d)); err != nil {
+		return diag.Errorf("Failed to update speech and text analytics settings: %s", err)
+	}
+
+	log.Printf("Updated speech and text analytics settings")
+	return readSpeechAndTextAnalyticsSettings(ctx, d, meta)
+}
+
+func deleteSpeechAndTextAnalyticsSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The settings of an organization cannot be deleted or reset, so they are only removed from the state
+	log.Printf("Removing speech and text analytics settings from the state")
+	return nil
+}
@@ -0,0 +1,77 @@
+package speechandtextanalytics_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_settings_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *speechAndTextAnalyticsSettingsProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getSpeechAndTextAnalyticsSettingsFunc func(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error)
+type updateSpeechAndTextAnalyticsSettingsFunc func(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy, body *platformclientv2.Speechtextanalyticssettingsrequest) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error)
+
+// speechAndTextAnalyticsSettingsProxy contains all of the methods that call genesys cloud APIs.
+type speechAndTextAnalyticsSettingsProxy struct {
+	clientConfig                             *platformclientv2.Configuration
+	speechTextAnalyticsApi                   *platformclientv2.SpeechTextAnalyticsApi
+	getSpeechAndTextAnalyticsSettingsAttr    getSpeechAndTextAnalyticsSettingsFunc
+	updateSpeechAndTextAnalyticsSettingsAttr updateSpeechAndTextAnalyticsSettingsFunc
+}
+
+// newSpeechAndTextAnalyticsSettingsProxy initializes the speech and text analytics settings proxy with all of the data needed to communicate with Genesys Cloud
+func newSpeechAndTextAnalyticsSettingsProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsSettingsProxy {
+	api := platformclientv2.NewSpeechTextAnalyticsApiWithConfig(clientConfig)
+	return &speechAndTextAnalyticsSettingsProxy{
+		clientConfig:                             clientConfig,
+		speechTextAnalyticsApi:                   api,
+		getSpeechAndTextAnalyticsSettingsAttr:    getSpeechAndTextAnalyticsSettingsFn,
+		updateSpeechAndTextAnalyticsSettingsAttr: updateSpeechAndTextAnalyticsSettingsFn,
+	}
+}
+
+// getSpeechAndTextAnalyticsSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSpeechAndTextAnalyticsSettingsProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsSettingsProxy {
+	if internalProxy == nil {
+		internalProxy = newSpeechAndTextAnalyticsSettingsProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getSpeechAndTextAnalyticsSettings retrieves the Genesys Cloud speech and text analytics settings of the organization
+func (p *speechAndTextAnalyticsSettingsProxy) getSpeechAndTextAnalyticsSettings(ctx context.Context) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsSettingsAttr(ctx, p)
+}
+
+// updateSpeechAndTextAnalyticsSettings updates the Genesys Cloud speech and text analytics settings of the organization
+func (p *speechAndTextAnalyticsSettingsProxy) updateSpeechAndTextAnalyticsSettings(ctx context.Context, body *platformclientv2.Speechtextanalyticssettingsrequest) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+	return p.updateSpeechAndTextAnalyticsSettingsAttr(ctx, p, body)
+}
+
+// getSpeechAndTextAnalyticsSettingsFn is the implementation for retrieving the speech and text analytics settings in Genesys Cloud
+func getSpeechAndTextAnalyticsSettingsFn(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+	settings, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsSettings()
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics settings: %s", err)
+	}
+	return settings, resp, nil
+}
+
+// updateSpeechAndTextAnalyticsSettingsFn is the implementation for updating the speech and text analytics settings in Genesys Cloud
+func updateSpeechAndTextAnalyticsSettingsFn(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy, body *platformclientv2.Speechtextanalyticssettingsrequest) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+	settings, resp, err := p.speechTextAnalyticsApi.PutSpeechandtextanalyticsSettings(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update speech and text analytics settings: %s", err)
+	}
+	return settings, resp, nil
+}
@@ -0,0 +1,77 @@
+package speechandtextanalytics_settings
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesycloud_speechandtextanalytics_settings_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the speechandtextanalytics_settings resource.
+3.  The resource exporter configuration for the speechandtextanalytics_settings exporter.
+*/
+const resourceName = "genesyscloud_speechandtextanalytics_settings"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceSpeechAndTextAnalyticsSettings())
+	regInstance.RegisterExporter(resourceName, SpeechAndTextAnalyticsSettingsExporter())
+}
+
+// ResourceSpeechAndTextAnalyticsSettings registers the genesyscloud_speechandtextanalytics_settings resource with Terraform
+func ResourceSpeechAndTextAnalyticsSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `An organization's speech and text analytics settings. Only one of these resources should be defined per organization.
+Deleting the resource leaves the settings of the organization as they are.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createSpeechAndTextAnalyticsSettings),
+		ReadContext:   gcloud.ReadWithPooledClient(readSpeechAndTextAnalyticsSettings),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateSpeechAndTextAnalyticsSettings),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteSpeechAndTextAnalyticsSettings),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"default_program_id": {
+				Description: "ID of the program that is used for interactions that are not mapped to any program.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"expected_dialects": {
+				Description: "The dialects that are expected in the interactions of the organization, e.g. en-US.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"text_analytics_enabled": {
+				Description: "Whether text analytics, including sentiment analysis of digital interactions, is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"agent_empathy_enabled": {
+				Description: "Whether the empathy and lack of empathy of agents is detected in interactions.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// SpeechAndTextAnalyticsSettingsExporter returns the resourceExporter object used to hold the genesyscloud_speechandtextanalytics_settings exporter's config
+func SpeechAndTextAnalyticsSettingsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllSpeechAndTextAnalyticsSettings),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"default_program_id": {RefType: "genesyscloud_speechandtextanalytics_program"},
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package speechandtextanalytics_settings
+
+import (
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	speechAndTextAnalyticsProgram "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_program"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_settings_test.go contains all of the test cases for running the resource
+tests for speechandtextanalytics_settings. The org running the test needs a speech and text analytics license.
+*/
+
+func TestAccResourceSpeechAndTextAnalyticsSettings(t *testing.T) {
+	var (
+		resourceId        = "test-settings"
+		fullName          = resourceName + "." + resourceId
+		programResourceId = "test-program"
+		programConfig     = speechAndTextAnalyticsProgram.GenerateSpeechAndTextAnalyticsProgramResource(
+			programResourceId,
+			"Terraform Default Program "+uuid.NewString(),
+		)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: programConfig + GenerateSpeechAndTextAnalyticsSettingsResource(
+					resourceId,
+					"default_program_id = genesyscloud_speechandtextanalytics_program."+programResourceId+".id",
+					`expected_dialects = ["en-US"]`,
+					"text_analytics_enabled = true",
+					"agent_empathy_enabled = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fullName, "default_program_id", "genesyscloud_speechandtextanalytics_program."+programResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "expected_dialects.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "expected_dialects.0", "en-US"),
+					resource.TestCheckResourceAttr(fullName, "text_analytics_enabled", "true"),
+					resource.TestCheckResourceAttr(fullName, "agent_empathy_enabled", "true"),
+				),
+			},
+			{
+				// Update. The default program is cleared before the program is destroyed.
+				Config: programConfig + GenerateSpeechAndTextAnalyticsSettingsResource(
+					resourceId,
+					`expected_dialects = ["en-US", "es-US"]`,
+					"text_analytics_enabled = false",
+					"agent_empathy_enabled = false",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "default_program_id", ""),
+					resource.TestCheckResourceAttr(fullName, "expected_dialects.#", "2"),
+					resource.TestCheckResourceAttr(fullName, "text_analytics_enabled", "false"),
+					resource.TestCheckResourceAttr(fullName, "agent_empathy_enabled", "false"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package speechandtextanalytics_settings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceSpeechAndTextAnalyticsSettingsCreate(t *testing.T) {
+	programId := uuid.NewString()
+	var updated *platformclientv2.Speechtextanalyticssettingsrequest
+
+	proxy := &speechAndTextAnalyticsSettingsProxy{}
+	proxy.updateSpeechAndTextAnalyticsSettingsAttr = func(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy, body *platformclientv2.Speechtextanalyticssettingsrequest) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+		updated = body
+		return &platformclientv2.Speechtextanalyticssettingsresponse{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsSettingsAttr = func(ctx context.Context, p *speechAndTextAnalyticsSettingsProxy) (*platformclientv2.Speechtextanalyticssettingsresponse, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Speechtextanalyticssettingsresponse{
+			DefaultProgram:       &platformclientv2.Addressableentityref{Id: updated.DefaultProgramId},
+			ExpectedDialects:     updated.ExpectedDialects,
+			TextAnalyticsEnabled: updated.TextAnalyticsEnabled,
+			AgentEmpathyEnabled:  updated.AgentEmpathyEnabled,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceSpeechAndTextAnalyticsSettings().Schema, map[string]interface{}{
+		"default_program_id":     programId,
+		"expected_dialects":      []interface{}{"en-US"},
+		"text_analytics_enabled": true,
+	})
+
+	diagErr := createSpeechAndTextAnalyticsSettings(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "settings", d.Id())
+
+	assert.Equal(t, programId, *updated.DefaultProgramId)
+	assert.Equal(t, []string{"en-US"}, *updated.ExpectedDialects)
+	assert.True(t, *updated.TextAnalyticsEnabled)
+	assert.False(t, *updated.AgentEmpathyEnabled)
+
+	assert.Equal(t, programId, d.Get("default_program_id"))
+	assert.Equal(t, []interface{}{"en-US"}, d.Get("expected_dialects"))
+	assert.True(t, d.Get("text_analytics_enabled").(bool))
+	assert.False(t, d.Get("agent_empathy_enabled").(bool))
+}
@@ -0,0 +1,42 @@
+package speechandtextanalytics_settings
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_settings_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getSettingsRequestFromResourceData maps data from schema ResourceData object to a *platformclientv2.Speechtextanalyticssettingsrequest.
+// The settings are replaced as a whole, so the computed values of unset attributes are sent back as they are.
+func getSettingsRequestFromResourceData(d *schema.ResourceData) *platformclientv2.Speechtextanalyticssettingsrequest {
+	expectedDialects := lists.InterfaceListToStrings(d.Get("expected_dialects").([]interface{}))
+	textAnalyticsEnabled := d.Get("text_analytics_enabled").(bool)
+	agentEmpathyEnabled := d.Get("agent_empathy_enabled").(bool)
+
+	settingsRequest := &platformclientv2.Speechtextanalyticssettingsrequest{
+		ExpectedDialects:     &expectedDialects,
+		TextAnalyticsEnabled: &textAnalyticsEnabled,
+		AgentEmpathyEnabled:  &agentEmpathyEnabled,
+	}
+	if defaultProgramId := d.Get("default_program_id").(string); defaultProgramId != "" {
+		settingsRequest.DefaultProgramId = &defaultProgramId
+	}
+	return settingsRequest
+}
+
+// GenerateSpeechAndTextAnalyticsSettingsResource generates a terraform string for the speech and text analytics settings resource
+func GenerateSpeechAndTextAnalyticsSettingsResource(resourceId string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_speechandtextanalytics_settings" "%s" {
+	%s
+}
+`, resourceId, strings.Join(extraAttrs, "\n"))
+}
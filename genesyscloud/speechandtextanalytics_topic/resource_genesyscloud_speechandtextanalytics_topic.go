@@ -0,0 +1,151 @@
+package speechandtextanalytics_topic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_topic.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllSpeechAndTextAnalyticsTopics retrieves all of the speech and text analytics topics via Terraform in the Genesys Cloud and is used for the exporter
+func getAllSpeechAndTextAnalyticsTopics(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getSpeechAndTextAnalyticsTopicProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	topics, _, err := proxy.getAllSpeechAndTextAnalyticsTopics(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get speech and text analytics topics: %v", err)
+	}
+
+	for _, topic := range *topics {
+		resources[*topic.Id] = &resourceExporter.ResourceMeta{Name: *topic.Name}
+	}
+	return resources, nil
+}
+
+// createSpeechAndTextAnalyticsTopic is used by the speechandtextanalytics_topic resource to create a Genesys Cloud speech and text analytics topic
+func createSpeechAndTextAnalyticsTopic(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsTopicProxy(sdkConfig)
+
+	topicRequest := getTopicRequestFromResourceData(d)
+
+	log.Printf("Creating speech and text analytics topic %s", *topicRequest.Name)
+	topic, _, err := proxy.createSpeechAndTextAnalyticsTopic(ctx, topicRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create speech and text analytics topic %s: %s", *topicRequest.Name, err)
+	}
+
+	d.SetId(*topic.Id)
+
+	if d.Get("published").(bool) {
+		if diagErr := publishTopic(ctx, proxy, d.Id()); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Created speech and text analytics topic %s %s", *topicRequest.Name, *topic.Id)
+	return readSpeechAndTextAnalyticsTopic(ctx, d, meta)
+}
+
+// readSpeechAndTextAnalyticsTopic is used by the speechandtextanalytics_topic resource to read a speech and text analytics topic from Genesys Cloud
+func readSpeechAndTextAnalyticsTopic(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsTopicProxy(sdkConfig)
+
+	log.Printf("Reading speech and text analytics topic %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		topic, resp, getErr := proxy.getSpeechAndTextAnalyticsTopic(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read speech and text analytics topic %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read speech and text analytics topic %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSpeechAndTextAnalyticsTopic())
+
+		resourcedata.SetNillableValue(d, "name", topic.Name)
+		resourcedata.SetNillableValue(d, "description", topic.Description)
+		resourcedata.SetNillableValue(d, "dialect", topic.Dialect)
+		resourcedata.SetNillableValue(d, "strictness", topic.Strictness)
+		resourcedata.SetNillableValue(d, "participants", topic.Participants)
+		resourcedata.SetNillableValue(d, "published", topic.Published)
+		if topic.Tags != nil {
+			_ = d.Set("tags", lists.StringListToSet(*topic.Tags))
+		} else {
+			_ = d.Set("tags", nil)
+		}
+		_ = d.Set("phrases", flattenPhrases(topic.Phrases))
+
+		log.Printf("Read speech and text analytics topic %s %s", d.Id(), *topic.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateSpeechAndTextAnalyticsTopic is used by the speechandtextanalytics_topic resource to update a speech and text analytics topic in Genesys Cloud
+func updateSpeechAndTextAnalyticsTopic(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsTopicProxy(sdkConfig)
+
+	topicRequest := getTopicRequestFromResourceData(d)
+
+	currentTopic, _, err := proxy.getSpeechAndTextAnalyticsTopic(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to read speech and text analytics topic %s: %s", d.Id(), err)
+	}
+	programIds := getProgramIds(currentTopic)
+	topicRequest.ProgramIds = &programIds
+
+	log.Printf("Updating speech and text analytics topic %s", *topicRequest.Name)
+	if _, _, err := proxy.updateSpeechAndTextAnalyticsTopic(ctx, d.Id(), topicRequest); err != nil {
+		return diag.Errorf("Failed to update speech and text analytics topic %s: %s", *topicRequest.Name, err)
+	}
+
+	if d.Get("published").(bool) {
+		if diagErr := publishTopic(ctx, proxy, d.Id()); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated speech and text analytics topic %s", *topicRequest.Name)
+	return readSpeechAndTextAnalyticsTopic(ctx, d, meta)
+}
+
+// deleteSpeechAndTextAnalyticsTopic is used by the speechandtextanalytics_topic resource to delete a speech and text analytics topic from Genesys Cloud
+func deleteSpeechAndTextAnalyticsTopic(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSpeechAndTextAnalyticsTopicProxy(sdkConfig)
+
+	if _, err := proxy.deleteSpeechAndTextAnalyticsTopic(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete speech and text analytics topic %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getSpeechAndTextAnalyticsTopic(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted speech and text analytics topic %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting speech and text analytics topic %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Speech and text analytics topic %s still exists", d.Id()))
+	})
+}
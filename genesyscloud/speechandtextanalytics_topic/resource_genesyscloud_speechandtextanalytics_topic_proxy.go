@@ -0,0 +1,192 @@
+package speechandtextanalytics_topic
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_topic_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *speechAndTextAnalyticsTopicProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllSpeechAndTextAnalyticsTopicsFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy) (*[]platformclientv2.Listedtopic, *platformclientv2.APIResponse, error)
+type createSpeechAndTextAnalyticsTopicFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsTopicFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.Topic, *platformclientv2.APIResponse, error)
+type updateSpeechAndTextAnalyticsTopicFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error)
+type deleteSpeechAndTextAnalyticsTopicFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.APIResponse, error)
+type publishSpeechAndTextAnalyticsTopicsFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, topicIds []string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error)
+type getSpeechAndTextAnalyticsTopicsPublishJobFunc func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, jobId string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error)
+
+// speechAndTextAnalyticsTopicProxy contains all of the methods that call genesys cloud APIs.
+type speechAndTextAnalyticsTopicProxy struct {
+	clientConfig                                  *platformclientv2.Configuration
+	speechTextAnalyticsApi                        *platformclientv2.SpeechTextAnalyticsApi
+	getAllSpeechAndTextAnalyticsTopicsAttr        getAllSpeechAndTextAnalyticsTopicsFunc
+	createSpeechAndTextAnalyticsTopicAttr         createSpeechAndTextAnalyticsTopicFunc
+	getSpeechAndTextAnalyticsTopicAttr            getSpeechAndTextAnalyticsTopicFunc
+	updateSpeechAndTextAnalyticsTopicAttr         updateSpeechAndTextAnalyticsTopicFunc
+	deleteSpeechAndTextAnalyticsTopicAttr         deleteSpeechAndTextAnalyticsTopicFunc
+	publishSpeechAndTextAnalyticsTopicsAttr       publishSpeechAndTextAnalyticsTopicsFunc
+	getSpeechAndTextAnalyticsTopicsPublishJobAttr getSpeechAndTextAnalyticsTopicsPublishJobFunc
+}
+
+// newSpeechAndTextAnalyticsTopicProxy initializes the speech and text analytics topic proxy with all of the data needed to communicate with Genesys Cloud
+func newSpeechAndTextAnalyticsTopicProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsTopicProxy {
+	api := platformclientv2.NewSpeechTextAnalyticsApiWithConfig(clientConfig)
+	return &speechAndTextAnalyticsTopicProxy{
+		clientConfig:                                  clientConfig,
+		speechTextAnalyticsApi:                        api,
+		getAllSpeechAndTextAnalyticsTopicsAttr:        getAllSpeechAndTextAnalyticsTopicsFn,
+		createSpeechAndTextAnalyticsTopicAttr:         createSpeechAndTextAnalyticsTopicFn,
+		getSpeechAndTextAnalyticsTopicAttr:            getSpeechAndTextAnalyticsTopicFn,
+		updateSpeechAndTextAnalyticsTopicAttr:         updateSpeechAndTextAnalyticsTopicFn,
+		deleteSpeechAndTextAnalyticsTopicAttr:         deleteSpeechAndTextAnalyticsTopicFn,
+		publishSpeechAndTextAnalyticsTopicsAttr:       publishSpeechAndTextAnalyticsTopicsFn,
+		getSpeechAndTextAnalyticsTopicsPublishJobAttr: getSpeechAndTextAnalyticsTopicsPublishJobFn,
+	}
+}
+
+// getSpeechAndTextAnalyticsTopicProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSpeechAndTextAnalyticsTopicProxy(clientConfig *platformclientv2.Configuration) *speechAndTextAnalyticsTopicProxy {
+	if internalProxy == nil {
+		internalProxy = newSpeechAndTextAnalyticsTopicProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllSpeechAndTextAnalyticsTopics retrieves all Genesys Cloud speech and text analytics topics
+func (p *speechAndTextAnalyticsTopicProxy) getAllSpeechAndTextAnalyticsTopics(ctx context.Context) (*[]platformclientv2.Listedtopic, *platformclientv2.APIResponse, error) {
+	return p.getAllSpeechAndTextAnalyticsTopicsAttr(ctx, p)
+}
+
+// createSpeechAndTextAnalyticsTopic creates a Genesys Cloud speech and text analytics topic
+func (p *speechAndTextAnalyticsTopicProxy) createSpeechAndTextAnalyticsTopic(ctx context.Context, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	return p.createSpeechAndTextAnalyticsTopicAttr(ctx, p, body)
+}
+
+// getSpeechAndTextAnalyticsTopic retrieves a Genesys Cloud speech and text analytics topic by id
+func (p *speechAndTextAnalyticsTopicProxy) getSpeechAndTextAnalyticsTopic(ctx context.Context, id string) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsTopicAttr(ctx, p, id)
+}
+
+// updateSpeechAndTextAnalyticsTopic updates a Genesys Cloud speech and text analytics topic
+func (p *speechAndTextAnalyticsTopicProxy) updateSpeechAndTextAnalyticsTopic(ctx context.Context, id string, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	return p.updateSpeechAndTextAnalyticsTopicAttr(ctx, p, id, body)
+}
+
+// deleteSpeechAndTextAnalyticsTopic deletes a Genesys Cloud speech and text analytics topic by id
+func (p *speechAndTextAnalyticsTopicProxy) deleteSpeechAndTextAnalyticsTopic(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteSpeechAndTextAnalyticsTopicAttr(ctx, p, id)
+}
+
+// publishSpeechAndTextAnalyticsTopics starts a job that publishes Genesys Cloud speech and text analytics topics
+func (p *speechAndTextAnalyticsTopicProxy) publishSpeechAndTextAnalyticsTopics(ctx context.Context, topicIds []string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+	return p.publishSpeechAndTextAnalyticsTopicsAttr(ctx, p, topicIds)
+}
+
+// getSpeechAndTextAnalyticsTopicsPublishJob retrieves a Genesys Cloud speech and text analytics topics publish job by id
+func (p *speechAndTextAnalyticsTopicProxy) getSpeechAndTextAnalyticsTopicsPublishJob(ctx context.Context, jobId string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+	return p.getSpeechAndTextAnalyticsTopicsPublishJobAttr(ctx, p, jobId)
+}
+
+// getAllSpeechAndTextAnalyticsTopicsFn is the implementation for retrieving all speech and text analytics topics in Genesys Cloud
+func getAllSpeechAndTextAnalyticsTopicsFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy) (*[]platformclientv2.Listedtopic, *platformclientv2.APIResponse, error) {
+	var (
+		nextPage  string
+		allTopics []platformclientv2.Listedtopic
+		resp      *platformclientv2.APIResponse
+	)
+
+	const pageSize = 100
+	for {
+		topics, apiResp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsTopics(nextPage, pageSize, "", "", nil, "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics topics: %s", err)
+		}
+
+		if topics.Entities == nil || len(*topics.Entities) == 0 {
+			break
+		}
+		allTopics = append(allTopics, *topics.Entities...)
+
+		if topics.NextUri == nil || *topics.NextUri == "" {
+			break
+		}
+
+		u, err := url.Parse(*topics.NextUri)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get speech and text analytics topics: %s", err)
+		}
+		nextPage = u.Query().Get("nextPage")
+		if nextPage == "" {
+			break
+		}
+	}
+
+	return &allTopics, resp, nil
+}
+
+// createSpeechAndTextAnalyticsTopicFn is the implementation for creating a speech and text analytics topic in Genesys Cloud
+func createSpeechAndTextAnalyticsTopicFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	topic, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsTopics(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create speech and text analytics topic: %s", err)
+	}
+	return topic, resp, nil
+}
+
+// getSpeechAndTextAnalyticsTopicFn is the implementation for retrieving a speech and text analytics topic in Genesys Cloud
+func getSpeechAndTextAnalyticsTopicFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	topic, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsTopic(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics topic by id %s: %s", id, err)
+	}
+	return topic, resp, nil
+}
+
+// updateSpeechAndTextAnalyticsTopicFn is the implementation for updating a speech and text analytics topic in Genesys Cloud
+func updateSpeechAndTextAnalyticsTopicFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+	topic, resp, err := p.speechTextAnalyticsApi.PutSpeechandtextanalyticsTopic(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update speech and text analytics topic %s: %s", id, err)
+	}
+	return topic, resp, nil
+}
+
+// deleteSpeechAndTextAnalyticsTopicFn is the implementation for deleting a speech and text analytics topic in Genesys Cloud
+func deleteSpeechAndTextAnalyticsTopicFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.speechTextAnalyticsApi.DeleteSpeechandtextanalyticsTopic(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete speech and text analytics topic %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// publishSpeechAndTextAnalyticsTopicsFn is the implementation for starting a speech and text analytics topics publish job in Genesys Cloud
+func publishSpeechAndTextAnalyticsTopicsFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, topicIds []string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+	job, resp, err := p.speechTextAnalyticsApi.PostSpeechandtextanalyticsTopicsPublishjobs(platformclientv2.Topicjobrequest{TopicIds: &topicIds})
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to publish speech and text analytics topics %v: %s", topicIds, err)
+	}
+	return job, resp, nil
+}
+
+// getSpeechAndTextAnalyticsTopicsPublishJobFn is the implementation for retrieving a speech and text analytics topics publish job in Genesys Cloud
+func getSpeechAndTextAnalyticsTopicsPublishJobFn(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, jobId string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+	job, resp, err := p.speechTextAnalyticsApi.GetSpeechandtextanalyticsTopicsPublishjob(jobId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve speech and text analytics topics publish job %s: %s", jobId, err)
+	}
+	return job, resp, nil
+}
@@ -0,0 +1,125 @@
+package speechandtextanalytics_topic
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_speechandtextanalytics_topic_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the speechandtextanalytics_topic resource.
+3.  The resource exporter configuration for the speechandtextanalytics_topic exporter.
+*/
+const resourceName = "genesyscloud_speechandtextanalytics_topic"
+
+var (
+	strictnessValues = []string{"1", "55", "72", "85", "95"}
+
+	phraseResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"text": {
+				Description: "The phrase text.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"strictness": {
+				Description:  "The phrase strictness. If not set, the strictness of the topic is used.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(strictnessValues, false),
+			},
+			"sentiment": {
+				Description:  "The phrase sentiment.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Unspecified",
+				ValidateFunc: validation.StringInSlice([]string{"Unspecified", "Positive", "Neutral", "Negative"}, false),
+			},
+		},
+	}
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceSpeechAndTextAnalyticsTopic())
+	regInstance.RegisterExporter(resourceName, SpeechAndTextAnalyticsTopicExporter())
+}
+
+// ResourceSpeechAndTextAnalyticsTopic registers the genesyscloud_speechandtextanalytics_topic resource with Terraform
+func ResourceSpeechAndTextAnalyticsTopic() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Speech and Text Analytics Topic. Topics group the phrases that are detected in the interactions of the programs they belong to.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createSpeechAndTextAnalyticsTopic),
+		ReadContext:   gcloud.ReadWithPooledClient(readSpeechAndTextAnalyticsTopic),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateSpeechAndTextAnalyticsTopic),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteSpeechAndTextAnalyticsTopic),
+		CustomizeDiff: customizeTopicDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The topic name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The topic description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"dialect": {
+				Description: "The topic dialect, e.g. en-US.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"strictness": {
+				Description:  "The topic strictness.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "72",
+				ValidateFunc: validation.StringInSlice(strictnessValues, false),
+			},
+			"participants": {
+				Description:  "The participants of the interactions in which the topic phrases are detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "All",
+				ValidateFunc: validation.StringInSlice([]string{"External", "Internal", "All"}, false),
+			},
+			"tags": {
+				Description: "The topic tags.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"phrases": {
+				Description: "The topic phrases.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        phraseResource,
+			},
+			"published": {
+				Description: "Whether the topic is published. Changes to a topic are only applied to interactions once the topic is published. Publishing starts a publish job which is waited on until it completes. A published topic cannot be unpublished, so changing this from true to false is rejected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// SpeechAndTextAnalyticsTopicExporter returns the resourceExporter object used to hold the genesyscloud_speechandtextanalytics_topic exporter's config
+func SpeechAndTextAnalyticsTopicExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllSpeechAndTextAnalyticsTopics),
+	}
+}
@@ -0,0 +1,108 @@
+package speechandtextanalytics_topic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_topic_test.go contains all of the test cases for running the resource
+tests for speechandtextanalytics_topic. The org running the test needs a speech and text analytics license.
+*/
+
+func TestAccResourceSpeechAndTextAnalyticsTopic(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId = "test-topic"
+		fullName   = resourceName + "." + resourceId
+		name1      = "Terraform Topic " + uuid.NewString()
+		name2      = "Terraform Topic " + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateSpeechAndTextAnalyticsTopicResource(
+					resourceId,
+					name1,
+					"en-US",
+					`description = "Terraform topic"`,
+					`tags = ["terraform"]`,
+					GeneratePhrase("cancel my subscription"),
+					GeneratePhrase("close my account", `strictness = "85"`),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "description", "Terraform topic"),
+					resource.TestCheckResourceAttr(fullName, "dialect", "en-US"),
+					resource.TestCheckResourceAttr(fullName, "strictness", "72"),
+					resource.TestCheckResourceAttr(fullName, "participants", "All"),
+					resource.TestCheckResourceAttr(fullName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "phrases.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "phrases.*", map[string]string{"text": "close my account", "strictness": "85"}),
+					resource.TestCheckResourceAttr(fullName, "published", "false"),
+				),
+			},
+			{
+				// Update and publish
+				Config: GenerateSpeechAndTextAnalyticsTopicResource(
+					resourceId,
+					name2,
+					"en-US",
+					`strictness = "85"`,
+					`participants = "External"`,
+					`published = true`,
+					GeneratePhrase("cancel my subscription"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "strictness", "85"),
+					resource.TestCheckResourceAttr(fullName, "participants", "External"),
+					resource.TestCheckResourceAttr(fullName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(fullName, "phrases.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "published", "true"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifySpeechAndTextAnalyticsTopicDestroyed,
+	})
+}
+
+func testVerifySpeechAndTextAnalyticsTopicDestroyed(state *terraform.State) error {
+	proxy := newSpeechAndTextAnalyticsTopicProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		topic, resp, err := proxy.getSpeechAndTextAnalyticsTopic(context.Background(), rs.Primary.ID)
+		if topic != nil {
+			return fmt.Errorf("Speech and text analytics topic (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Topic not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All topics destroyed
+	return nil
+}
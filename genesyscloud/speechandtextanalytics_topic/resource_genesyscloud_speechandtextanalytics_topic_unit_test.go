@@ -0,0 +1,131 @@
+package speechandtextanalytics_topic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceSpeechAndTextAnalyticsTopicUpdate(t *testing.T) {
+	topicId := uuid.NewString()
+	programId := uuid.NewString()
+	jobId := uuid.NewString()
+	current := &platformclientv2.Topic{
+		Id:        &topicId,
+		Name:      platformclientv2.String("Old Name"),
+		Published: platformclientv2.Bool(true),
+		Programs:  &[]platformclientv2.Baseprogramentity{{Id: &programId}},
+	}
+	var updated *platformclientv2.Topicrequest
+	jobPolls := 0
+
+	proxy := &speechAndTextAnalyticsTopicProxy{}
+	proxy.getSpeechAndTextAnalyticsTopicAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, topicId, id)
+		return current, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateSpeechAndTextAnalyticsTopicAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string, body *platformclientv2.Topicrequest) (*platformclientv2.Topic, *platformclientv2.APIResponse, error) {
+		updated = body
+		current = &platformclientv2.Topic{
+			Id:           &topicId,
+			Name:         body.Name,
+			Description:  body.Description,
+			Dialect:      body.Dialect,
+			Strictness:   body.Strictness,
+			Participants: body.Participants,
+			Tags:         body.Tags,
+			Phrases:      body.Phrases,
+			Published:    platformclientv2.Bool(false),
+			Programs:     current.Programs,
+		}
+		return current, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.publishSpeechAndTextAnalyticsTopicsAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, topicIds []string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, []string{topicId}, topicIds)
+		return &platformclientv2.Topicjob{Id: &jobId, State: platformclientv2.String("Running")}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsTopicsPublishJobAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, jobId, id)
+		jobPolls++
+		if jobPolls < 2 {
+			return &platformclientv2.Topicjob{Id: &jobId, State: platformclientv2.String("Running")}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		}
+		current.Published = platformclientv2.Bool(true)
+		return &platformclientv2.Topicjob{Id: &jobId, State: platformclientv2.String("Completed")}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceSpeechAndTextAnalyticsTopic().Schema, map[string]interface{}{
+		"name":      "New Name",
+		"dialect":   "en-US",
+		"published": true,
+		"phrases": []interface{}{
+			map[string]interface{}{"text": "cancel my subscription", "strictness": "85"},
+		},
+	})
+	d.SetId(topicId)
+
+	diagErr := updateSpeechAndTextAnalyticsTopic(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	// Program assignments are preserved
+	assert.Equal(t, []string{programId}, *updated.ProgramIds)
+	assert.Equal(t, "72", *updated.Strictness)
+	assert.Equal(t, "All", *updated.Participants)
+	assert.Equal(t, "85", *(*updated.Phrases)[0].Strictness)
+	assert.Equal(t, "Unspecified", *(*updated.Phrases)[0].Sentiment)
+	assert.Equal(t, 2, jobPolls)
+
+	assert.Equal(t, "New Name", d.Get("name").(string))
+	assert.True(t, d.Get("published").(bool))
+	assert.Equal(t, 1, d.Get("phrases").(*schema.Set).Len())
+}
+
+func TestUnitPublishTopicFailed(t *testing.T) {
+	topicId := uuid.NewString()
+	jobId := uuid.NewString()
+
+	proxy := &speechAndTextAnalyticsTopicProxy{}
+	proxy.publishSpeechAndTextAnalyticsTopicsAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, topicIds []string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Topicjob{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getSpeechAndTextAnalyticsTopicsPublishJobAttr = func(ctx context.Context, p *speechAndTextAnalyticsTopicProxy, id string) (*platformclientv2.Topicjob, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Topicjob{Id: &jobId, State: platformclientv2.String("Failed")}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	diagErr := publishTopic(context.Background(), proxy, topicId)
+	assert.NotNil(t, diagErr)
+}
+
+func TestUnitCustomizeTopicDiff(t *testing.T) {
+	topicResource := ResourceSpeechAndTextAnalyticsTopic()
+	state := &terraform.InstanceState{
+		ID: uuid.NewString(),
+		Attributes: map[string]string{
+			"id":        "topic",
+			"name":      "Topic",
+			"published": "true",
+		},
+	}
+	config := func(published bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "Topic",
+			"published": published,
+		})
+	}
+
+	_, err := topicResource.Diff(context.Background(), state, config(false), nil)
+	assert.ErrorContains(t, err, "cannot be unpublished")
+
+	_, err = topicResource.Diff(context.Background(), state, config(true), nil)
+	assert.NoError(t, err)
+}
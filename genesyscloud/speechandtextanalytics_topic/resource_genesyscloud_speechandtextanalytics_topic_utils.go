@@ -0,0 +1,164 @@
+package speechandtextanalytics_topic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_speechandtextanalytics_topic_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// customizeTopicDiff rejects unpublishing a topic. The API has no unpublish operation, so the change could never be applied.
+func customizeTopicDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldPublished, newPublished := diff.GetChange("published")
+	if oldPublished.(bool) && !newPublished.(bool) {
+		return fmt.Errorf("topic %s is published and cannot be unpublished. Keep published set to true, or recreate the topic to discard the published version", diff.Id())
+	}
+	return nil
+}
+
+// getTopicRequestFromResourceData maps data from schema ResourceData object to a *platformclientv2.Topicrequest
+func getTopicRequestFromResourceData(d *schema.ResourceData) *platformclientv2.Topicrequest {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	dialect := d.Get("dialect").(string)
+	strictness := d.Get("strictness").(string)
+	participants := d.Get("participants").(string)
+	phrases := buildPhrases(d.Get("phrases").(*schema.Set))
+
+	return &platformclientv2.Topicrequest{
+		Name:         &name,
+		Description:  &description,
+		Dialect:      &dialect,
+		Strictness:   &strictness,
+		Participants: &participants,
+		Tags:         lists.SetToStringList(d.Get("tags").(*schema.Set)),
+		Phrases:      &phrases,
+	}
+}
+
+// buildPhrases maps the phrases blocks into a list of Genesys Cloud platformclientv2.Phrase
+func buildPhrases(phrasesSet *schema.Set) []platformclientv2.Phrase {
+	phrases := make([]platformclientv2.Phrase, 0)
+	for _, phrase := range phrasesSet.List() {
+		phraseMap := phrase.(map[string]interface{})
+		text := phraseMap["text"].(string)
+		sentiment := phraseMap["sentiment"].(string)
+
+		sdkPhrase := platformclientv2.Phrase{
+			Text:      &text,
+			Sentiment: &sentiment,
+		}
+		if strictness, ok := phraseMap["strictness"].(string); ok && strictness != "" {
+			sdkPhrase.Strictness = &strictness
+		}
+		phrases = append(phrases, sdkPhrase)
+	}
+	return phrases
+}
+
+// flattenPhrases maps a list of Genesys Cloud platformclientv2.Phrase into phrases blocks
+func flattenPhrases(phrases *[]platformclientv2.Phrase) []interface{} {
+	if phrases == nil {
+		return nil
+	}
+
+	phraseList := make([]interface{}, 0)
+	for _, phrase := range *phrases {
+		phraseMap := make(map[string]interface{})
+		if phrase.Text != nil {
+			phraseMap["text"] = *phrase.Text
+		}
+		if phrase.Strictness != nil {
+			phraseMap["strictness"] = *phrase.Strictness
+		}
+		if phrase.Sentiment != nil {
+			phraseMap["sentiment"] = *phrase.Sentiment
+		}
+		phraseList = append(phraseList, phraseMap)
+	}
+	return phraseList
+}
+
+// getProgramIds returns the ids of the programs a topic belongs to. Program assignments are managed by the
+// genesyscloud_speechandtextanalytics_program resource so they are preserved when the topic is updated.
+func getProgramIds(topic *platformclientv2.Topic) []string {
+	programIds := make([]string, 0)
+	if topic.Programs != nil {
+		for _, program := range *topic.Programs {
+			if program.Id != nil {
+				programIds = append(programIds, *program.Id)
+			}
+		}
+	}
+	return programIds
+}
+
+// publishTopic starts a publish job for a topic and waits until the job completes
+func publishTopic(ctx context.Context, proxy *speechAndTextAnalyticsTopicProxy, topicId string) diag.Diagnostics {
+	log.Printf("Publishing speech and text analytics topic %s", topicId)
+	job, _, err := proxy.publishSpeechAndTextAnalyticsTopics(ctx, []string{topicId})
+	if err != nil {
+		return diag.Errorf("Failed to publish speech and text analytics topic %s: %s", topicId, err)
+	}
+
+	diagErr := gcloud.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		job, _, err := proxy.getSpeechAndTextAnalyticsTopicsPublishJob(ctx, *job.Id)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read publish job of speech and text analytics topic %s: %s", topicId, err))
+		}
+
+		state := ""
+		if job.State != nil {
+			state = *job.State
+		}
+		switch state {
+		case "Completed":
+			return nil
+		case "Failed":
+			return retry.NonRetryableError(fmt.Errorf("publish job %s of speech and text analytics topic %s failed", *job.Id, topicId))
+		}
+		return retry.RetryableError(fmt.Errorf("publish job %s of speech and text analytics topic %s is %s", *job.Id, topicId, strings.ToLower(state)))
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Published speech and text analytics topic %s", topicId)
+	return nil
+}
+
+// GenerateSpeechAndTextAnalyticsTopicResource generates a terraform string for a speech and text analytics topic resource
+func GenerateSpeechAndTextAnalyticsTopicResource(resourceId string, name string, dialect string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_speechandtextanalytics_topic" "%s" {
+	name    = "%s"
+	dialect = "%s"
+	%s
+}
+`, resourceId, name, dialect, strings.Join(extraAttrs, "\n"))
+}
+
+// GeneratePhrase generates a terraform string for a phrases block
+func GeneratePhrase(text string, extraAttrs ...string) string {
+	return fmt.Sprintf(`phrases {
+		text = "%s"
+		%s
+	}
+	`, text, strings.Join(extraAttrs, "\n"))
+}
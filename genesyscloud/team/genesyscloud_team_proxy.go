@@ -15,6 +15,8 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
+//go:generate go run terraform-provider-genesyscloud/genesyscloud/util/proxyfake/proxyfakegen -type teamProxy
+
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *teamProxy
 
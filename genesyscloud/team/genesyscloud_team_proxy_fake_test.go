@@ -0,0 +1,119 @@
+// Code generated by proxyfakegen. DO NOT EDIT.
+
+package team
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/util/proxyfake"
+)
+
+// teamProxyFake is an in-memory fake of teamProxy. Its Store holds the entities and the simulated errors.
+type teamProxyFake struct {
+	*proxyfake.Store
+	proxy *teamProxy
+}
+
+// newTeamProxyFake returns a fake whose proxy can be assigned to internalProxy in unit tests
+func newTeamProxyFake() *teamProxyFake {
+	f := &teamProxyFake{Store: proxyfake.NewStore(), proxy: &teamProxy{}}
+	f.proxy.createTeamAttr = f.createTeam
+	f.proxy.getAllTeamAttr = f.getAllTeam
+	f.proxy.getTeamIdByNameAttr = f.getTeamIdByName
+	f.proxy.getTeamByIdAttr = f.getTeamById
+	f.proxy.updateTeamAttr = f.updateTeam
+	f.proxy.deleteTeamAttr = f.deleteTeam
+	f.proxy.createMembersAttr = f.createMembers
+	f.proxy.getMembersByIdAttr = f.getMembersById
+	f.proxy.deleteMembersAttr = f.deleteMembers
+	return f
+}
+
+func (f *teamProxyFake) createTeam(ctx context.Context, p *teamProxy, team *platformclientv2.Team) (*platformclientv2.Team, error) {
+	if _, err := f.NextError("createTeam"); err != nil {
+		return nil, err
+	}
+	fakeId := f.Create(team)
+	fakeEntity, _ := f.Get(fakeId)
+	return fakeEntity.(*platformclientv2.Team), nil
+}
+
+func (f *teamProxyFake) getAllTeam(ctx context.Context, p *teamProxy, name string) (*[]platformclientv2.Team, error) {
+	if _, err := f.NextError("getAllTeam"); err != nil {
+		return nil, err
+	}
+	fakeEntities := make([]platformclientv2.Team, 0)
+	for _, fakeEntity := range f.List(name) {
+		if fakeValue, isType := fakeEntity.(*platformclientv2.Team); isType {
+			fakeEntities = append(fakeEntities, *fakeValue)
+		}
+	}
+	return &fakeEntities, nil
+}
+
+func (f *teamProxyFake) getTeamIdByName(ctx context.Context, p *teamProxy, name string) (string, bool, error) {
+	if _, err := f.NextError("getTeamIdByName"); err != nil {
+		return "", true, err
+	}
+	fakeId, found := f.IdByName(name)
+	if !found {
+		return "", true, proxyfake.NotFoundError(name)
+	}
+	return fakeId, false, nil
+}
+
+func (f *teamProxyFake) getTeamById(ctx context.Context, p *teamProxy, id string) (*platformclientv2.Team, int, error) {
+	if statusCode, err := f.NextError("getTeamById"); err != nil {
+		return nil, statusCode, err
+	}
+	fakeEntity, found := f.Get(id)
+	fakeValue, isType := fakeEntity.(*platformclientv2.Team)
+	if !found || !isType {
+		return nil, http.StatusNotFound, proxyfake.NotFoundError(id)
+	}
+	return fakeValue, http.StatusOK, nil
+}
+
+func (f *teamProxyFake) updateTeam(ctx context.Context, p *teamProxy, id string, team *platformclientv2.Team) (*platformclientv2.Team, error) {
+	if _, err := f.NextError("updateTeam"); err != nil {
+		return nil, err
+	}
+	if !f.Update(id, team) {
+		return nil, proxyfake.NotFoundError(id)
+	}
+	fakeEntity, _ := f.Get(id)
+	return fakeEntity.(*platformclientv2.Team), nil
+}
+
+func (f *teamProxyFake) deleteTeam(ctx context.Context, p *teamProxy, id string) (int, error) {
+	if statusCode, err := f.NextError("deleteTeam"); err != nil {
+		return statusCode, err
+	}
+	if !f.Delete(id) {
+		return http.StatusNotFound, proxyfake.NotFoundError(id)
+	}
+	return http.StatusOK, nil
+}
+
+func (f *teamProxyFake) createMembers(ctx context.Context, p *teamProxy, teamId string, members platformclientv2.Teammembers) (*platformclientv2.Teammemberaddlistingresponse, error) {
+	if _, err := f.NextError("createMembers"); err != nil {
+		return nil, err
+	}
+	return nil, proxyfake.NotSupportedError("createMembers")
+}
+
+func (f *teamProxyFake) getMembersById(ctx context.Context, p *teamProxy, teamId string) (*[]platformclientv2.Userreferencewithname, error) {
+	if _, err := f.NextError("getMembersById"); err != nil {
+		return nil, err
+	}
+	return nil, proxyfake.NotSupportedError("getMembersById")
+}
+
+func (f *teamProxyFake) deleteMembers(ctx context.Context, p *teamProxy, teamId string, memberId string) (int, error) {
+	if statusCode, err := f.NextError("deleteMembers"); err != nil {
+		return statusCode, err
+	}
+	return http.StatusNotImplemented, proxyfake.NotSupportedError("deleteMembers")
+}
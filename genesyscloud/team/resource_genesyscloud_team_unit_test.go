@@ -256,6 +256,53 @@ func TestUnitResourceTeamGetAll(t *testing.T) {
 
 }
 
+func TestUnitResourceTeamLifecycleWithFake(t *testing.T) {
+	tName := "My Unit Test Team"
+	tDescription := "My Unit Test Team"
+	tDivisionId := uuid.NewString()
+
+	fake := newTeamProxyFake()
+	// Members are child entities of the team that the fake does not store
+	fake.proxy.getMembersByIdAttr = func(ctx context.Context, p *teamProxy, teamId string) (*[]platformclientv2.Userreferencewithname, error) {
+		return &[]platformclientv2.Userreferencewithname{}, nil
+	}
+	internalProxy = fake.proxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceSchema := ResourceTeam().Schema
+	resourceDataMap := buildTeamResourceMap("", tName, tDescription, tDivisionId)
+	d := schema.TestResourceDataRaw(t, resourceSchema, resourceDataMap)
+
+	// A conflict on the first create attempt is surfaced to the user
+	fake.SimulateError("createTeam", http.StatusConflict, 1)
+	diag := createTeam(ctx, d, gc)
+	assert.Equal(t, true, diag.HasError())
+
+	diag = createTeam(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, tName, d.Get("name").(string))
+
+	teamId, _, err := fake.proxy.getTeamIdByName(ctx, tName)
+	assert.Nil(t, err)
+	assert.Equal(t, d.Id(), teamId)
+
+	tUpdatedDescription := "My Updated Unit Test Team"
+	assert.Nil(t, d.Set("description", tUpdatedDescription))
+	diag = updateTeam(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tUpdatedDescription, d.Get("description").(string))
+
+	diag = deleteTeam(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	_, statusCode, _ := fake.proxy.getTeamById(ctx, d.Id())
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.Equal(t, 2, fake.Calls("createTeam"))
+}
+
 func buildTeamResourceMap(tId string, tName string, tDescription string, tDivisionId string) map[string]interface{} {
 	resourceDataMap := map[string]interface{}{
 		"id":          tId,
@@ -25,6 +25,9 @@ const (
 	defaultTfJSONVariablesFile = "variables.tf.json"
 	defaultTfVarsFile          = "terraform.tfvars"
 	defaultTfStateFile         = "terraform.tfstate"
+
+	datatableRowResourceType  = "genesyscloud_architect_datatable_row"
+	datatableRowsResourceType = "genesyscloud_architect_datatable_rows"
 )
 
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
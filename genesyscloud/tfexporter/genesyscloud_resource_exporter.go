@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -66,6 +67,7 @@ type GenesysCloudResourceExporter struct {
 	splitFilesByResource   bool
 	logPermissionErrors    bool
 	addDependsOn           bool
+	datatableRowsAsCsv     bool
 	includeStateFile       bool
 	version                string
 	provider               *schema.Provider
@@ -128,6 +130,7 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 		splitFilesByResource: d.Get("split_files_by_resource").(bool),
 		logPermissionErrors:  d.Get("log_permission_errors").(bool),
 		addDependsOn:         d.Get("enable_flow_depends_on").(bool),
+		datatableRowsAsCsv:   d.Get("export_datatable_rows_as_csv").(bool),
 		filterType:           filterType,
 		includeStateFile:     d.Get("include_state_file").(bool),
 		version:              meta.(*gcloud.ProviderMeta).Version,
@@ -215,6 +218,7 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 		exports = g.resourceTypeFilter(exports, *g.filterList)
 	}
 
+	g.selectDatatableRowsExporter(exports)
 	g.exporters = &exports
 
 	// Assign excluded attributes to the config Map
@@ -226,6 +230,46 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 	return nil
 }
 
+// selectDatatableRowsExporter keeps a single exporter for datatable rows so that rows are never managed twice. With
+// export_datatable_rows_as_csv, datatables get one CSV backed genesyscloud_architect_datatable_rows resource each in
+// place of the per-row resources. Otherwise the CSV resource is only exported when it is explicitly included.
+func (g *GenesysCloudResourceExporter) selectDatatableRowsExporter(exports map[string]*resourceExporter.ResourceExporter) {
+	if g.datatableRowsAsCsv {
+		if _, ok := exports[datatableRowResourceType]; ok {
+			delete(exports, datatableRowResourceType)
+			if exporter, ok := resourceExporter.GetResourceExporters()[datatableRowsResourceType]; ok {
+				exports[datatableRowsResourceType] = exporter
+			}
+		}
+		return
+	}
+
+	explicitlyIncluded := g.filterList != nil && (g.filterType == IncludeResources || g.filterType == LegacyInclude) &&
+		lists.ItemInSlice(datatableRowsResourceType, formatFilter(*g.filterList))
+	if !explicitlyIncluded {
+		delete(exports, datatableRowsResourceType)
+	}
+}
+
+// datatableRowsFilter applies the name filters of genesyscloud_architect_datatable_row to the CSV backed
+// genesyscloud_architect_datatable_rows resources that replace it with export_datatable_rows_as_csv. Those resources are
+// named after their datatable, so a filter such as genesyscloud_architect_datatable_row::^Customers keeps selecting the
+// rows of the same datatables.
+func (g *GenesysCloudResourceExporter) datatableRowsFilter(filter []string) []string {
+	if !g.datatableRowsAsCsv {
+		return filter
+	}
+
+	newFilter := make([]string, 0, len(filter))
+	for _, f := range filter {
+		if strings.HasPrefix(f, datatableRowResourceType+"::") {
+			f = datatableRowsResourceType + strings.TrimPrefix(f, datatableRowResourceType)
+		}
+		newFilter = append(newFilter, f)
+	}
+	return newFilter
+}
+
 // Removes the ::resource_name from the resource_types list
 func formatFilter(filter []string) []string {
 	newFilter := make([]string, 0)
@@ -259,6 +303,8 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 		}
 	}
 
+	newFilter = g.datatableRowsFilter(newFilter)
+
 	//Retrieve a map of all of the objects we are going to build.  Apply the filter that will remove specific classes of an object
 	diagErr = g.buildSanitizedResourceMaps(*g.exporters, newFilter, g.logPermissionErrors)
 	if diagErr != nil {
@@ -281,6 +327,7 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
 	var wg sync.WaitGroup
+	var resourcesMutex sync.Mutex
 
 	ctx, cancel := context.WithCancel(g.ctx)
 	defer cancel()
@@ -300,7 +347,9 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 				cancel()
 				return
 			}
+			resourcesMutex.Lock()
 			g.resources = append(g.resources, typeResources...)
+			resourcesMutex.Unlock()
 		}(resType, exporter)
 	}
 
@@ -326,6 +375,9 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.resourceTypesHCLBlocks = make(map[string]resourceHCLBlock, 0)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
 
+	// Resources are retrieved concurrently. Sort them so that the generated files are identical between runs.
+	sortResources(g.resources)
+
 	for _, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
 		if diagErr != nil {
@@ -371,6 +423,15 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	return nil
 }
 
+func sortResources(resources []resourceExporter.ResourceInfo) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Type != resources[j].Type {
+			return resources[i].Type < resources[j].Type
+		}
+		return resources[i].Name < resources[j].Name
+	})
+}
+
 func (g *GenesysCloudResourceExporter) updateSanitiseMap(exporters map[string]*resourceExporter.ResourceExporter, //Map of all of the exporters
 	resource resourceExporter.ResourceInfo) {
 	if exporters[resource.Type] != nil {
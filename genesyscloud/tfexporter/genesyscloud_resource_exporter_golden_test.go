@@ -0,0 +1,271 @@
+package tfexporter
+
+import (
+	"context"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/proxyfake"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The golden export tests run the full GenesysCloudResourceExporter pipeline against two fake resource types whose
+state is read from in-memory proxy stores, and compare the generated files with the golden files stored under
+test/data/export. Run with -update-golden to regenerate the golden files after an intentional change to the output.
+*/
+
+const (
+	goldenDivisionType = "genesyscloud_golden_division"
+	goldenTeamType     = "genesyscloud_golden_team"
+)
+
+type goldenDivision struct {
+	Id          *string
+	Name        *string
+	Description *string
+}
+
+type goldenTeam struct {
+	Id             *string
+	Name           *string
+	Description    *string
+	DivisionId     *string
+	MemberIds      []string
+	PhoneNumber    *string
+	MaxMembers     int
+	Enabled        bool
+	SettingsJson   *string
+	EdgeId         *string
+	AutoAssignment *goldenTeamAutoAssignment
+}
+
+type goldenTeamAutoAssignment struct {
+	Enabled    bool
+	DivisionId *string
+}
+
+func TestUnitExportGoldenFiles(t *testing.T) {
+	testCases := []struct {
+		name             string
+		exportAsHCL      bool
+		includeStateFile bool
+	}{
+		{name: "hcl_with_state", exportAsHCL: true, includeStateFile: true},
+		{name: "json_without_state", exportAsHCL: false, includeStateFile: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			exportDir := runGoldenExport(t, testCase.exportAsHCL, testCase.includeStateFile)
+			testrunner.CompareExportWithGoldenFiles(t, exportDir, testCase.name, testrunner.NormalizeTfStateLineage)
+		})
+	}
+}
+
+// runGoldenExport registers the fake resources and exporters, runs an export into a temporary directory and returns the directory
+func runGoldenExport(t *testing.T, exportAsHCL bool, includeStateFile bool) string {
+	divisions, teams := buildGoldenStores()
+
+	originalResources, originalDataSources := providerResources, providerDataSources
+	originalExporters := resourceExporter.GetResourceExporters()
+	t.Cleanup(func() {
+		providerResources, providerDataSources = originalResources, originalDataSources
+		resourceExporter.SetRegisterExporter(originalExporters)
+		attributesDecoded = make(map[string]string)
+	})
+
+	providerResources = map[string]*schema.Resource{
+		goldenDivisionType: goldenDivisionResource(divisions),
+		goldenTeamType:     goldenTeamResource(teams),
+	}
+	providerDataSources = map[string]*schema.Resource{}
+	resourceExporter.SetRegisterExporter(map[string]*resourceExporter.ResourceExporter{
+		goldenDivisionType: goldenDivisionExporter(divisions),
+		goldenTeamType:     goldenTeamExporter(teams),
+	})
+
+	// Keep the state file as written by the exporter rather than upgraded by a local terraform CLI
+	t.Setenv("PATH", "")
+
+	exportDir := t.TempDir()
+	d := schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{
+		"directory":                exportDir,
+		"include_filter_resources": []interface{}{goldenDivisionType, goldenTeamType},
+		"export_as_hcl":            exportAsHCL,
+		"include_state_file":       includeStateFile,
+	})
+	meta := &gcloud.ProviderMeta{
+		Version:      "0.1.0",
+		ClientConfig: platformclientv2.GetDefaultConfiguration(),
+	}
+
+	gre, diagErr := NewGenesysCloudResourceExporter(context.Background(), d, meta, IncludeResources)
+	if diagErr != nil {
+		t.Fatalf("Failed to create exporter: %v", diagErr)
+	}
+	if diagErr := gre.Export(); diagErr != nil {
+		t.Fatalf("Export failed: %v", diagErr)
+	}
+	return exportDir
+}
+
+func buildGoldenStores() (*proxyfake.Store, *proxyfake.Store) {
+	divisions := proxyfake.NewStore()
+	divisions.Create(&goldenDivision{
+		Id:          platformclientv2.String("d1000000-0000-0000-0000-000000000001"),
+		Name:        platformclientv2.String("Sales"),
+		Description: platformclientv2.String("Sales division"),
+	})
+	divisions.Create(&goldenDivision{
+		Id:   platformclientv2.String("d1000000-0000-0000-0000-000000000002"),
+		Name: platformclientv2.String("Support & Service"),
+	})
+
+	teams := proxyfake.NewStore()
+	teams.Create(&goldenTeam{
+		Id:          platformclientv2.String("t1000000-0000-0000-0000-000000000001"),
+		Name:        platformclientv2.String("Inbound Sales"),
+		Description: platformclientv2.String("Handles ${region} inbound calls"),
+		DivisionId:  platformclientv2.String("d1000000-0000-0000-0000-000000000001"),
+		// The second member does not belong to an exported resource and cannot be resolved to a reference
+		MemberIds:    []string{"d1000000-0000-0000-0000-000000000002", "u1000000-0000-0000-0000-000000000099"},
+		PhoneNumber:  platformclientv2.String("+1 (317) 555-0100"),
+		Enabled:      true,
+		SettingsJson: platformclientv2.String(`{"priority":5,"tags":["inbound","sales"]}`),
+		EdgeId:       platformclientv2.String("e1000000-0000-0000-0000-000000000001"),
+		AutoAssignment: &goldenTeamAutoAssignment{
+			Enabled:    true,
+			DivisionId: platformclientv2.String("d1000000-0000-0000-0000-000000000002"),
+		},
+	})
+	teams.Create(&goldenTeam{
+		Id:         platformclientv2.String("t1000000-0000-0000-0000-000000000002"),
+		Name:       platformclientv2.String("Escalations"),
+		DivisionId: platformclientv2.String("d1000000-0000-0000-0000-000000000099"),
+		MaxMembers: 10,
+	})
+	return divisions, teams
+}
+
+func goldenResourceIds(store *proxyfake.Store) resourceExporter.GetAllResourcesFunc {
+	return func(_ context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+		resources := make(resourceExporter.ResourceIDMetaMap)
+		for _, entity := range store.List("") {
+			switch e := entity.(type) {
+			case *goldenDivision:
+				resources[*e.Id] = &resourceExporter.ResourceMeta{Name: *e.Name}
+			case *goldenTeam:
+				resources[*e.Id] = &resourceExporter.ResourceMeta{Name: *e.Name}
+			}
+		}
+		return resources, nil
+	}
+}
+
+func goldenDivisionExporter(divisions *proxyfake.Store) *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: goldenResourceIds(divisions),
+	}
+}
+
+func goldenTeamExporter(teams *proxyfake.Store) *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: goldenResourceIds(teams),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"division_id":                 {RefType: goldenDivisionType},
+			"member_ids":                  {RefType: goldenDivisionType},
+			"auto_assignment.division_id": {RefType: goldenDivisionType},
+		},
+		JsonEncodeAttributes: []string{"settings_json"},
+		UnResolvableAttributes: map[string]*schema.Schema{
+			"edge_id": goldenTeamResource(teams).Schema["edge_id"],
+		},
+		CustomValidateExports: map[string][]string{
+			"E164": {"phone_number"},
+		},
+	}
+}
+
+func goldenDivisionResource(divisions *proxyfake.Store) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			entity, ok := divisions.Get(d.Id())
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			division := entity.(*goldenDivision)
+			_ = d.Set("name", *division.Name)
+			_ = d.Set("description", stringOrEmpty(division.Description))
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+		},
+	}
+}
+
+func goldenTeamResource(teams *proxyfake.Store) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			entity, ok := teams.Get(d.Id())
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			team := entity.(*goldenTeam)
+			_ = d.Set("name", *team.Name)
+			_ = d.Set("description", stringOrEmpty(team.Description))
+			_ = d.Set("division_id", stringOrEmpty(team.DivisionId))
+			_ = d.Set("member_ids", team.MemberIds)
+			_ = d.Set("phone_number", stringOrEmpty(team.PhoneNumber))
+			_ = d.Set("max_members", team.MaxMembers)
+			_ = d.Set("enabled", team.Enabled)
+			_ = d.Set("settings_json", stringOrEmpty(team.SettingsJson))
+			_ = d.Set("edge_id", stringOrEmpty(team.EdgeId))
+			if team.AutoAssignment != nil {
+				_ = d.Set("auto_assignment", []interface{}{map[string]interface{}{
+					"enabled":     team.AutoAssignment.Enabled,
+					"division_id": stringOrEmpty(team.AutoAssignment.DivisionId),
+				}})
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":          {Type: schema.TypeString, Required: true},
+			"description":   {Type: schema.TypeString, Optional: true},
+			"division_id":   {Type: schema.TypeString, Optional: true},
+			"member_ids":    {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"phone_number":  {Type: schema.TypeString, Optional: true},
+			"max_members":   {Type: schema.TypeInt, Optional: true},
+			"enabled":       {Type: schema.TypeBool, Optional: true},
+			"settings_json": {Type: schema.TypeString, Optional: true},
+			"edge_id":       {Type: schema.TypeString, Optional: true, Description: "Edge the team is homed on."},
+			"auto_assignment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled":     {Type: schema.TypeBool, Optional: true},
+						"division_id": {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -382,3 +382,94 @@ func TestUnitMergeExporters(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitSelectDatatableRowsExporter(t *testing.T) {
+	originalExporters := resourceExporter.GetResourceExporters()
+	t.Cleanup(func() { resourceExporter.SetRegisterExporter(originalExporters) })
+
+	registered := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_architect_datatable": {},
+		datatableRowResourceType:           {},
+		datatableRowsResourceType:          {},
+		"genesyscloud_routing_queue":       {},
+	}
+	resourceExporter.SetRegisterExporter(registered)
+
+	testCases := []struct {
+		name               string
+		datatableRowsAsCsv bool
+		filterType         ExporterFilterType
+		filterList         []string
+		expected           []string
+		expectedFilter     []string
+	}{
+		{
+			name:     "per-row resources by default",
+			expected: []string{"genesyscloud_architect_datatable", datatableRowResourceType, "genesyscloud_routing_queue"},
+		},
+		{
+			name:               "csv resources replace per-row resources",
+			datatableRowsAsCsv: true,
+			expected:           []string{"genesyscloud_architect_datatable", datatableRowsResourceType, "genesyscloud_routing_queue"},
+		},
+		{
+			name:               "csv resources replace included per-row resources",
+			datatableRowsAsCsv: true,
+			filterType:         IncludeResources,
+			filterList:         []string{datatableRowResourceType + "::^Customers", "genesyscloud_routing_queue::^Sales"},
+			expected:           []string{datatableRowsResourceType, "genesyscloud_routing_queue"},
+			expectedFilter:     []string{datatableRowsResourceType + "::^Customers", "genesyscloud_routing_queue::^Sales"},
+		},
+		{
+			name:           "per-row name filters kept by default",
+			filterType:     IncludeResources,
+			filterList:     []string{datatableRowResourceType + "::^Customers"},
+			expected:       []string{datatableRowResourceType},
+			expectedFilter: []string{datatableRowResourceType + "::^Customers"},
+		},
+		{
+			name:           "csv resources kept when explicitly included",
+			filterType:     IncludeResources,
+			filterList:     []string{datatableRowsResourceType},
+			expected:       []string{datatableRowsResourceType},
+			expectedFilter: []string{datatableRowsResourceType},
+		},
+		{
+			name:           "csv resources not exported with an exclude filter",
+			filterType:     ExcludeResources,
+			filterList:     []string{"genesyscloud_routing_queue"},
+			expected:       []string{"genesyscloud_architect_datatable", datatableRowResourceType},
+			expectedFilter: []string{"genesyscloud_routing_queue"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := &GenesysCloudResourceExporter{
+				datatableRowsAsCsv: testCase.datatableRowsAsCsv,
+				filterType:         testCase.filterType,
+			}
+			exports := resourceExporter.GetResourceExporters()
+			if testCase.filterList != nil {
+				g.filterList = &testCase.filterList
+				if testCase.filterType == ExcludeResources {
+					exports = ExcludeFilterByResourceType(exports, testCase.filterList)
+				} else {
+					exports = IncludeFilterByResourceType(exports, testCase.filterList)
+				}
+			}
+
+			g.selectDatatableRowsExporter(exports)
+
+			var actual []string
+			for resType := range exports {
+				actual = append(actual, resType)
+			}
+			assert.ElementsMatch(t, testCase.expected, actual)
+
+			if testCase.filterList != nil {
+				assert.Equal(t, testCase.expectedFilter, g.datatableRowsFilter(testCase.filterList))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 
@@ -65,7 +66,8 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		}
 
 		// Resource files
-		for resType, resBlock := range h.resourceTypesHCLBlocks {
+		for _, resType := range h.sortedResourceTypes() {
+			resBlock := h.resourceTypesHCLBlocks[resType]
 			resourceHCLFilePath := filepath.Join(h.dirPath, fmt.Sprintf("%s.%s", resType, resourceHCLFileExt))
 			if resourceHCLFilePath == "" {
 				return diag.Errorf("Failed to create file path %s", resourceHCLFilePath)
@@ -79,8 +81,8 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		allBlockSlice := make([][]byte, 0)
 		allBlockSlice = append(allBlockSlice, providerBlock)
 
-		for _, resBlock := range h.resourceTypesHCLBlocks {
-			allBlockSlice = append(allBlockSlice, resBlock...)
+		for _, resType := range h.sortedResourceTypes() {
+			allBlockSlice = append(allBlockSlice, h.resourceTypesHCLBlocks[resType]...)
 		}
 		allBlockSlice = append(allBlockSlice, variablesBlock)
 
@@ -119,6 +121,16 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	return nil
 }
 
+// sortedResourceTypes returns the exported resource types in alphabetical order so the generated files are stable
+func (h *HCLExporter) sortedResourceTypes() []string {
+	resTypes := make([]string, 0, len(h.resourceTypesHCLBlocks))
+	for resType := range h.resourceTypesHCLBlocks {
+		resTypes = append(resTypes, resType)
+	}
+	sort.Strings(resTypes)
+	return resTypes
+}
+
 // Create the  HCL block for terraform and the genesyscloud provider
 func createHCLProviderBlock(providerSource string, version string) []byte {
 	rootFile := hclwrite.NewEmptyFile()
@@ -211,9 +223,16 @@ func instanceStateToHCLBlock(resType, resName string, json gcloud.JsonMap) []byt
 	return []byte(newCopy)
 }
 
+// addBody adds the attributes in alphabetical order so that the same resource is always written the same way
 func addBody(body *hclwrite.Body, json gcloud.JsonMap) {
-	for k, v := range json {
-		addValue(body, k, v)
+	keys := make([]string, 0, len(json))
+	for k := range json {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		addValue(body, k, json[k])
 	}
 }
 
@@ -281,9 +300,7 @@ func handleInterfaceArray(body *hclwrite.Body, k string, v []interface{}) {
 		// k { ... }
 		if valMap, ok := val.(map[string]interface{}); ok {
 			block := body.AppendNewBlock(k, nil)
-			for key, value := range valMap {
-				addValue(block.Body(), key, value)
-			}
+			addBody(block.Body(), valMap)
 			nestedBlock = true
 			// k = [ ... ]
 		} else {
@@ -111,6 +111,13 @@ func ResourceTfExport() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				ForceNew:    true,
 			},
+			"export_datatable_rows_as_csv": {
+				Description: "Export the rows of each architect datatable to a CSV file under `datatables/` managed by a genesyscloud_architect_datatable_rows resource, instead of one genesyscloud_architect_datatable_row resource per row. Filters on genesyscloud_architect_datatable_row apply to these resources, which are named after their datatable with a `_rows` suffix.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"enable_flow_depends_on": {
 				Description: "Adds a \"depends_on\" attribute to genesyscloud_flow resources with a list of resources that are referenced inside the flow configuration. Currently this functionality is in beta.",
 				Type:        schema.TypeBool,
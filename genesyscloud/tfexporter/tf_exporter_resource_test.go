@@ -4,6 +4,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
 	grammarLanguage "terraform-provider-genesyscloud/genesyscloud/architect_grammar_language"
@@ -18,13 +19,22 @@ import (
 	obCampaign "terraform-provider-genesyscloud/genesyscloud/outbound_campaign"
 	obCampaignRule "terraform-provider-genesyscloud/genesyscloud/outbound_campaignrule"
 	outboundContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
+	obDigitalRuleset "terraform-provider-genesyscloud/genesyscloud/outbound_digitalruleset"
 	obRuleset "terraform-provider-genesyscloud/genesyscloud/outbound_ruleset"
 	obSequence "terraform-provider-genesyscloud/genesyscloud/outbound_sequence"
 	obw "terraform-provider-genesyscloud/genesyscloud/outbound_wrapupcode_mappings"
 	pat "terraform-provider-genesyscloud/genesyscloud/process_automation_trigger"
 	recMediaRetPolicy "terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	routingDirectRoutingSettings "terraform-provider-genesyscloud/genesyscloud/routing_direct_routing_settings"
+	routingMessageAddress "terraform-provider-genesyscloud/genesyscloud/routing_message_address"
+	routingPredictor "terraform-provider-genesyscloud/genesyscloud/routing_predictor"
 	routingSmsAddress "terraform-provider-genesyscloud/genesyscloud/routing_sms_addresses"
+	staDictionaryFeedback "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_dictionaryfeedback"
+	staProgram "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_program"
+	staSentimentFeedback "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_sentimentfeedback"
+	staSettings "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_settings"
+	staTopic "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_topic"
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
 	workitemSchema "terraform-provider-genesyscloud/genesyscloud/task_management_workitem_schema"
 	worktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
@@ -36,6 +46,11 @@ import (
 	edgesTrunk "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_trunk"
 	webdeployConfig "terraform-provider-genesyscloud/genesyscloud/webdeployments_configuration"
 	webdeployDeploy "terraform-provider-genesyscloud/genesyscloud/webdeployments_deployment"
+	wfmActivityCode "terraform-provider-genesyscloud/genesyscloud/wfm_activity_code"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+	wfmManagementUnit "terraform-provider-genesyscloud/genesyscloud/wfm_management_unit"
+	wfmPlanningGroup "terraform-provider-genesyscloud/genesyscloud/wfm_planning_group"
+	wfmServiceGoalTemplate "terraform-provider-genesyscloud/genesyscloud/wfm_service_goal_template"
 
 	"testing"
 
@@ -68,6 +83,7 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_architect_grammar_language"] = grammarLanguage.ResourceArchitectGrammarLanguage()
 	providerResources["genesyscloud_architect_datatable"] = dt.ResourceArchitectDatatable()
 	providerResources["genesyscloud_architect_datatable_row"] = architect_datatable_row.ResourceArchitectDatatableRow()
+	providerResources["genesyscloud_architect_datatable_rows"] = architect_datatable_rows.ResourceArchitectDatatableRows()
 	providerResources["genesyscloud_architect_emergencygroup"] = emergencyGroup.ResourceArchitectEmergencyGroup()
 	providerResources["genesyscloud_flow"] = gcloud.ResourceFlow()
 	providerResources["genesyscloud_flow_milestone"] = flowMilestone.ResourceFlowMilestone()
@@ -140,10 +156,17 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_outbound_sequence"] = obSequence.ResourceOutboundSequence()
 	providerResources["genesyscloud_outbound_dnclist"] = ob.ResourceOutboundDncList()
 	providerResources["genesyscloud_outbound_campaignrule"] = obCampaignRule.ResourceOutboundCampaignrule()
+	providerResources["genesyscloud_outbound_digitalruleset"] = obDigitalRuleset.ResourceOutboundDigitalruleset()
 	providerResources["genesyscloud_outbound_wrapupcodemappings"] = obw.ResourceOutboundWrapUpCodeMappings()
 	providerResources["genesyscloud_quality_forms_survey"] = gcloud.ResourceSurveyForm()
 	providerResources["genesyscloud_responsemanagement_response"] = gcloud.ResourceResponsemanagementResponse()
 	providerResources["genesyscloud_routing_sms_address"] = routingSmsAddress.ResourceRoutingSmsAddress()
+	providerResources["genesyscloud_routing_message_address_open"] = routingMessageAddress.ResourceRoutingMessageAddressOpen()
+	providerResources["genesyscloud_routing_message_address_whatsapp"] = routingMessageAddress.ResourceRoutingMessageAddressWhatsApp()
+	providerResources["genesyscloud_routing_message_address_facebook"] = routingMessageAddress.ResourceRoutingMessageAddressFacebook()
+	providerResources["genesyscloud_routing_message_address_instagram"] = routingMessageAddress.ResourceRoutingMessageAddressInstagram()
+	providerResources["genesyscloud_routing_predictor"] = routingPredictor.ResourceRoutingPredictor()
+	providerResources["genesyscloud_routing_direct_routing_settings"] = routingDirectRoutingSettings.ResourceRoutingDirectRoutingSettings()
 	providerResources["genesyscloud_routing_skill_group"] = gcloud.ResourceRoutingSkillGroup()
 	providerResources["genesyscloud_telephony_providers_edges_did_pool"] = didPool.ResourceTelephonyDidPool()
 
@@ -151,6 +174,17 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_task_management_workitem_schema"] = workitemSchema.ResourceTaskManagementWorkitemSchema()
 	providerResources["genesyscloud_task_management_worktype"] = worktype.ResourceTaskManagementWorktype()
 
+	providerResources["genesyscloud_wfm_business_unit"] = wfmBusinessUnit.ResourceWfmBusinessUnit()
+	providerResources["genesyscloud_wfm_management_unit"] = wfmManagementUnit.ResourceWfmManagementUnit()
+	providerResources["genesyscloud_wfm_activity_code"] = wfmActivityCode.ResourceWfmActivityCode()
+	providerResources["genesyscloud_wfm_service_goal_template"] = wfmServiceGoalTemplate.ResourceWfmServiceGoalTemplate()
+	providerResources["genesyscloud_wfm_planning_group"] = wfmPlanningGroup.ResourceWfmPlanningGroup()
+	providerResources["genesyscloud_speechandtextanalytics_topic"] = staTopic.ResourceSpeechAndTextAnalyticsTopic()
+	providerResources["genesyscloud_speechandtextanalytics_program"] = staProgram.ResourceSpeechAndTextAnalyticsProgram()
+	providerResources["genesyscloud_speechandtextanalytics_dictionaryfeedback"] = staDictionaryFeedback.ResourceSpeechAndTextAnalyticsDictionaryFeedback()
+	providerResources["genesyscloud_speechandtextanalytics_settings"] = staSettings.ResourceSpeechAndTextAnalyticsSettings()
+	providerResources["genesyscloud_speechandtextanalytics_sentimentfeedback"] = staSentimentFeedback.ResourceSpeechAndTextAnalyticsSentimentFeedback()
+
 	providerResources["genesyscloud_tf_export"] = ResourceTfExport()
 }
 
@@ -159,6 +193,7 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter("genesyscloud_architect_grammar_language", grammarLanguage.ArchitectGrammarLanguageExporter())
 	RegisterExporter("genesyscloud_architect_datatable", dt.ArchitectDatatableExporter())
 	RegisterExporter("genesyscloud_architect_datatable_row", architect_datatable_row.ArchitectDatatableRowExporter())
+	RegisterExporter("genesyscloud_architect_datatable_rows", architect_datatable_rows.ArchitectDatatableRowsExporter())
 	RegisterExporter("genesyscloud_architect_emergencygroup", emergencyGroup.ArchitectEmergencyGroupExporter())
 	RegisterExporter("genesyscloud_architect_ivr", archIvr.ArchitectIvrExporter())
 	RegisterExporter("genesyscloud_architect_schedules", gcloud.ArchitectSchedulesExporter())
@@ -216,6 +251,12 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter("genesyscloud_routing_skill", gcloud.RoutingSkillExporter())
 	RegisterExporter("genesyscloud_routing_skill_group", gcloud.ResourceSkillGroupExporter())
 	RegisterExporter("genesyscloud_routing_sms_address", routingSmsAddress.RoutingSmsAddressExporter())
+	RegisterExporter("genesyscloud_routing_message_address_open", routingMessageAddress.RoutingMessageAddressOpenExporter())
+	RegisterExporter("genesyscloud_routing_message_address_whatsapp", routingMessageAddress.RoutingMessageAddressWhatsAppExporter())
+	RegisterExporter("genesyscloud_routing_message_address_facebook", routingMessageAddress.RoutingMessageAddressFacebookExporter())
+	RegisterExporter("genesyscloud_routing_message_address_instagram", routingMessageAddress.RoutingMessageAddressInstagramExporter())
+	RegisterExporter("genesyscloud_routing_predictor", routingPredictor.RoutingPredictorExporter())
+	RegisterExporter("genesyscloud_routing_direct_routing_settings", routingDirectRoutingSettings.RoutingDirectRoutingSettingsExporter())
 	RegisterExporter("genesyscloud_routing_utilization", gcloud.RoutingUtilizationExporter())
 	RegisterExporter("genesyscloud_routing_wrapupcode", gcloud.RoutingWrapupCodeExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_edge_group", edgeGroup.EdgeGroupExporter())
@@ -230,12 +271,23 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter("genesyscloud_webdeployments_deployment", webdeployDeploy.WebDeploymentExporter())
 	RegisterExporter("genesyscloud_webdeployments_configuration", webdeployConfig.WebDeploymentConfigurationExporter())
 	RegisterExporter("genesyscloud_widget_deployment", gcloud.WidgetDeploymentExporter())
+	RegisterExporter("genesyscloud_wfm_business_unit", wfmBusinessUnit.WfmBusinessUnitExporter())
+	RegisterExporter("genesyscloud_wfm_management_unit", wfmManagementUnit.WfmManagementUnitExporter())
+	RegisterExporter("genesyscloud_wfm_activity_code", wfmActivityCode.WfmActivityCodeExporter())
+	RegisterExporter("genesyscloud_wfm_service_goal_template", wfmServiceGoalTemplate.WfmServiceGoalTemplateExporter())
+	RegisterExporter("genesyscloud_wfm_planning_group", wfmPlanningGroup.WfmPlanningGroupExporter())
+	RegisterExporter("genesyscloud_speechandtextanalytics_topic", staTopic.SpeechAndTextAnalyticsTopicExporter())
+	RegisterExporter("genesyscloud_speechandtextanalytics_program", staProgram.SpeechAndTextAnalyticsProgramExporter())
+	RegisterExporter("genesyscloud_speechandtextanalytics_dictionaryfeedback", staDictionaryFeedback.SpeechAndTextAnalyticsDictionaryFeedbackExporter())
+	RegisterExporter("genesyscloud_speechandtextanalytics_settings", staSettings.SpeechAndTextAnalyticsSettingsExporter())
+	RegisterExporter("genesyscloud_speechandtextanalytics_sentimentfeedback", staSentimentFeedback.SpeechAndTextAnalyticsSentimentFeedbackExporter())
 
 	RegisterExporter("genesyscloud_knowledge_document_variation", gcloud.KnowledgeDocumentVariationExporter())
 	RegisterExporter("genesyscloud_knowledge_label", gcloud.KnowledgeLabelExporter())
 
 	RegisterExporter("genesyscloud_processautomation_trigger", pat.ProcessAutomationTriggerExporter())
 	RegisterExporter("genesyscloud_outbound_ruleset", obRuleset.OutboundRulesetExporter())
+	RegisterExporter("genesyscloud_outbound_digitalruleset", obDigitalRuleset.OutboundDigitalrulesetExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_did_pool", didPool.TelephonyDidPoolExporter())
 
 	RegisterExporter("genesyscloud_task_management_workbin", workbin.TaskManagementWorkbinExporter())
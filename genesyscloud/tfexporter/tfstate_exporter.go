@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 
@@ -115,8 +116,15 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 }
 
 func generateTfVarsContent(vars map[string]interface{}) string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	tfVarsContent := ""
-	for k, v := range vars {
+	for _, k := range keys {
+		v := vars[k]
 		vStr := v
 		if v == nil {
 			vStr = "null"
@@ -0,0 +1,449 @@
+package user_bulk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_user_bulk.go contains all of the methods that perform the core logic for a resource.
+*/
+
+func createUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+
+	log.Printf("Creating user bulk %s", d.Id())
+	if diagErr := applyUserBulk(ctx, d, meta); diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Created user bulk %s", d.Id())
+	return readUserBulk(ctx, d, meta)
+}
+
+// readUserBulk looks up the tracked users. Users deleted outside of Terraform are dropped from user_ids, and
+// customizeUserBulkDiff then plans an update that creates them again.
+func readUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserBulkProxy(sdkConfig)
+
+	log.Printf("Reading user bulk %s", d.Id())
+
+	trackedIds := make([]string, 0)
+	for _, id := range d.Get("user_ids").(map[string]interface{}) {
+		trackedIds = append(trackedIds, id.(string))
+	}
+	users, _, err := proxy.getUsersByIds(ctx, trackedIds)
+	if err != nil {
+		return diag.Errorf("Failed to read users of user bulk %s: %s", d.Id(), err)
+	}
+	existingIds := make(map[string]bool)
+	for _, user := range *users {
+		existingIds[*user.Id] = true
+	}
+
+	userIds := make(map[string]interface{})
+	for email, id := range d.Get("user_ids").(map[string]interface{}) {
+		if existingIds[id.(string)] {
+			userIds[email] = id
+		}
+	}
+	_ = d.Set("user_ids", userIds)
+
+	createdUserIds := make([]interface{}, 0)
+	for _, id := range d.Get("created_user_ids").(*schema.Set).List() {
+		if existingIds[id.(string)] {
+			createdUserIds = append(createdUserIds, id)
+		}
+	}
+	_ = d.Set("created_user_ids", createdUserIds)
+
+	log.Printf("Read %d users of user bulk %s", len(userIds), d.Id())
+	return nil
+}
+
+// customizeUserBulkDiff plans an update when a row of the users file has no user ID in user_ids. This happens when a
+// user was deleted outside of Terraform or could not be applied, so that the next apply creates or applies it again.
+func customizeUserBulkDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown("users_filepath") {
+		return nil
+	}
+
+	usersFilepath := diff.Get("users_filepath").(string)
+	reader, file, err := files.DownloadOrOpenFile(usersFilepath)
+	if err != nil {
+		return fmt.Errorf("failed to open users file %s: %s", usersFilepath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	users, err := parseUsersFile(reader, isJsonUsersFile(usersFilepath))
+	if err != nil {
+		return fmt.Errorf("invalid users file %s: %s", usersFilepath, err)
+	}
+
+	managedEmails := make(map[string]bool)
+	for email := range diff.Get("user_ids").(map[string]interface{}) {
+		managedEmails[strings.ToLower(email)] = true
+	}
+	for _, user := range users {
+		if !managedEmails[strings.ToLower(user.email)] {
+			log.Printf("User %s of user bulk %s has no user ID and will be applied", user.email, diff.Id())
+			if err := diff.SetNewComputed("user_ids"); err != nil {
+				return err
+			}
+			return diff.SetNewComputed("created_user_ids")
+		}
+	}
+	return nil
+}
+
+func updateUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating user bulk %s", d.Id())
+	if diagErr := applyUserBulk(ctx, d, meta); diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated user bulk %s", d.Id())
+	return readUserBulk(ctx, d, meta)
+}
+
+// deleteUserBulk deletes the users created by the resource. Existing users that were matched by email are left in the org.
+func deleteUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserBulkProxy(sdkConfig)
+
+	createdUserIds := d.Get("created_user_ids").(*schema.Set)
+	var diagErr diag.Diagnostics
+	remaining := make(map[string]interface{})
+	remainingCreatedIds := make([]interface{}, 0)
+	for email, id := range d.Get("user_ids").(map[string]interface{}) {
+		if !createdUserIds.Contains(id) {
+			log.Printf("Leaving user %s of user bulk %s as it was not created by the resource", email, d.Id())
+			continue
+		}
+		log.Printf("Deleting user %s of user bulk %s", email, d.Id())
+		resp, err := proxy.deleteUser(ctx, id.(string))
+		if err != nil && !gcloud.IsStatus404(resp) {
+			diagErr = append(diagErr, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to delete user %s", email),
+				Detail:   err.Error(),
+			})
+			remaining[email] = id
+			remainingCreatedIds = append(remainingCreatedIds, id)
+		}
+	}
+
+	if diagErr.HasError() {
+		_ = d.Set("user_ids", remaining)
+		_ = d.Set("created_user_ids", remainingCreatedIds)
+		return diagErr
+	}
+	log.Printf("Deleted user bulk %s", d.Id())
+	return nil
+}
+
+// applyUserBulk compares the users file with the users of the org and applies the changes. A failure on one user is
+// reported as a diagnostic for its row of the file and does not stop the other users. The IDs of the managed users are
+// saved even when some users fail. Users created by the resource are tracked separately from the existing users that
+// were matched by email, as only the created users are deleted.
+func applyUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserBulkProxy(sdkConfig)
+
+	usersFilepath := d.Get("users_filepath").(string)
+
+	reader, file, err := files.DownloadOrOpenFile(usersFilepath)
+	if err != nil {
+		return diag.Errorf("Failed to open users file %s: %s", usersFilepath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	users, err := parseUsersFile(reader, isJsonUsersFile(usersFilepath))
+	if err != nil {
+		return diag.Errorf("Invalid users file %s: %s", usersFilepath, err)
+	}
+
+	orgUsers, _, err := proxy.getAllUsers(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to get users: %s", err)
+	}
+	orgUsersByEmail := make(map[string]platformclientv2.User)
+	for _, user := range *orgUsers {
+		if user.Email != nil {
+			orgUsersByEmail[strings.ToLower(*user.Email)] = user
+		}
+	}
+
+	homeDivisionId := ""
+	if needsHomeDivision(users) {
+		if homeDivisionId, _, err = proxy.getHomeDivisionId(ctx); err != nil {
+			return diag.Errorf("Failed to get home division: %s", err)
+		}
+	}
+
+	var diagErr diag.Diagnostics
+	previousIds := d.Get("user_ids").(map[string]interface{})
+	previousCreatedIds := d.Get("created_user_ids").(*schema.Set)
+	userIds := make(map[string]interface{})
+	createdUserIds := make([]interface{}, 0)
+	fileEmails := make(map[string]bool)
+	queueChanges := newQueueMemberChanges()
+	usersById := make(map[string]bulkUser)
+
+	for _, user := range users {
+		fileEmails[strings.ToLower(user.email)] = true
+
+		var current *platformclientv2.User
+		if orgUser, ok := orgUsersByEmail[strings.ToLower(user.email)]; ok {
+			current = &orgUser
+		}
+
+		userId, err := applyBulkUser(ctx, proxy, user, current, homeDivisionId, queueChanges)
+		if userId != "" {
+			userIds[user.email] = userId
+			usersById[userId] = user
+			if current == nil || previousCreatedIds.Contains(userId) {
+				createdUserIds = append(createdUserIds, userId)
+			}
+		}
+		if err != nil {
+			diagErr = append(diagErr, buildRowDiagnostic(user, err))
+		}
+	}
+
+	diagErr = append(diagErr, applyQueueMemberChanges(ctx, proxy, queueChanges, usersById)...)
+
+	// Users that were created by this resource but are no longer in the file are deleted. Existing users are only no
+	// longer managed.
+	for email, id := range previousIds {
+		if fileEmails[strings.ToLower(email)] {
+			continue
+		}
+		if !previousCreatedIds.Contains(id) {
+			log.Printf("No longer managing user %s removed from users file %s", email, usersFilepath)
+			continue
+		}
+		log.Printf("Deleting user %s removed from users file %s", email, usersFilepath)
+		resp, err := proxy.deleteUser(ctx, id.(string))
+		if err != nil && !gcloud.IsStatus404(resp) {
+			diagErr = append(diagErr, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to delete user %s", email),
+				Detail:   err.Error(),
+			})
+			userIds[email] = id
+			createdUserIds = append(createdUserIds, id)
+		}
+	}
+
+	_ = d.Set("user_ids", userIds)
+	_ = d.Set("created_user_ids", createdUserIds)
+	return diagErr
+}
+
+// applyBulkUser creates or updates a single user and its skills, languages and roles. Queue membership changes are
+// collected in queueChanges so that they can be sent per queue. The ID of the user is returned as soon as it is known.
+func applyBulkUser(ctx context.Context, proxy *userBulkProxy, user bulkUser, current *platformclientv2.User, homeDivisionId string, queueChanges *queueMemberChanges) (string, error) {
+	var userId string
+	isNew := current == nil
+
+	if isNew {
+		if user.name == "" {
+			return "", fmt.Errorf("name is required to create a user")
+		}
+		createUser := platformclientv2.Createuser{
+			Email: platformclientv2.String(user.email),
+			Name:  platformclientv2.String(user.name),
+			State: platformclientv2.String("active"),
+		}
+		if user.divisionId != "" {
+			createUser.DivisionId = platformclientv2.String(user.divisionId)
+		}
+		if user.department != "" {
+			createUser.Department = platformclientv2.String(user.department)
+		}
+		if user.title != "" {
+			createUser.Title = platformclientv2.String(user.title)
+		}
+
+		log.Printf("Creating user %s", user.email)
+		createdUser, _, err := proxy.createUser(ctx, &createUser)
+		if err != nil {
+			return "", err
+		}
+		userId = *createdUser.Id
+	} else {
+		userId = *current.Id
+		if update := buildUserUpdate(user, *current); update != nil {
+			log.Printf("Updating user %s", user.email)
+			if _, _, err := proxy.updateUser(ctx, userId, update); err != nil {
+				return userId, err
+			}
+		}
+	}
+
+	if user.skills != nil {
+		if err := applyUserSkills(ctx, proxy, userId, user.skills, isNew); err != nil {
+			return userId, err
+		}
+	}
+	if user.languages != nil {
+		if err := applyUserLanguages(ctx, proxy, userId, user.languages, isNew); err != nil {
+			return userId, err
+		}
+	}
+	if user.roles != nil {
+		if err := applyUserRoles(ctx, proxy, userId, user.roles, homeDivisionId); err != nil {
+			return userId, err
+		}
+	}
+	if user.queueIds != nil {
+		var currentQueueIds []string
+		if !isNew {
+			var err error
+			if currentQueueIds, _, err = proxy.getUserQueueIds(ctx, userId); err != nil {
+				return userId, err
+			}
+		}
+		adds, removes := diffIds(user.queueIds, currentQueueIds)
+		for _, queueId := range adds {
+			queueChanges.adds[queueId] = append(queueChanges.adds[queueId], userId)
+		}
+		for _, queueId := range removes {
+			queueChanges.removes[queueId] = append(queueChanges.removes[queueId], userId)
+		}
+	}
+	return userId, nil
+}
+
+func applyUserSkills(ctx context.Context, proxy *userBulkProxy, userId string, desired map[string]float64, isNew bool) error {
+	current := make(map[string]float64)
+	if !isNew {
+		skills, _, err := proxy.getUserSkills(ctx, userId)
+		if err != nil {
+			return err
+		}
+		current = skillProficiencies(*skills)
+	}
+
+	upserts, removes := diffProficiencies(desired, current)
+	for _, skillId := range removes {
+		if resp, err := proxy.deleteUserSkill(ctx, userId, skillId); err != nil && !gcloud.IsStatus404(resp) {
+			return err
+		}
+	}
+	for _, chunk := range chunks.ChunkBy(upserts, maxUserRoutingEntriesPerRequest) {
+		skills := make([]platformclientv2.Userroutingskillpost, 0, len(chunk))
+		for _, skillId := range chunk {
+			skills = append(skills, platformclientv2.Userroutingskillpost{
+				Id:          platformclientv2.String(skillId),
+				Proficiency: platformclientv2.Float64(desired[skillId]),
+			})
+		}
+		if _, err := proxy.patchUserSkills(ctx, userId, skills); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyUserLanguages(ctx context.Context, proxy *userBulkProxy, userId string, desired map[string]float64, isNew bool) error {
+	current := make(map[string]float64)
+	if !isNew {
+		languages, _, err := proxy.getUserLanguages(ctx, userId)
+		if err != nil {
+			return err
+		}
+		current = languageProficiencies(*languages)
+	}
+
+	upserts, removes := diffProficiencies(desired, current)
+	for _, languageId := range removes {
+		if resp, err := proxy.deleteUserLanguage(ctx, userId, languageId); err != nil && !gcloud.IsStatus404(resp) {
+			return err
+		}
+	}
+	for _, chunk := range chunks.ChunkBy(upserts, maxUserRoutingEntriesPerRequest) {
+		languages := make([]platformclientv2.Userroutinglanguagepost, 0, len(chunk))
+		for _, languageId := range chunk {
+			languages = append(languages, platformclientv2.Userroutinglanguagepost{
+				Id:          platformclientv2.String(languageId),
+				Proficiency: platformclientv2.Float64(desired[languageId]),
+			})
+		}
+		if _, err := proxy.patchUserLanguages(ctx, userId, languages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyUserRoles grants and removes roles of a user. New users are given default roles by Genesys Cloud, so the
+// current grants are always read.
+func applyUserRoles(ctx context.Context, proxy *userBulkProxy, userId string, desired []roleDivision, homeDivisionId string) error {
+	grants, _, err := proxy.getUserGrants(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	adds, removes := diffRoles(desired, *grants, homeDivisionId)
+	for _, role := range removes {
+		if resp, err := proxy.deleteUserGrant(ctx, userId, role.divisionId, role.roleId); err != nil && !gcloud.IsStatus404(resp) {
+			return err
+		}
+	}
+	if len(adds) > 0 {
+		if _, err := proxy.addUserGrants(ctx, userId, buildRoleDivisionGrants(adds)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyQueueMemberChanges adds and removes the members of each queue in chunks. A failed chunk is reported for every
+// user in it.
+func applyQueueMemberChanges(ctx context.Context, proxy *userBulkProxy, queueChanges *queueMemberChanges, usersById map[string]bulkUser) diag.Diagnostics {
+	var diagErr diag.Diagnostics
+
+	update := func(changes map[string][]string, remove bool) {
+		for _, queueId := range sortedKeys(changes) {
+			for _, chunk := range chunks.ChunkBy(changes[queueId], maxQueueMembersPerRequest) {
+				log.Printf("Updating %d members of queue %s", len(chunk), queueId)
+				if _, err := proxy.updateQueueMembers(ctx, queueId, buildWritableEntities(chunk), remove); err != nil {
+					for _, userId := range chunk {
+						diagErr = append(diagErr, buildRowDiagnostic(usersById[userId], err))
+					}
+				}
+			}
+		}
+	}
+	update(queueChanges.removes, true)
+	update(queueChanges.adds, false)
+
+	return diagErr
+}
+
+// buildRowDiagnostic reports an error on a user with its location in the users file
+func buildRowDiagnostic(user bulkUser, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Failed to apply %s (%s)", user.location, user.email),
+		Detail:   err.Error(),
+	}
+}
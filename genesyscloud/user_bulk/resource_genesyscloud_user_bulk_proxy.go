@@ -0,0 +1,440 @@
+package user_bulk
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_user_bulk_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *userBulkProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllUsersFunc func(ctx context.Context, p *userBulkProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getUserFunc func(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type getUsersByIdsFunc func(ctx context.Context, p *userBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type createUserFunc func(ctx context.Context, p *userBulkProxy, user *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type updateUserFunc func(ctx context.Context, p *userBulkProxy, userId string, user *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type deleteUserFunc func(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.APIResponse, error)
+type getUserSkillsFunc func(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error)
+type patchUserSkillsFunc func(ctx context.Context, p *userBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error)
+type deleteUserSkillFunc func(ctx context.Context, p *userBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error)
+type getUserLanguagesFunc func(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Userroutinglanguage, *platformclientv2.APIResponse, error)
+type patchUserLanguagesFunc func(ctx context.Context, p *userBulkProxy, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error)
+type deleteUserLanguageFunc func(ctx context.Context, p *userBulkProxy, userId string, languageId string) (*platformclientv2.APIResponse, error)
+type getUserGrantsFunc func(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
+type addUserGrantsFunc func(ctx context.Context, p *userBulkProxy, userId string, grants platformclientv2.Roledivisiongrants) (*platformclientv2.APIResponse, error)
+type deleteUserGrantFunc func(ctx context.Context, p *userBulkProxy, userId string, divisionId string, roleId string) (*platformclientv2.APIResponse, error)
+type getUserQueueIdsFunc func(ctx context.Context, p *userBulkProxy, userId string) ([]string, *platformclientv2.APIResponse, error)
+type updateQueueMembersFunc func(ctx context.Context, p *userBulkProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error)
+type getHomeDivisionIdFunc func(ctx context.Context, p *userBulkProxy) (string, *platformclientv2.APIResponse, error)
+
+// userBulkProxy contains all of the methods that call genesys cloud APIs.
+type userBulkProxy struct {
+	clientConfig           *platformclientv2.Configuration
+	usersApi               *platformclientv2.UsersApi
+	routingApi             *platformclientv2.RoutingApi
+	authorizationApi       *platformclientv2.AuthorizationApi
+	getAllUsersAttr        getAllUsersFunc
+	getUserAttr            getUserFunc
+	getUsersByIdsAttr      getUsersByIdsFunc
+	createUserAttr         createUserFunc
+	updateUserAttr         updateUserFunc
+	deleteUserAttr         deleteUserFunc
+	getUserSkillsAttr      getUserSkillsFunc
+	patchUserSkillsAttr    patchUserSkillsFunc
+	deleteUserSkillAttr    deleteUserSkillFunc
+	getUserLanguagesAttr   getUserLanguagesFunc
+	patchUserLanguagesAttr patchUserLanguagesFunc
+	deleteUserLanguageAttr deleteUserLanguageFunc
+	getUserGrantsAttr      getUserGrantsFunc
+	addUserGrantsAttr      addUserGrantsFunc
+	deleteUserGrantAttr    deleteUserGrantFunc
+	getUserQueueIdsAttr    getUserQueueIdsFunc
+	updateQueueMembersAttr updateQueueMembersFunc
+	getHomeDivisionIdAttr  getHomeDivisionIdFunc
+}
+
+// newUserBulkProxy initializes the user bulk proxy with all of the data needed to communicate with Genesys Cloud
+func newUserBulkProxy(clientConfig *platformclientv2.Configuration) *userBulkProxy {
+	return &userBulkProxy{
+		clientConfig:           clientConfig,
+		usersApi:               platformclientv2.NewUsersApiWithConfig(clientConfig),
+		routingApi:             platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		authorizationApi:       platformclientv2.NewAuthorizationApiWithConfig(clientConfig),
+		getAllUsersAttr:        getAllUsersFn,
+		getUserAttr:            getUserFn,
+		getUsersByIdsAttr:      getUsersByIdsFn,
+		createUserAttr:         createUserFn,
+		updateUserAttr:         updateUserFn,
+		deleteUserAttr:         deleteUserFn,
+		getUserSkillsAttr:      getUserSkillsFn,
+		patchUserSkillsAttr:    patchUserSkillsFn,
+		deleteUserSkillAttr:    deleteUserSkillFn,
+		getUserLanguagesAttr:   getUserLanguagesFn,
+		patchUserLanguagesAttr: patchUserLanguagesFn,
+		deleteUserLanguageAttr: deleteUserLanguageFn,
+		getUserGrantsAttr:      getUserGrantsFn,
+		addUserGrantsAttr:      addUserGrantsFn,
+		deleteUserGrantAttr:    deleteUserGrantFn,
+		getUserQueueIdsAttr:    getUserQueueIdsFn,
+		updateQueueMembersAttr: updateQueueMembersFn,
+		getHomeDivisionIdAttr:  getHomeDivisionIdFn,
+	}
+}
+
+// getUserBulkProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getUserBulkProxy(clientConfig *platformclientv2.Configuration) *userBulkProxy {
+	if internalProxy == nil {
+		internalProxy = newUserBulkProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllUsers retrieves all active users in the org
+func (p *userBulkProxy) getAllUsers(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getAllUsersAttr(ctx, p)
+}
+
+// getUser retrieves a user by id
+func (p *userBulkProxy) getUser(ctx context.Context, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUserAttr(ctx, p, userId)
+}
+
+// getUsersByIds retrieves the active users with the given ids
+func (p *userBulkProxy) getUsersByIds(ctx context.Context, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUsersByIdsAttr(ctx, p, userIds)
+}
+
+// createUser creates a user
+func (p *userBulkProxy) createUser(ctx context.Context, user *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.createUserAttr(ctx, p, user)
+}
+
+// updateUser updates the profile of a user
+func (p *userBulkProxy) updateUser(ctx context.Context, userId string, user *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.updateUserAttr(ctx, p, userId, user)
+}
+
+// deleteUser deletes a user
+func (p *userBulkProxy) deleteUser(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserAttr(ctx, p, userId)
+}
+
+// getUserSkills retrieves the routing skills of a user
+func (p *userBulkProxy) getUserSkills(ctx context.Context, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+	return p.getUserSkillsAttr(ctx, p, userId)
+}
+
+// patchUserSkills adds or updates routing skills of a user
+func (p *userBulkProxy) patchUserSkills(ctx context.Context, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	return p.patchUserSkillsAttr(ctx, p, userId, skills)
+}
+
+// deleteUserSkill removes a routing skill from a user
+func (p *userBulkProxy) deleteUserSkill(ctx context.Context, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserSkillAttr(ctx, p, userId, skillId)
+}
+
+// getUserLanguages retrieves the routing languages of a user
+func (p *userBulkProxy) getUserLanguages(ctx context.Context, userId string) (*[]platformclientv2.Userroutinglanguage, *platformclientv2.APIResponse, error) {
+	return p.getUserLanguagesAttr(ctx, p, userId)
+}
+
+// patchUserLanguages adds or updates routing languages of a user
+func (p *userBulkProxy) patchUserLanguages(ctx context.Context, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error) {
+	return p.patchUserLanguagesAttr(ctx, p, userId, languages)
+}
+
+// deleteUserLanguage removes a routing language from a user
+func (p *userBulkProxy) deleteUserLanguage(ctx context.Context, userId string, languageId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserLanguageAttr(ctx, p, userId, languageId)
+}
+
+// getUserGrants retrieves the role grants of a user
+func (p *userBulkProxy) getUserGrants(ctx context.Context, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	return p.getUserGrantsAttr(ctx, p, userId)
+}
+
+// addUserGrants grants roles to a user
+func (p *userBulkProxy) addUserGrants(ctx context.Context, userId string, grants platformclientv2.Roledivisiongrants) (*platformclientv2.APIResponse, error) {
+	return p.addUserGrantsAttr(ctx, p, userId, grants)
+}
+
+// deleteUserGrant removes a role grant from a user
+func (p *userBulkProxy) deleteUserGrant(ctx context.Context, userId string, divisionId string, roleId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserGrantAttr(ctx, p, userId, divisionId, roleId)
+}
+
+// getUserQueueIds retrieves the ids of the queues a user is a member of
+func (p *userBulkProxy) getUserQueueIds(ctx context.Context, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getUserQueueIdsAttr(ctx, p, userId)
+}
+
+// updateQueueMembers adds or removes members of a queue
+func (p *userBulkProxy) updateQueueMembers(ctx context.Context, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	return p.updateQueueMembersAttr(ctx, p, queueId, members, remove)
+}
+
+// getHomeDivisionId retrieves the id of the home division
+func (p *userBulkProxy) getHomeDivisionId(ctx context.Context) (string, *platformclientv2.APIResponse, error) {
+	return p.getHomeDivisionIdAttr(ctx, p)
+}
+
+// getAllUsersFn is the implementation for retrieving all active users in Genesys Cloud
+func getAllUsersFn(ctx context.Context, p *userBulkProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	var allUsers []platformclientv2.User
+	const pageSize = 500
+
+	users, resp, err := p.usersApi.GetUsers(pageSize, 1, nil, nil, "", nil, "", "active")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get users: %s", err)
+	}
+	if users.Entities == nil || len(*users.Entities) == 0 {
+		return &allUsers, resp, nil
+	}
+	allUsers = append(allUsers, *users.Entities...)
+
+	for pageNum := 2; users.PageCount != nil && pageNum <= *users.PageCount; pageNum++ {
+		page, resp, err := p.usersApi.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", "active")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get users: %s", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allUsers = append(allUsers, *page.Entities...)
+	}
+
+	return &allUsers, resp, nil
+}
+
+// getUserFn is the implementation for retrieving a user by id
+func getUserFn(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	user, resp, err := p.usersApi.GetUser(userId, nil, "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get user %s: %s", userId, err)
+	}
+	return user, resp, nil
+}
+
+// getUsersByIdsFn is the implementation for retrieving the active users with the given ids. Users that were deleted are
+// not returned.
+func getUsersByIdsFn(ctx context.Context, p *userBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	users := make([]platformclientv2.User, 0)
+	var resp *platformclientv2.APIResponse
+
+	for _, chunk := range chunks.ChunkBy(userIds, maxUsersPerRequest) {
+		page, chunkResp, err := p.usersApi.GetUsers(maxUsersPerRequest, 1, chunk, nil, "", nil, "", "active")
+		resp = chunkResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get users: %s", err)
+		}
+		if page.Entities != nil {
+			users = append(users, *page.Entities...)
+		}
+	}
+
+	return &users, resp, nil
+}
+
+// createUserFn is the implementation for creating a user
+func createUserFn(ctx context.Context, p *userBulkProxy, user *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	createdUser, resp, err := p.usersApi.PostUsers(*user)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create user %s: %s", *user.Email, err)
+	}
+	return createdUser, resp, nil
+}
+
+// updateUserFn is the implementation for updating a user. The current version of the user is read first.
+func updateUserFn(ctx context.Context, p *userBulkProxy, userId string, user *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	currentUser, resp, err := getUserFn(ctx, p, userId)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	user.Version = currentUser.Version
+	updatedUser, resp, err := p.usersApi.PatchUser(userId, *user)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update user %s: %s", userId, err)
+	}
+	return updatedUser, resp, nil
+}
+
+// deleteUserFn is the implementation for deleting a user
+func deleteUserFn(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.DeleteUser(userId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete user %s: %s", userId, err)
+	}
+	return resp, nil
+}
+
+// getUserSkillsFn is the implementation for retrieving the routing skills of a user
+func getUserSkillsFn(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+	var skills []platformclientv2.Userroutingskill
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		skillListing, resp, err := p.usersApi.GetUserRoutingskills(userId, pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get skills of user %s: %s", userId, err)
+		}
+		if skillListing.Entities == nil || len(*skillListing.Entities) == 0 {
+			return &skills, resp, nil
+		}
+		skills = append(skills, *skillListing.Entities...)
+		if skillListing.PageCount == nil || pageNum >= *skillListing.PageCount {
+			return &skills, resp, nil
+		}
+	}
+}
+
+// patchUserSkillsFn is the implementation for adding or updating routing skills of a user
+func patchUserSkillsFn(ctx context.Context, p *userBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUserRoutingskillsBulk(userId, skills)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update skills of user %s: %s", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserSkillFn is the implementation for removing a routing skill from a user
+func deleteUserSkillFn(ctx context.Context, p *userBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.usersApi.DeleteUserRoutingskill(userId, skillId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove skill %s from user %s: %s", skillId, userId, err)
+	}
+	return resp, nil
+}
+
+// getUserLanguagesFn is the implementation for retrieving the routing languages of a user
+func getUserLanguagesFn(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Userroutinglanguage, *platformclientv2.APIResponse, error) {
+	var languages []platformclientv2.Userroutinglanguage
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		languageListing, resp, err := p.usersApi.GetUserRoutinglanguages(userId, pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get languages of user %s: %s", userId, err)
+		}
+		if languageListing.Entities == nil || len(*languageListing.Entities) == 0 {
+			return &languages, resp, nil
+		}
+		languages = append(languages, *languageListing.Entities...)
+		if languageListing.PageCount == nil || pageNum >= *languageListing.PageCount {
+			return &languages, resp, nil
+		}
+	}
+}
+
+// patchUserLanguagesFn is the implementation for adding or updating routing languages of a user
+func patchUserLanguagesFn(ctx context.Context, p *userBulkProxy, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUserRoutinglanguagesBulk(userId, languages)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update languages of user %s: %s", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserLanguageFn is the implementation for removing a routing language from a user
+func deleteUserLanguageFn(ctx context.Context, p *userBulkProxy, userId string, languageId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.usersApi.DeleteUserRoutinglanguage(userId, languageId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove language %s from user %s: %s", languageId, userId, err)
+	}
+	return resp, nil
+}
+
+// getUserGrantsFn is the implementation for retrieving the role grants of a user
+func getUserGrantsFn(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(userId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get roles of user %s: %s", userId, err)
+	}
+
+	var grants []platformclientv2.Authzgrant
+	if subject.Grants != nil {
+		for _, grant := range *subject.Grants {
+			// Grants inherited from groups are not managed here
+			if grant.SubjectId != nil && *grant.SubjectId == userId {
+				grants = append(grants, grant)
+			}
+		}
+	}
+	return &grants, resp, nil
+}
+
+// addUserGrantsFn is the implementation for granting roles to a user
+func addUserGrantsFn(ctx context.Context, p *userBulkProxy, userId string, grants platformclientv2.Roledivisiongrants) (*platformclientv2.APIResponse, error) {
+	resp, err := p.authorizationApi.PostAuthorizationSubjectBulkadd(userId, grants, "PC_USER")
+	if err != nil {
+		return resp, fmt.Errorf("failed to add roles to user %s: %s", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserGrantFn is the implementation for removing a role grant from a user
+func deleteUserGrantFn(ctx context.Context, p *userBulkProxy, userId string, divisionId string, roleId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.authorizationApi.DeleteAuthorizationSubjectDivisionRole(userId, divisionId, roleId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove role %s in division %s from user %s: %s", roleId, divisionId, userId, err)
+	}
+	return resp, nil
+}
+
+// getUserQueueIdsFn is the implementation for retrieving the queues a user is a member of. The API lists joined and
+// unjoined queues separately, so both are read.
+func getUserQueueIdsFn(ctx context.Context, p *userBulkProxy, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	var queueIds []string
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for _, joined := range []bool{true, false} {
+		for pageNum := 1; ; pageNum++ {
+			queues, queuesResp, err := p.usersApi.GetUserQueues(userId, pageSize, pageNum, joined, nil)
+			resp = queuesResp
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get queues of user %s: %s", userId, err)
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				break
+			}
+			for _, queue := range *queues.Entities {
+				queueIds = append(queueIds, *queue.Id)
+			}
+			if queues.PageCount == nil || pageNum >= *queues.PageCount {
+				break
+			}
+		}
+	}
+	return queueIds, resp, nil
+}
+
+// updateQueueMembersFn is the implementation for adding or removing members of a queue
+func updateQueueMembersFn(ctx context.Context, p *userBulkProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.PostRoutingQueueMembers(queueId, members, remove)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update members of queue %s: %s", queueId, err)
+	}
+	return resp, nil
+}
+
+// getHomeDivisionIdFn is the implementation for retrieving the id of the home division
+func getHomeDivisionIdFn(ctx context.Context, p *userBulkProxy) (string, *platformclientv2.APIResponse, error) {
+	homeDivision, resp, err := p.authorizationApi.GetAuthorizationDivisionsHome()
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to get home division: %s", err)
+	}
+	return *homeDivision.Id, resp, nil
+}
@@ -0,0 +1,64 @@
+package user_bulk
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+Defines the resource schema for the user_bulk package. Unlike genesyscloud_user, which manages a single user, this
+resource manages many users, along with their skills, languages, roles and queue memberships, from one CSV or JSON file.
+*/
+const resourceName = "genesyscloud_user_bulk"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceUserBulk())
+	//No Datasource defined
+	//No Exporter defined
+}
+
+// ResourceUserBulk returns the resource schema definition
+func ResourceUserBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Bulk. Creates and updates many users from a CSV or JSON file and manages their routing skills, routing languages, roles and queue memberships.
+Users are matched with the users of the org by email and only the changes are sent to Genesys Cloud, using the bulk endpoints in chunks. A failure on one user does not stop the others; each failure is reported with the row of the file it came from.
+A CSV file has a header row with the columns email (required), name, division_id, department, title, skills, languages, roles and queues. Skills and languages are lists of id:proficiency, roles are lists of roleId or roleId:divisionId and queues are lists of queue IDs, all separated by semicolons. An empty cell leaves that attribute of the user unmanaged.
+A JSON file (with a .json extension) holds an array of objects with the fields email, name, division_id, department, title, skills (skill_id, proficiency), languages (language_id, proficiency), roles (role_id, division_ids) and queue_ids. An absent field leaves that attribute unmanaged and an empty array removes every value.
+Roles without a division are granted in the home division. Users created by this resource are deleted when they are removed from the file or the resource is destroyed. Existing users that were matched by email are never deleted; they are only no longer managed. A user in the file without a user ID, for example because it was deleted outside of Terraform, is applied again on the next apply. Do not manage the same users with genesyscloud_user.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createUserBulk),
+		ReadContext:   gcloud.ReadWithPooledClient(readUserBulk),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateUserBulk),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteUserBulk),
+		CustomizeDiff: customizeUserBulkDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"users_filepath": {
+				Description:  "Path to the CSV or JSON file containing the users. Files with a .json extension are read as JSON.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: gcloud.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the users file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_ids": {
+				Description: "Map of the email of each managed user to its ID.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_user_ids": {
+				Description: "IDs of the users created by this resource. Only these users are deleted when they are removed from the file or the resource is destroyed.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
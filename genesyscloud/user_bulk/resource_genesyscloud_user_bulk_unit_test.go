@@ -0,0 +1,339 @@
+package user_bulk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseUsersCsv(t *testing.T) {
+	testCases := []struct {
+		name        string
+		csv         string
+		expected    []bulkUser
+		expectedErr string
+	}{
+		{
+			name: "parses lists and leaves empty cells unmanaged",
+			csv:  "email,name,skills,languages,roles,queues\njohn@example.com,John,skill-1:3;skill-2:4.5,lang-1:2,role-1;role-2:div-1,queue-1;queue-2\njane@example.com,Jane,,,,\n",
+			expected: []bulkUser{
+				{
+					location:  "row 2",
+					email:     "john@example.com",
+					name:      "John",
+					skills:    map[string]float64{"skill-1": 3, "skill-2": 4.5},
+					languages: map[string]float64{"lang-1": 2},
+					roles:     []roleDivision{{roleId: "role-1"}, {roleId: "role-2", divisionId: "div-1"}},
+					queueIds:  []string{"queue-1", "queue-2"},
+				},
+				{location: "row 3", email: "jane@example.com", name: "Jane"},
+			},
+		},
+		{
+			name: "accepts any column order and case",
+			csv:  "Title,EMAIL,Department,Division_Id\nAgent,john@example.com,Sales,div-1\n",
+			expected: []bulkUser{
+				{location: "row 2", email: "john@example.com", title: "Agent", department: "Sales", divisionId: "div-1"},
+			},
+		},
+		{name: "empty file", csv: "", expectedErr: "file is empty"},
+		{name: "missing email column", csv: "name\nJohn\n", expectedErr: "header does not contain the email column"},
+		{name: "unknown column", csv: "email,phone\njohn@example.com,555\n", expectedErr: "unknown column phone"},
+		{name: "empty email", csv: "email,name\n,John\n", expectedErr: "row 2: email is empty"},
+		{name: "duplicate email", csv: "email\njohn@example.com\nJOHN@example.com\n", expectedErr: "row 3: duplicate email JOHN@example.com"},
+		{name: "skill without proficiency", csv: "email,skills\njohn@example.com,skill-1\n", expectedErr: "row 2: invalid skills: skill-1 is not in the form id:proficiency"},
+		{name: "proficiency out of range", csv: "email,languages\njohn@example.com,lang-1:6\n", expectedErr: "row 2: invalid languages: lang-1: proficiency 6 must be between 0 and 5"},
+		{name: "role without id", csv: "email,roles\njohn@example.com,:div-1\n", expectedErr: "row 2: invalid roles: :div-1 has no role ID"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			users, err := parseUsersFile(strings.NewReader(testCase.csv), false)
+			if testCase.expectedErr != "" {
+				assert.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, users)
+		})
+	}
+}
+
+func TestUnitParseUsersJson(t *testing.T) {
+	content := `[
+  {
+    "email": "john@example.com",
+    "name": "John",
+    "skills": [{"skill_id": "skill-1", "proficiency": 3}],
+    "roles": [{"role_id": "role-1"}, {"role_id": "role-2", "division_ids": ["div-1", "div-2"]}],
+    "queue_ids": []
+  },
+  {"email": "jane@example.com", "title": "Agent", "languages": []}
+]`
+	users, err := parseUsersFile(strings.NewReader(content), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []bulkUser{
+		{
+			location: "entry 1",
+			email:    "john@example.com",
+			name:     "John",
+			skills:   map[string]float64{"skill-1": 3},
+			roles: []roleDivision{
+				{roleId: "role-1"},
+				{roleId: "role-2", divisionId: "div-1"},
+				{roleId: "role-2", divisionId: "div-2"},
+			},
+			queueIds: []string{},
+		},
+		{location: "entry 2", email: "jane@example.com", title: "Agent", languages: map[string]float64{}},
+	}, users)
+
+	_, err = parseUsersFile(strings.NewReader(`{"email": "john@example.com"}`), true)
+	assert.ErrorContains(t, err, "failed to parse JSON")
+
+	_, err = parseUsersFile(strings.NewReader(`[{"email": "john@example.com", "skills": [{"skill_id": "skill-1", "proficiency": -1}]}]`), true)
+	assert.ErrorContains(t, err, "entry 1: invalid skills: skill-1: proficiency -1 must be between 0 and 5")
+
+	assert.True(t, isJsonUsersFile("users/agents.JSON"))
+	assert.False(t, isJsonUsersFile("users/agents.csv"))
+}
+
+func TestUnitDiffUserBulk(t *testing.T) {
+	upserts, removes := diffProficiencies(
+		map[string]float64{"skill-1": 3, "skill-2": 4, "skill-3": 1},
+		map[string]float64{"skill-1": 3, "skill-2": 2, "skill-4": 5},
+	)
+	assert.Equal(t, []string{"skill-2", "skill-3"}, upserts)
+	assert.Equal(t, []string{"skill-4"}, removes)
+
+	grant := func(roleId, divisionId string) platformclientv2.Authzgrant {
+		return platformclientv2.Authzgrant{
+			Role:     &platformclientv2.Authzgrantrole{Id: platformclientv2.String(roleId)},
+			Division: &platformclientv2.Authzdivision{Id: platformclientv2.String(divisionId)},
+		}
+	}
+	adds, roleRemoves := diffRoles(
+		[]roleDivision{{roleId: "role-1"}, {roleId: "role-2", divisionId: "div-1"}},
+		[]platformclientv2.Authzgrant{grant("role-1", "home"), grant("employee", "home")},
+		"home",
+	)
+	assert.Equal(t, []roleDivision{{roleId: "role-2", divisionId: "div-1"}}, adds)
+	assert.Equal(t, []roleDivision{{roleId: "employee", divisionId: "home"}}, roleRemoves)
+
+	queueAdds, queueRemoves := diffIds([]string{"queue-2", "queue-1"}, []string{"queue-3", "queue-1"})
+	assert.Equal(t, []string{"queue-2"}, queueAdds)
+	assert.Equal(t, []string{"queue-3"}, queueRemoves)
+
+	assert.Nil(t, buildUserUpdate(bulkUser{name: "John"}, platformclientv2.User{Name: platformclientv2.String("John"), Title: platformclientv2.String("Agent")}))
+	update := buildUserUpdate(bulkUser{name: "John", title: "Supervisor"}, platformclientv2.User{Name: platformclientv2.String("John"), Title: platformclientv2.String("Agent")})
+	assert.Equal(t, &platformclientv2.Updateuser{Title: platformclientv2.String("Supervisor")}, update)
+}
+
+func TestUnitApplyUserBulk(t *testing.T) {
+	usersPath := filepath.Join(t.TempDir(), "users.csv")
+	content := "email,name,skills,roles,queues\n" +
+		"existing@example.com,Existing User,skill-1:3;skill-2:5,,queue-1\n" +
+		"new@example.com,New User,,role-1,queue-1\n" +
+		"broken@example.com,Broken User,skill-1:1,,\n"
+	if err := os.WriteFile(usersPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var createdEmails []string
+	var deletedUserIds []string
+	var deletedSkills []string
+	patchedSkills := make(map[string][]platformclientv2.Userroutingskillpost)
+	var grantedRoles []platformclientv2.Roledivisionpair
+	queueMembers := make(map[string][]string)
+
+	proxy := &userBulkProxy{}
+	proxy.getAllUsersAttr = func(ctx context.Context, p *userBulkProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.User{
+			{Id: platformclientv2.String("existing-id"), Email: platformclientv2.String("Existing@example.com"), Name: platformclientv2.String("Existing User")},
+			{Id: platformclientv2.String("broken-id"), Email: platformclientv2.String("broken@example.com"), Name: platformclientv2.String("Broken User")},
+			{Id: platformclientv2.String("removed-id"), Email: platformclientv2.String("removed@example.com"), Name: platformclientv2.String("Removed User")},
+			{Id: platformclientv2.String("adopted-id"), Email: platformclientv2.String("adopted@example.com"), Name: platformclientv2.String("Adopted User")},
+		}, nil, nil
+	}
+	proxy.createUserAttr = func(ctx context.Context, p *userBulkProxy, user *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		createdEmails = append(createdEmails, *user.Email)
+		return &platformclientv2.User{Id: platformclientv2.String("new-id"), Email: user.Email}, nil, nil
+	}
+	proxy.updateUserAttr = func(ctx context.Context, p *userBulkProxy, userId string, user *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		t.Errorf("unexpected update of user %s", userId)
+		return nil, nil, nil
+	}
+	proxy.deleteUserAttr = func(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+		deletedUserIds = append(deletedUserIds, userId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getUserSkillsAttr = func(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+		if userId == "broken-id" {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusInternalServerError}, fmt.Errorf("failed to get skills of user %s", userId)
+		}
+		return &[]platformclientv2.Userroutingskill{
+			{Id: platformclientv2.String("skill-1"), Proficiency: platformclientv2.Float64(3)},
+			{Id: platformclientv2.String("skill-3"), Proficiency: platformclientv2.Float64(1)},
+		}, nil, nil
+	}
+	proxy.deleteUserSkillAttr = func(ctx context.Context, p *userBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+		deletedSkills = append(deletedSkills, userId+"/"+skillId)
+		return nil, nil
+	}
+	proxy.patchUserSkillsAttr = func(ctx context.Context, p *userBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+		patchedSkills[userId] = append(patchedSkills[userId], skills...)
+		return nil, nil
+	}
+	proxy.getUserGrantsAttr = func(ctx context.Context, p *userBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Authzgrant{}, nil, nil
+	}
+	proxy.addUserGrantsAttr = func(ctx context.Context, p *userBulkProxy, userId string, grants platformclientv2.Roledivisiongrants) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, "new-id", userId)
+		grantedRoles = append(grantedRoles, *grants.Grants...)
+		return nil, nil
+	}
+	proxy.getUserQueueIdsAttr = func(ctx context.Context, p *userBulkProxy, userId string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{"queue-2"}, nil, nil
+	}
+	proxy.updateQueueMembersAttr = func(ctx context.Context, p *userBulkProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+		for _, member := range members {
+			queueMembers[fmt.Sprintf("%s/%v", queueId, remove)] = append(queueMembers[fmt.Sprintf("%s/%v", queueId, remove)], *member.Id)
+		}
+		return nil, nil
+	}
+	proxy.getHomeDivisionIdAttr = func(ctx context.Context, p *userBulkProxy) (string, *platformclientv2.APIResponse, error) {
+		return "home-division", nil, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserBulk().Schema, map[string]interface{}{
+		"users_filepath":    usersPath,
+		"file_content_hash": "hash",
+	})
+	d.SetId("user-bulk")
+	// The previous apply managed a created and an existing user that have since been removed from the file
+	_ = d.Set("user_ids", map[string]interface{}{
+		"existing@example.com": "existing-id",
+		"removed@example.com":  "removed-id",
+		"adopted@example.com":  "adopted-id",
+	})
+	_ = d.Set("created_user_ids", []interface{}{"removed-id"})
+
+	diagErr := applyUserBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+
+	// The failing user is reported with its row and does not stop the others
+	assert.Len(t, diagErr, 1)
+	assert.Equal(t, "Failed to apply row 4 (broken@example.com)", diagErr[0].Summary)
+
+	assert.Equal(t, []string{"new@example.com"}, createdEmails)
+	assert.Equal(t, []string{"removed-id"}, deletedUserIds)
+	assert.Equal(t, []string{"existing-id/skill-3"}, deletedSkills)
+	assert.Equal(t, map[string][]platformclientv2.Userroutingskillpost{
+		"existing-id": {{Id: platformclientv2.String("skill-2"), Proficiency: platformclientv2.Float64(5)}},
+	}, patchedSkills)
+	assert.Equal(t, []platformclientv2.Roledivisionpair{
+		{RoleId: platformclientv2.String("role-1"), DivisionId: platformclientv2.String("home-division")},
+	}, grantedRoles)
+	assert.Equal(t, map[string][]string{
+		"queue-1/false": {"existing-id", "new-id"},
+		"queue-2/true":  {"existing-id"},
+	}, queueMembers)
+	assert.Equal(t, map[string]interface{}{
+		"existing@example.com": "existing-id",
+		"new@example.com":      "new-id",
+		"broken@example.com":   "broken-id",
+	}, d.Get("user_ids"))
+	assert.ElementsMatch(t, []interface{}{"new-id"}, d.Get("created_user_ids").(*schema.Set).List())
+}
+
+func TestUnitDeleteUserBulk(t *testing.T) {
+	var deletedUserIds []string
+	proxy := &userBulkProxy{}
+	proxy.deleteUserAttr = func(ctx context.Context, p *userBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+		deletedUserIds = append(deletedUserIds, userId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserBulk().Schema, map[string]interface{}{
+		"users_filepath":    "users.csv",
+		"file_content_hash": "hash",
+	})
+	d.SetId("user-bulk")
+	_ = d.Set("user_ids", map[string]interface{}{
+		"new@example.com":     "new-id",
+		"adopted@example.com": "adopted-id",
+	})
+	_ = d.Set("created_user_ids", []interface{}{"new-id"})
+
+	diagErr := deleteUserBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+
+	// The existing user matched by email is left in the org
+	assert.Empty(t, diagErr)
+	assert.Equal(t, []string{"new-id"}, deletedUserIds)
+}
+
+func TestUnitReadUserBulkPlansDeletedUsers(t *testing.T) {
+	usersPath := filepath.Join(t.TempDir(), "users.csv")
+	if err := os.WriteFile(usersPath, []byte("email,name\nkept@example.com,Kept User\ndeleted@example.com,Deleted User\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var lookedUpIds []string
+	proxy := &userBulkProxy{}
+	proxy.getUsersByIdsAttr = func(ctx context.Context, p *userBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		lookedUpIds = append(lookedUpIds, userIds...)
+		// The user deleted outside of Terraform is no longer returned
+		return &[]platformclientv2.User{{Id: platformclientv2.String("kept-id")}}, nil, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	resourceUserBulk := ResourceUserBulk()
+	config := map[string]interface{}{
+		"users_filepath":    usersPath,
+		"file_content_hash": "hash",
+	}
+	d := schema.TestResourceDataRaw(t, resourceUserBulk.Schema, config)
+	d.SetId("user-bulk")
+	_ = d.Set("user_ids", map[string]interface{}{
+		"kept@example.com":    "kept-id",
+		"deleted@example.com": "deleted-id",
+	})
+	_ = d.Set("created_user_ids", []interface{}{"kept-id", "deleted-id"})
+
+	diagErr := readUserBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Empty(t, diagErr)
+	assert.ElementsMatch(t, []string{"kept-id", "deleted-id"}, lookedUpIds)
+	assert.Equal(t, map[string]interface{}{"kept@example.com": "kept-id"}, d.Get("user_ids"))
+
+	// The deleted user has no ID, so the plan is not empty
+	instanceDiff, err := resourceUserBulk.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, instanceDiff) {
+		assert.False(t, instanceDiff.Empty())
+		assert.True(t, instanceDiff.Attributes["user_ids.%"].NewComputed)
+	}
+
+	// Once every row has an ID again, the plan is empty
+	_ = d.Set("user_ids", map[string]interface{}{
+		"kept@example.com":    "kept-id",
+		"deleted@example.com": "recreated-id",
+	})
+	instanceDiff, err = resourceUserBulk.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.True(t, instanceDiff == nil || instanceDiff.Empty())
+}
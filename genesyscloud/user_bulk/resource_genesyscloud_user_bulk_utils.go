@@ -0,0 +1,496 @@
+package user_bulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	// Maximum number of skills or languages the bulk patch endpoints accept in one request
+	maxUserRoutingEntriesPerRequest = 50
+
+	// Maximum number of members the queue members endpoint accepts in one request
+	maxQueueMembersPerRequest = 100
+
+	// Maximum number of user IDs looked up in one request to the users endpoint
+	maxUsersPerRequest = 100
+
+	listSeparator  = ";"
+	valueSeparator = ":"
+)
+
+var csvColumns = []string{"email", "name", "division_id", "department", "title", "skills", "languages", "roles", "queues"}
+
+// bulkUser is a user read from the users file. A nil skills, languages, roles or queueIds leaves that attribute unmanaged.
+type bulkUser struct {
+	location   string
+	email      string
+	name       string
+	divisionId string
+	department string
+	title      string
+	skills     map[string]float64
+	languages  map[string]float64
+	roles      []roleDivision
+	queueIds   []string
+}
+
+// roleDivision is a role granted in a division. An empty divisionId stands for the home division.
+type roleDivision struct {
+	roleId     string
+	divisionId string
+}
+
+func (r roleDivision) String() string {
+	return r.roleId + valueSeparator + r.divisionId
+}
+
+// userJson is the format of a user in a JSON users file
+type userJson struct {
+	Email      string `json:"email"`
+	Name       string `json:"name"`
+	DivisionId string `json:"division_id"`
+	Department string `json:"department"`
+	Title      string `json:"title"`
+	Skills     *[]struct {
+		SkillId     string  `json:"skill_id"`
+		Proficiency float64 `json:"proficiency"`
+	} `json:"skills"`
+	Languages *[]struct {
+		LanguageId  string  `json:"language_id"`
+		Proficiency float64 `json:"proficiency"`
+	} `json:"languages"`
+	Roles *[]struct {
+		RoleId      string   `json:"role_id"`
+		DivisionIds []string `json:"division_ids"`
+	} `json:"roles"`
+	QueueIds *[]string `json:"queue_ids"`
+}
+
+// queueMemberChanges holds the users to add to and remove from each queue so that every queue is updated in as few
+// requests as possible
+type queueMemberChanges struct {
+	adds    map[string][]string
+	removes map[string][]string
+}
+
+func newQueueMemberChanges() *queueMemberChanges {
+	return &queueMemberChanges{
+		adds:    make(map[string][]string),
+		removes: make(map[string][]string),
+	}
+}
+
+// isJsonUsersFile reports whether the users file should be read as JSON
+func isJsonUsersFile(filepath string) bool {
+	return strings.EqualFold(path.Ext(filepath), ".json")
+}
+
+// parseUsersFile reads the users of a CSV or JSON file and checks that every user has a unique email
+func parseUsersFile(reader io.Reader, isJson bool) ([]bulkUser, error) {
+	var users []bulkUser
+	var err error
+	if isJson {
+		users, err = parseUsersJson(reader)
+	} else {
+		users, err = parseUsersCsv(reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]bool)
+	for _, user := range users {
+		if user.email == "" {
+			return nil, fmt.Errorf("%s: email is empty", user.location)
+		}
+		email := strings.ToLower(user.email)
+		if emails[email] {
+			return nil, fmt.Errorf("%s: duplicate email %s", user.location, user.email)
+		}
+		emails[email] = true
+	}
+	return users, nil
+}
+
+// parseUsersCsv reads the users of a CSV file. The first row is a header naming the columns.
+func parseUsersCsv(reader io.Reader) ([]bulkUser, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, fmt.Errorf("failed to read header: %s", err)
+	}
+
+	columnIndexes := make(map[string]int)
+	for i, name := range header {
+		column := strings.ToLower(strings.TrimSpace(name))
+		if !isCsvColumn(column) {
+			return nil, fmt.Errorf("unknown column %s, expected one of %s", name, strings.Join(csvColumns, ", "))
+		}
+		columnIndexes[column] = i
+	}
+	if _, ok := columnIndexes["email"]; !ok {
+		return nil, fmt.Errorf("header does not contain the email column")
+	}
+
+	var users []bulkUser
+	for rowNum := 2; ; rowNum++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return users, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %s", rowNum, err)
+		}
+
+		value := func(column string) string {
+			if i, ok := columnIndexes[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		user := bulkUser{
+			location:   fmt.Sprintf("row %d", rowNum),
+			email:      value("email"),
+			name:       value("name"),
+			divisionId: value("division_id"),
+			department: value("department"),
+			title:      value("title"),
+		}
+		if user.skills, err = parseProficiencyList(value("skills")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid skills: %s", rowNum, err)
+		}
+		if user.languages, err = parseProficiencyList(value("languages")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid languages: %s", rowNum, err)
+		}
+		if user.roles, err = parseRoleList(value("roles")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid roles: %s", rowNum, err)
+		}
+		user.queueIds = splitList(value("queues"))
+		users = append(users, user)
+	}
+}
+
+func isCsvColumn(name string) bool {
+	for _, column := range csvColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits a cell of a CSV file into its values. An empty cell returns nil.
+func splitList(cell string) []string {
+	if cell == "" {
+		return nil
+	}
+	values := make([]string, 0)
+	for _, value := range strings.Split(cell, listSeparator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseProficiencyList parses a list of id:proficiency values
+func parseProficiencyList(cell string) (map[string]float64, error) {
+	values := splitList(cell)
+	if values == nil {
+		return nil, nil
+	}
+
+	proficiencies := make(map[string]float64)
+	for _, value := range values {
+		id, proficiencyStr, found := strings.Cut(value, valueSeparator)
+		if !found {
+			return nil, fmt.Errorf("%s is not in the form id%sproficiency", value, valueSeparator)
+		}
+		proficiency, err := strconv.ParseFloat(strings.TrimSpace(proficiencyStr), 64)
+		if err != nil {
+			return nil, fmt.Errorf("proficiency %s of %s is not a number", proficiencyStr, id)
+		}
+		if err := validateProficiency(proficiency); err != nil {
+			return nil, fmt.Errorf("%s: %s", id, err)
+		}
+		proficiencies[strings.TrimSpace(id)] = proficiency
+	}
+	return proficiencies, nil
+}
+
+func validateProficiency(proficiency float64) error {
+	if proficiency < 0 || proficiency > 5 {
+		return fmt.Errorf("proficiency %v must be between 0 and 5", proficiency)
+	}
+	return nil
+}
+
+// parseRoleList parses a list of roleId or roleId:divisionId values
+func parseRoleList(cell string) ([]roleDivision, error) {
+	values := splitList(cell)
+	if values == nil {
+		return nil, nil
+	}
+
+	roles := make([]roleDivision, 0)
+	for _, value := range values {
+		roleId, divisionId, _ := strings.Cut(value, valueSeparator)
+		roleId = strings.TrimSpace(roleId)
+		if roleId == "" {
+			return nil, fmt.Errorf("%s has no role ID", value)
+		}
+		roles = append(roles, roleDivision{roleId: roleId, divisionId: strings.TrimSpace(divisionId)})
+	}
+	return roles, nil
+}
+
+// parseUsersJson reads the users of a JSON file holding an array of users
+func parseUsersJson(reader io.Reader) ([]bulkUser, error) {
+	var entries []userJson
+	if err := json.NewDecoder(reader).Decode(&entries); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, fmt.Errorf("failed to parse JSON: %s", err)
+	}
+
+	users := make([]bulkUser, 0, len(entries))
+	for i, entry := range entries {
+		user := bulkUser{
+			location:   fmt.Sprintf("entry %d", i+1),
+			email:      strings.TrimSpace(entry.Email),
+			name:       entry.Name,
+			divisionId: entry.DivisionId,
+			department: entry.Department,
+			title:      entry.Title,
+		}
+		if entry.Skills != nil {
+			user.skills = make(map[string]float64)
+			for _, skill := range *entry.Skills {
+				if err := validateProficiency(skill.Proficiency); err != nil {
+					return nil, fmt.Errorf("%s: invalid skills: %s: %s", user.location, skill.SkillId, err)
+				}
+				user.skills[skill.SkillId] = skill.Proficiency
+			}
+		}
+		if entry.Languages != nil {
+			user.languages = make(map[string]float64)
+			for _, language := range *entry.Languages {
+				if err := validateProficiency(language.Proficiency); err != nil {
+					return nil, fmt.Errorf("%s: invalid languages: %s: %s", user.location, language.LanguageId, err)
+				}
+				user.languages[language.LanguageId] = language.Proficiency
+			}
+		}
+		if entry.Roles != nil {
+			user.roles = make([]roleDivision, 0)
+			for _, role := range *entry.Roles {
+				if role.RoleId == "" {
+					return nil, fmt.Errorf("%s: invalid roles: role_id is empty", user.location)
+				}
+				if len(role.DivisionIds) == 0 {
+					user.roles = append(user.roles, roleDivision{roleId: role.RoleId})
+				}
+				for _, divisionId := range role.DivisionIds {
+					user.roles = append(user.roles, roleDivision{roleId: role.RoleId, divisionId: divisionId})
+				}
+			}
+		}
+		if entry.QueueIds != nil {
+			user.queueIds = append(make([]string, 0), *entry.QueueIds...)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// needsHomeDivision reports whether any role of the users is granted without a division
+func needsHomeDivision(users []bulkUser) bool {
+	for _, user := range users {
+		for _, role := range user.roles {
+			if role.divisionId == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildUserUpdate returns the profile changes to apply to an existing user, or nil if there are none. Empty fields
+// of the file are left unmanaged.
+func buildUserUpdate(user bulkUser, current platformclientv2.User) *platformclientv2.Updateuser {
+	var update platformclientv2.Updateuser
+	changed := false
+	if user.name != "" && user.name != stringValue(current.Name) {
+		update.Name = platformclientv2.String(user.name)
+		changed = true
+	}
+	if user.department != "" && user.department != stringValue(current.Department) {
+		update.Department = platformclientv2.String(user.department)
+		changed = true
+	}
+	if user.title != "" && user.title != stringValue(current.Title) {
+		update.Title = platformclientv2.String(user.title)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return &update
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// diffProficiencies compares the desired proficiencies with the current ones and returns the ids to add or update
+// and the ids to remove, both sorted
+func diffProficiencies(desired map[string]float64, current map[string]float64) (upserts []string, removes []string) {
+	for id, proficiency := range desired {
+		if currentProficiency, ok := current[id]; !ok || currentProficiency != proficiency {
+			upserts = append(upserts, id)
+		}
+	}
+	for id := range current {
+		if _, ok := desired[id]; !ok {
+			removes = append(removes, id)
+		}
+	}
+	sort.Strings(upserts)
+	sort.Strings(removes)
+	return upserts, removes
+}
+
+func skillProficiencies(skills []platformclientv2.Userroutingskill) map[string]float64 {
+	proficiencies := make(map[string]float64)
+	for _, skill := range skills {
+		if skill.Id != nil && skill.Proficiency != nil {
+			proficiencies[*skill.Id] = *skill.Proficiency
+		}
+	}
+	return proficiencies
+}
+
+func languageProficiencies(languages []platformclientv2.Userroutinglanguage) map[string]float64 {
+	proficiencies := make(map[string]float64)
+	for _, language := range languages {
+		if language.Id != nil && language.Proficiency != nil {
+			proficiencies[*language.Id] = *language.Proficiency
+		}
+	}
+	return proficiencies
+}
+
+// diffRoles compares the desired roles with the current grants of a user. Roles without a division are granted in
+// the home division.
+func diffRoles(desired []roleDivision, current []platformclientv2.Authzgrant, homeDivisionId string) (adds []roleDivision, removes []roleDivision) {
+	desiredSet := make(map[string]roleDivision)
+	for _, role := range desired {
+		if role.divisionId == "" {
+			role.divisionId = homeDivisionId
+		}
+		desiredSet[role.String()] = role
+	}
+
+	currentSet := make(map[string]roleDivision)
+	for _, grant := range current {
+		if grant.Role == nil || grant.Role.Id == nil || grant.Division == nil || grant.Division.Id == nil {
+			continue
+		}
+		role := roleDivision{roleId: *grant.Role.Id, divisionId: *grant.Division.Id}
+		currentSet[role.String()] = role
+	}
+
+	for key, role := range desiredSet {
+		if _, ok := currentSet[key]; !ok {
+			adds = append(adds, role)
+		}
+	}
+	for key, role := range currentSet {
+		if _, ok := desiredSet[key]; !ok {
+			removes = append(removes, role)
+		}
+	}
+	sortRoles(adds)
+	sortRoles(removes)
+	return adds, removes
+}
+
+func sortRoles(roles []roleDivision) {
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].String() < roles[j].String()
+	})
+}
+
+// diffIds compares two lists of ids and returns the ids to add and to remove, both sorted
+func diffIds(desired []string, current []string) (adds []string, removes []string) {
+	desiredSet := make(map[string]bool)
+	for _, id := range desired {
+		desiredSet[id] = true
+	}
+	currentSet := make(map[string]bool)
+	for _, id := range current {
+		currentSet[id] = true
+	}
+
+	for id := range desiredSet {
+		if !currentSet[id] {
+			adds = append(adds, id)
+		}
+	}
+	for id := range currentSet {
+		if !desiredSet[id] {
+			removes = append(removes, id)
+		}
+	}
+	sort.Strings(adds)
+	sort.Strings(removes)
+	return adds, removes
+}
+
+func buildWritableEntities(ids []string) []platformclientv2.Writableentity {
+	entities := make([]platformclientv2.Writableentity, 0, len(ids))
+	for _, id := range ids {
+		entities = append(entities, platformclientv2.Writableentity{Id: platformclientv2.String(id)})
+	}
+	return entities
+}
+
+func buildRoleDivisionGrants(roles []roleDivision) platformclientv2.Roledivisiongrants {
+	pairs := make([]platformclientv2.Roledivisionpair, 0, len(roles))
+	for _, role := range roles {
+		pairs = append(pairs, platformclientv2.Roledivisionpair{
+			RoleId:     platformclientv2.String(role.roleId),
+			DivisionId: platformclientv2.String(role.divisionId),
+		})
+	}
+	return platformclientv2.Roledivisiongrants{Grants: &pairs}
+}
+
+// sortedKeys returns the keys of a map of queue changes in order so that requests are sent in a stable order
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
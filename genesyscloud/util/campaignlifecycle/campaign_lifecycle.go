@@ -0,0 +1,197 @@
+package campaignlifecycle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+This package moves outbound campaigns through their state machine. A running campaign cannot be changed: it has to be
+turned off first, and it then stays in "stopping" until the calls in progress drain. Only once it is "off" can it be
+updated, after which the status the user asked for is restored. Resources that depend on campaigns (contact lists and
+sequences) use the same helpers to stop the campaigns affected by an update and restart them afterwards.
+*/
+
+const (
+	StatusOn       = "on"
+	StatusOff      = "off"
+	StatusStopping = "stopping"
+	StatusComplete = "complete"
+	StatusInvalid  = "invalid"
+)
+
+// Type definitions for each func on the client so they can be stubbed out in tests
+type getCampaignFunc func(ctx context.Context, id string) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
+type updateCampaignFunc func(ctx context.Context, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, error)
+type getAllCampaignsFunc func(ctx context.Context) (*[]platformclientv2.Campaign, error)
+
+// CampaignClient holds the campaign API calls used to change the status of campaigns
+type CampaignClient struct {
+	GetCampaignAttr     getCampaignFunc
+	UpdateCampaignAttr  updateCampaignFunc
+	GetAllCampaignsAttr getAllCampaignsFunc
+}
+
+// NewCampaignClient returns a CampaignClient calling the Genesys Cloud outbound API
+func NewCampaignClient(clientConfig *platformclientv2.Configuration) *CampaignClient {
+	outboundApi := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &CampaignClient{
+		GetCampaignAttr: func(ctx context.Context, id string) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+			return outboundApi.GetOutboundCampaign(id)
+		},
+		UpdateCampaignAttr: func(ctx context.Context, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, error) {
+			updated, _, err := outboundApi.PutOutboundCampaign(id, *campaign)
+			return updated, err
+		},
+		GetAllCampaignsAttr: func(ctx context.Context) (*[]platformclientv2.Campaign, error) {
+			var campaigns []platformclientv2.Campaign
+			const pageSize = 100
+			for pageNum := 1; ; pageNum++ {
+				campaignListing, _, err := outboundApi.GetOutboundCampaigns(pageSize, pageNum, "", "", nil, "", "", "", "", "", nil, "", "")
+				if err != nil {
+					return nil, err
+				}
+				if campaignListing.Entities == nil || len(*campaignListing.Entities) == 0 {
+					break
+				}
+				campaigns = append(campaigns, *campaignListing.Entities...)
+				if campaignListing.PageCount == nil || pageNum >= *campaignListing.PageCount {
+					break
+				}
+			}
+			return &campaigns, nil
+		},
+	}
+}
+
+// IsRunning returns true if the campaign status means the campaign cannot be updated
+func IsRunning(status string) bool {
+	return status == StatusOn || status == StatusStopping
+}
+
+// StopCampaign turns a running campaign off and waits for it to finish stopping. It returns true if the campaign was
+// on, so that callers know whether it has to be restarted.
+func StopCampaign(ctx context.Context, client *CampaignClient, campaignId string, timeout time.Duration) (bool, diag.Diagnostics) {
+	campaign, _, err := client.GetCampaignAttr(ctx, campaignId)
+	if err != nil {
+		return false, diag.Errorf("Failed to read Outbound Campaign %s: %s", campaignId, err)
+	}
+
+	status := campaignStatus(campaign)
+	if !IsRunning(status) {
+		return false, nil
+	}
+
+	if status == StatusOn {
+		log.Printf("Turning off Outbound Campaign %s", campaignId)
+		campaign.CampaignStatus = platformclientv2.String(StatusOff)
+		if _, err := client.UpdateCampaignAttr(ctx, campaignId, campaign); err != nil {
+			return false, diag.Errorf("Failed to turn off Outbound Campaign %s: %s", campaignId, err)
+		}
+	}
+
+	log.Printf("Waiting for Outbound Campaign %s to stop", campaignId)
+	if diagErr := WaitForCampaignStatus(ctx, client, campaignId, timeout, func(status string) bool {
+		return !IsRunning(status)
+	}); diagErr != nil {
+		return false, diagErr
+	}
+	return status == StatusOn, nil
+}
+
+// StartCampaign turns a campaign on and waits for Genesys Cloud to report it as running. A campaign with nothing left
+// to dial moves straight to complete, which is accepted too.
+func StartCampaign(ctx context.Context, client *CampaignClient, campaignId string, timeout time.Duration) diag.Diagnostics {
+	campaign, _, err := client.GetCampaignAttr(ctx, campaignId)
+	if err != nil {
+		return diag.Errorf("Failed to read Outbound Campaign %s: %s", campaignId, err)
+	}
+	if campaignStatus(campaign) == StatusOn {
+		return nil
+	}
+
+	log.Printf("Turning on Outbound Campaign %s", campaignId)
+	campaign.CampaignStatus = platformclientv2.String(StatusOn)
+	if _, err := client.UpdateCampaignAttr(ctx, campaignId, campaign); err != nil {
+		return diag.Errorf("Failed to turn on Outbound Campaign %s: %s", campaignId, err)
+	}
+
+	return WaitForCampaignStatus(ctx, client, campaignId, timeout, func(status string) bool {
+		return status == StatusOn || status == StatusComplete
+	})
+}
+
+// WaitForCampaignStatus polls a campaign until its status satisfies done. A campaign that becomes invalid fails the wait.
+func WaitForCampaignStatus(ctx context.Context, client *CampaignClient, campaignId string, timeout time.Duration, done func(status string) bool) diag.Diagnostics {
+	return gcloud.WithRetries(ctx, timeout, func() *retry.RetryError {
+		campaign, _, err := client.GetCampaignAttr(ctx, campaignId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read Outbound Campaign %s: %s", campaignId, err))
+		}
+		status := campaignStatus(campaign)
+		if done(status) {
+			return nil
+		}
+		if status == StatusInvalid {
+			return retry.NonRetryableError(fmt.Errorf("Outbound Campaign %s is invalid", campaignId))
+		}
+		return retry.RetryableError(fmt.Errorf("Outbound Campaign %s is still %s", campaignId, status))
+	})
+}
+
+// WithCampaignsStopped stops every running campaign in campaignIds, calls apply and then restarts the campaigns that were
+// on. The campaigns are restarted even if apply fails, so that a failed update does not leave them off.
+func WithCampaignsStopped(ctx context.Context, client *CampaignClient, campaignIds []string, timeout time.Duration, apply func() diag.Diagnostics) diag.Diagnostics {
+	var stopped []string
+	var diags diag.Diagnostics
+	for _, campaignId := range campaignIds {
+		wasOn, diagErr := StopCampaign(ctx, client, campaignId, timeout)
+		if diagErr != nil {
+			diags = append(diags, diagErr...)
+			break
+		}
+		if wasOn {
+			stopped = append(stopped, campaignId)
+		}
+	}
+
+	if !diags.HasError() {
+		diags = append(diags, apply()...)
+	}
+
+	for _, campaignId := range stopped {
+		diags = append(diags, StartCampaign(ctx, client, campaignId, timeout)...)
+	}
+	return diags
+}
+
+// GetCampaignIdsByContactList returns the IDs of the campaigns that dial the contact list
+func GetCampaignIdsByContactList(ctx context.Context, client *CampaignClient, contactListId string) ([]string, error) {
+	campaigns, err := client.GetAllCampaignsAttr(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaigns: %s", err)
+	}
+
+	var campaignIds []string
+	for _, campaign := range *campaigns {
+		if campaign.Id != nil && campaign.ContactList != nil && campaign.ContactList.Id != nil && *campaign.ContactList.Id == contactListId {
+			campaignIds = append(campaignIds, *campaign.Id)
+		}
+	}
+	return campaignIds, nil
+}
+
+func campaignStatus(campaign *platformclientv2.Campaign) string {
+	if campaign == nil || campaign.CampaignStatus == nil {
+		return ""
+	}
+	return *campaign.CampaignStatus
+}
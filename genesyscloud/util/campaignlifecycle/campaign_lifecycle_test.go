@@ -0,0 +1,134 @@
+package campaignlifecycle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// newTestClient returns a client backed by the statuses map. A campaign that is turned off reports stopping and then
+// moves through its statuses in stopping, one per read. Any other update sets the status directly.
+func newTestClient(statuses map[string]string, stopping map[string][]string, updates *[]string) *CampaignClient {
+	return &CampaignClient{
+		GetCampaignAttr: func(ctx context.Context, id string) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+			status, ok := statuses[id]
+			if !ok {
+				return nil, nil, fmt.Errorf("campaign %s not found", id)
+			}
+			if status == StatusStopping && len(stopping[id]) > 0 {
+				statuses[id] = stopping[id][0]
+				stopping[id] = stopping[id][1:]
+			}
+			return &platformclientv2.Campaign{Id: &id, CampaignStatus: platformclientv2.String(status)}, nil, nil
+		},
+		UpdateCampaignAttr: func(ctx context.Context, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, error) {
+			*updates = append(*updates, id+":"+*campaign.CampaignStatus)
+			if *campaign.CampaignStatus == StatusOff {
+				statuses[id] = StatusStopping
+			} else {
+				statuses[id] = *campaign.CampaignStatus
+			}
+			return campaign, nil
+		},
+	}
+}
+
+func TestUnitStopCampaign(t *testing.T) {
+	var updates []string
+	statuses := map[string]string{"running": StatusOn, "idle": StatusOff}
+	stopping := map[string][]string{"running": {StatusStopping, StatusOff}}
+	client := newTestClient(statuses, stopping, &updates)
+
+	wasOn, diagErr := StopCampaign(context.Background(), client, "running", time.Minute)
+	if diagErr != nil {
+		t.Fatalf("Unexpected error stopping campaign: %v", diagErr)
+	}
+	if !wasOn {
+		t.Errorf("Expected campaign to be reported as on")
+	}
+	if statuses["running"] != StatusOff {
+		t.Errorf("Expected campaign to have drained to off, got %s", statuses["running"])
+	}
+
+	wasOn, diagErr = StopCampaign(context.Background(), client, "idle", time.Minute)
+	if diagErr != nil {
+		t.Fatalf("Unexpected error stopping campaign: %v", diagErr)
+	}
+	if wasOn {
+		t.Errorf("Expected campaign that was off to be reported as off")
+	}
+
+	if len(updates) != 1 || updates[0] != "running:off" {
+		t.Errorf("Unexpected campaign updates %v", updates)
+	}
+}
+
+func TestUnitStartCampaignInvalid(t *testing.T) {
+	var updates []string
+	statuses := map[string]string{"broken": StatusOff}
+	client := newTestClient(statuses, nil, &updates)
+	update := client.UpdateCampaignAttr
+	client.UpdateCampaignAttr = func(ctx context.Context, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, error) {
+		campaign, err := update(ctx, id, campaign)
+		statuses[id] = StatusInvalid
+		return campaign, err
+	}
+
+	if diagErr := StartCampaign(context.Background(), client, "broken", time.Minute); diagErr == nil {
+		t.Errorf("Expected an error for a campaign that became invalid")
+	}
+}
+
+func TestUnitWithCampaignsStopped(t *testing.T) {
+	var updates []string
+	statuses := map[string]string{"c1": StatusOn, "c2": StatusOff}
+	stopping := map[string][]string{"c1": {StatusOff}}
+	client := newTestClient(statuses, stopping, &updates)
+
+	applied := false
+	diagErr := WithCampaignsStopped(context.Background(), client, []string{"c1", "c2"}, time.Minute, func() diag.Diagnostics {
+		applied = true
+		if statuses["c1"] != StatusOff {
+			t.Errorf("Expected c1 to be off while applying, got %s", statuses["c1"])
+		}
+		return diag.Errorf("update failed")
+	})
+
+	if !applied {
+		t.Errorf("Expected apply to be called")
+	}
+	if !diagErr.HasError() {
+		t.Errorf("Expected the apply error to be returned")
+	}
+	if statuses["c1"] != StatusOn {
+		t.Errorf("Expected c1 to be restarted after a failed apply, got %s", statuses["c1"])
+	}
+	if statuses["c2"] != StatusOff {
+		t.Errorf("Expected c2 to stay off, got %s", statuses["c2"])
+	}
+}
+
+func TestUnitGetCampaignIdsByContactList(t *testing.T) {
+	client := &CampaignClient{
+		GetAllCampaignsAttr: func(ctx context.Context) (*[]platformclientv2.Campaign, error) {
+			return &[]platformclientv2.Campaign{
+				{Id: platformclientv2.String("c1"), ContactList: &platformclientv2.Domainentityref{Id: platformclientv2.String("list-a")}},
+				{Id: platformclientv2.String("c2"), ContactList: &platformclientv2.Domainentityref{Id: platformclientv2.String("list-b")}},
+				{Id: platformclientv2.String("c3"), ContactList: &platformclientv2.Domainentityref{Id: platformclientv2.String("list-a")}},
+				{Id: platformclientv2.String("c4")},
+			}, nil
+		},
+	}
+
+	ids, err := GetCampaignIdsByContactList(context.Background(), client, "list-a")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(ids) != 2 || ids[0] != "c1" || ids[1] != "c3" {
+		t.Errorf("Expected [c1 c3], got %v", ids)
+	}
+}
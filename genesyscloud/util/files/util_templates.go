@@ -0,0 +1,148 @@
+package files
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// FlowTemplate holds the configuration used to render a flow configuration file through Go's text/template
+// engine before it is uploaded. Variables may hold nested lists and maps, which allows conditionals and loops
+// inside the template. Each include is registered as a named template using its file name without extension.
+type FlowTemplate struct {
+	Variables map[string]interface{}
+	Includes  []string
+	Strict    bool
+}
+
+// Render reads the template from reader, registers any includes and executes the template with the configured
+// variables. When Strict is enabled, a reference to a variable that was not supplied results in an error.
+func (f *FlowTemplate) Render(name string, reader io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %s", name, err)
+	}
+
+	tmpl := template.New(name)
+	tmpl.Funcs(templateFuncs(tmpl))
+	if f.Strict {
+		tmpl.Option("missingkey=error")
+	}
+
+	for _, includePath := range f.Includes {
+		if err := f.addInclude(tmpl, includePath); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tmpl.Parse(string(content)); err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %s", name, err)
+	}
+
+	variables := f.Variables
+	if variables == nil {
+		variables = make(map[string]interface{})
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, variables); err != nil {
+		return nil, fmt.Errorf("failed to render template %s: %s", name, err)
+	}
+	return rendered.Bytes(), nil
+}
+
+func (f *FlowTemplate) addInclude(tmpl *template.Template, includePath string) error {
+	reader, file, err := DownloadOrOpenFile(includePath)
+	if err != nil {
+		return fmt.Errorf("failed to open template include %s: %s", includePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read template include %s: %s", includePath, err)
+	}
+
+	includeName := strings.TrimSuffix(filepath.Base(includePath), filepath.Ext(includePath))
+	if _, err := tmpl.New(includeName).Parse(string(content)); err != nil {
+		return fmt.Errorf("failed to parse template include %s: %s", includePath, err)
+	}
+	return nil
+}
+
+// HashIncludes returns a hash of the paths and contents of the template includes, so that a change to a shared
+// fragment can be detected even though the main configuration file is unchanged.
+func HashIncludes(includes []string) (string, error) {
+	hash := sha256.New()
+	for _, includePath := range includes {
+		reader, file, err := DownloadOrOpenFile(includePath)
+		if err != nil {
+			return "", fmt.Errorf("failed to open template include %s: %s", includePath, err)
+		}
+		hash.Write([]byte(includePath))
+		_, err = io.Copy(hash, reader)
+		if file != nil {
+			file.Close()
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read template include %s: %s", includePath, err)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// templateFuncs returns the helper functions available to flow templates. include renders a named template
+// into a string so that it can be piped through indent when a shared fragment is nested inside the YAML.
+func templateFuncs(tmpl *template.Template) template.FuncMap {
+	return template.FuncMap{
+		"include": func(name string, data interface{}) (string, error) {
+			var buf bytes.Buffer
+			if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+		"indent": func(spaces int, s string) string {
+			pad := strings.Repeat(" ", spaces)
+			return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+		},
+		"default": func(def interface{}, value interface{}) interface{} {
+			if value == nil {
+				return def
+			}
+			if s, ok := value.(string); ok && s == "" {
+				return def
+			}
+			return value
+		},
+		"required": func(name string, value interface{}) (interface{}, error) {
+			if value == nil {
+				return nil, fmt.Errorf("template variable %s is required", name)
+			}
+			if s, ok := value.(string); ok && s == "" {
+				return nil, fmt.Errorf("template variable %s is required", name)
+			}
+			return value, nil
+		},
+		"quote": func(value interface{}) string {
+			return fmt.Sprintf("%q", fmt.Sprint(value))
+		},
+		"join": func(sep string, values []interface{}) string {
+			items := make([]string, 0, len(values))
+			for _, v := range values {
+				items = append(items, fmt.Sprint(v))
+			}
+			return strings.Join(items, sep)
+		},
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+		"trim":  strings.TrimSpace,
+	}
+}
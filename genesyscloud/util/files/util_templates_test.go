@@ -0,0 +1,116 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFlowTemplateRender verifies that variables, conditionals and loops are rendered in a flow template
+func TestFlowTemplateRender(t *testing.T) {
+	flowTemplate := &FlowTemplate{
+		Variables: map[string]interface{}{
+			"name":   "EMEA Inbound",
+			"closed": true,
+			"languages": []interface{}{
+				"en-us",
+				"fr-fr",
+			},
+		},
+		Strict: true,
+	}
+	yamlTemplate := `inboundCall:
+  name: {{ .name }}
+{{- if .closed }}
+  closed: true
+{{- end }}
+  supportedLanguages:
+{{- range .languages }}
+    {{ . }}:
+      noValue: true
+{{- end }}`
+	expected := `inboundCall:
+  name: EMEA Inbound
+  closed: true
+  supportedLanguages:
+    en-us:
+      noValue: true
+    fr-fr:
+      noValue: true`
+
+	rendered, err := flowTemplate.Render("flow", strings.NewReader(yamlTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, string(rendered))
+}
+
+// TestFlowTemplateMissingVariable verifies that strict templates fail when a variable is not supplied
+func TestFlowTemplateMissingVariable(t *testing.T) {
+	flowTemplate := &FlowTemplate{
+		Variables: map[string]interface{}{"name": "EMEA Inbound"},
+		Strict:    true,
+	}
+
+	_, err := flowTemplate.Render("flow", strings.NewReader(`name: {{ .name }} {{ .region }}`))
+	if err == nil {
+		t.Fatal("expected an error for the missing variable region")
+	}
+	assert.Contains(t, err.Error(), "region")
+
+	flowTemplate.Strict = false
+	if _, err := flowTemplate.Render("flow", strings.NewReader(`name: {{ .name }} {{ .region }}`)); err != nil {
+		t.Errorf("expected no error when strict is disabled, got %s", err)
+	}
+}
+
+// TestFlowTemplateIncludes verifies that shared YAML fragments can be included and indented
+func TestFlowTemplateIncludes(t *testing.T) {
+	includePath := filepath.Join(t.TempDir(), "greeting.yaml")
+	if err := os.WriteFile(includePath, []byte("tts: Hello from {{ .region }}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	flowTemplate := &FlowTemplate{
+		Variables: map[string]interface{}{"region": "APAC"},
+		Includes:  []string{includePath},
+		Strict:    true,
+	}
+	yamlTemplate := `initialGreeting:
+{{ include "greeting" . | indent 2 }}`
+
+	rendered, err := flowTemplate.Render("flow", strings.NewReader(yamlTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "initialGreeting:\n  tts: Hello from APAC", string(rendered))
+}
+
+// TestHashIncludes verifies that the includes hash changes with the content of an include
+func TestHashIncludes(t *testing.T) {
+	includePath := filepath.Join(t.TempDir(), "greeting.yaml")
+	if err := os.WriteFile(includePath, []byte("tts: Hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	firstHash, err := HashIncludes([]string{includePath})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(includePath, []byte("tts: Goodbye"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	secondHash, err := HashIncludes([]string{includePath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, firstHash, secondHash)
+
+	if _, err := HashIncludes([]string{filepath.Join(t.TempDir(), "missing.yaml")}); err == nil {
+		t.Error("expected an error for a missing include")
+	}
+}
@@ -0,0 +1,644 @@
+package fileserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+ArchitectMock is a local stand-in for the parts of the Genesys Cloud API that the flow, script and user prompt
+resources depend on. It implements the Architect jobs, flows, prompts and scripts endpoints together with the
+presigned upload URLs they hand out, so these resources can be exercised without a live org.
+
+Point the provider at the mock with the api_base_path provider attribute or the GENESYSCLOUD_API_BASE_PATH
+environment variable. Any OAuth client ID and secret are accepted. Files in the served directory are available
+under /files/ for resources that download their configuration from a URL.
+*/
+type ArchitectMock struct {
+	URL string
+
+	server *httptest.Server
+	mutex  sync.Mutex
+
+	flows   map[string]*mockFlow
+	jobs    map[string]*platformclientv2.Architectjobstateresponse
+	prompts map[string]*platformclientv2.Prompt
+	scripts map[string]*mockScript
+	uploads map[string]*platformclientv2.Importscriptstatusresponse
+}
+
+type mockFlow struct {
+	flow          platformclientv2.Flow
+	versions      []platformclientv2.Flowversion
+	latestVersion int
+}
+
+type mockScript struct {
+	script    platformclientv2.Script
+	content   string
+	published bool
+}
+
+var (
+	flowTypeRegex = regexp.MustCompile(`(?m)^([A-Za-z]+):\s*$`)
+	flowNameRegex = regexp.MustCompile(`(?m)^\s+name:\s*(.+?)\s*$`)
+)
+
+// StartArchitectMock starts the mock on a random local port. Static files are served from directory under /files/.
+func StartArchitectMock(directory string) *ArchitectMock {
+	m := &ArchitectMock{
+		flows:   make(map[string]*mockFlow),
+		jobs:    make(map[string]*platformclientv2.Architectjobstateresponse),
+		prompts: make(map[string]*platformclientv2.Prompt),
+		scripts: make(map[string]*mockScript),
+		uploads: make(map[string]*platformclientv2.Importscriptstatusresponse),
+	}
+
+	mux := http.NewServeMux()
+	if directory != "" {
+		mux.Handle("/files/", http.StripPrefix("/files/", http.FileServer(http.Dir(directory))))
+	}
+	mux.HandleFunc("/oauth/token", m.handleToken)
+	mux.HandleFunc("/uploads/", m.handleUpload)
+	mux.HandleFunc("/api/v2/flows", m.handleFlows)
+	mux.HandleFunc("/api/v2/flows/", m.handleFlows)
+	mux.HandleFunc("/api/v2/architect/prompts", m.handlePrompts)
+	mux.HandleFunc("/api/v2/architect/prompts/", m.handlePrompts)
+	mux.HandleFunc("/api/v2/scripts", m.handleScripts)
+	mux.HandleFunc("/api/v2/scripts/", m.handleScripts)
+
+	m.server = httptest.NewServer(mux)
+	m.URL = m.server.URL
+
+	log.Printf("Architect mock started at %s", m.URL)
+	return m
+}
+
+// Close shuts down the mock server
+func (m *ArchitectMock) Close() {
+	m.server.Close()
+	log.Println("Architect mock finished serving")
+}
+
+func (m *ArchitectMock) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": uuid.NewString(),
+		"token_type":   "bearer",
+		"expires_in":   86400,
+	})
+}
+
+// handleUpload receives the content sent to the presigned URLs handed out by the mock
+func (m *ArchitectMock) handleUpload(w http.ResponseWriter, r *http.Request) {
+	segments := pathSegments(r.URL.Path, "/uploads/")
+	switch {
+	case len(segments) == 2 && segments[0] == "flows" && r.Method == http.MethodPut:
+		m.uploadFlow(w, r, segments[1])
+	case len(segments) == 2 && segments[0] == "prompts" && r.Method == http.MethodPost:
+		m.uploadPromptResource(w, r, segments[1])
+	case len(segments) == 2 && segments[0] == "v2" && segments[1] == "scripter" && r.Method == http.MethodPost:
+		m.uploadScript(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "upload not found")
+	}
+}
+
+func (m *ArchitectMock) handleFlows(w http.ResponseWriter, r *http.Request) {
+	segments := pathSegments(r.URL.Path, "/api/v2/flows")
+	query := r.URL.Query()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		m.listFlows(w, query)
+	case len(segments) == 1 && segments[0] == "jobs" && r.Method == http.MethodPost:
+		m.registerFlowJob(w)
+	case len(segments) == 2 && segments[0] == "jobs" && r.Method == http.MethodGet:
+		job, ok := m.jobs[segments[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	case len(segments) == 2 && segments[0] == "actions" && r.Method == http.MethodPost:
+		m.flowAction(w, segments[1], query.Get("flow"), query.Get("version"))
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		flow, ok := m.flows[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "flow not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, flow.flow)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if _, ok := m.flows[segments[0]]; !ok {
+			writeError(w, http.StatusNotFound, "flow not found")
+			return
+		}
+		delete(m.flows, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 2 && segments[1] == "versions" && r.Method == http.MethodGet:
+		flow, ok := m.flows[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "flow not found")
+			return
+		}
+		entities := paginate(flow.versions, query)
+		writeJSON(w, http.StatusOK, platformclientv2.Flowversionentitylisting{Entities: &entities, PageCount: pageCount(len(flow.versions), query)})
+	default:
+		writeError(w, http.StatusNotFound, "resource not found")
+	}
+}
+
+func (m *ArchitectMock) listFlows(w http.ResponseWriter, query map[string][]string) {
+	var flows []platformclientv2.Flow
+	for _, flow := range m.flows {
+		if names, ok := query["name"]; ok && names[0] != "" && !strings.EqualFold(*flow.flow.Name, names[0]) {
+			continue
+		}
+		if types, ok := query["type"]; ok && len(types) > 0 && !containsFold(types, *flow.flow.VarType) {
+			continue
+		}
+		flows = append(flows, flow.flow)
+	}
+	sort.Slice(flows, func(i, j int) bool { return *flows[i].Name < *flows[j].Name })
+
+	entities := paginate(flows, query)
+	writeJSON(w, http.StatusOK, platformclientv2.Flowentitylisting{Entities: &entities, PageCount: pageCount(len(flows), query)})
+}
+
+func (m *ArchitectMock) registerFlowJob(w http.ResponseWriter) {
+	jobId := uuid.NewString()
+	status := "Registered"
+	m.jobs[jobId] = &platformclientv2.Architectjobstateresponse{Id: &jobId, Status: &status}
+
+	presignedUrl := m.URL + "/uploads/flows/" + jobId
+	headers := map[string]string{"Content-Type": "application/yaml"}
+	writeJSON(w, http.StatusOK, platformclientv2.Registerarchitectjobresponse{Id: &jobId, PresignedUrl: &presignedUrl, Headers: &headers})
+}
+
+// uploadFlow publishes the uploaded YAML as a new version of the flow with the same name and type
+func (m *ArchitectMock) uploadFlow(w http.ResponseWriter, r *http.Request, jobId string) {
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	job, ok := m.jobs[jobId]
+	if !ok {
+		writeError(w, http.StatusForbidden, "presigned url is not valid")
+		return
+	}
+
+	flowType, flowName, err := parseFlowConfig(string(content))
+	if err != nil {
+		status := "Failure"
+		text := err.Error()
+		job.Status = &status
+		job.Messages = &[]platformclientv2.Architectjobmessage{{Text: &text}}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var flow *mockFlow
+	for _, f := range m.flows {
+		if strings.EqualFold(*f.flow.Name, flowName) && *f.flow.VarType == flowType {
+			flow = f
+			break
+		}
+	}
+	if flow == nil {
+		flowId := uuid.NewString()
+		flow = &mockFlow{
+			flow: platformclientv2.Flow{Id: &flowId, Name: &flowName, VarType: &flowType},
+		}
+		m.flows[flowId] = flow
+	}
+
+	flow.latestVersion++
+	versionId := fmt.Sprintf("%d.0", flow.latestVersion)
+	dateCreated := int(time.Now().UnixMilli())
+	version := platformclientv2.Flowversion{Id: &versionId, Name: &flowName, DateCreated: &dateCreated}
+	flow.versions = append([]platformclientv2.Flowversion{version}, flow.versions...)
+	flow.flow.PublishedVersion = &version
+
+	status := "Success"
+	job.Status = &status
+	job.Flow = &platformclientv2.Addressableentityref{Id: flow.flow.Id}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (m *ArchitectMock) flowAction(w http.ResponseWriter, action, flowId, versionId string) {
+	flow, ok := m.flows[flowId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "flow not found")
+		return
+	}
+
+	switch action {
+	case "publish":
+		for i := range flow.versions {
+			if *flow.versions[i].Id == versionId {
+				flow.flow.PublishedVersion = &flow.versions[i]
+				writeJSON(w, http.StatusOK, platformclientv2.Operation{Id: &flowId})
+				return
+			}
+		}
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("version %s does not exist", versionId))
+	case "unlock", "checkout", "checkin", "revert", "deactivate":
+		writeJSON(w, http.StatusOK, flow.flow)
+	default:
+		writeError(w, http.StatusNotFound, "action not found")
+	}
+}
+
+func (m *ArchitectMock) handlePrompts(w http.ResponseWriter, r *http.Request) {
+	segments := pathSegments(r.URL.Path, "/api/v2/architect/prompts")
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		var prompts []platformclientv2.Prompt
+		for _, prompt := range m.prompts {
+			if name := r.URL.Query().Get("name"); name != "" && !strings.EqualFold(*prompt.Name, name) {
+				continue
+			}
+			prompts = append(prompts, *prompt)
+		}
+		sort.Slice(prompts, func(i, j int) bool { return *prompts[i].Name < *prompts[j].Name })
+		entities := paginate(prompts, r.URL.Query())
+		writeJSON(w, http.StatusOK, platformclientv2.Promptentitylisting{Entities: &entities, PageCount: pageCount(len(prompts), r.URL.Query())})
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var prompt platformclientv2.Prompt
+		if err := json.NewDecoder(r.Body).Decode(&prompt); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		promptId := uuid.NewString()
+		prompt.Id = &promptId
+		prompt.Resources = &[]platformclientv2.Promptasset{}
+		m.prompts[promptId] = &prompt
+		writeJSON(w, http.StatusOK, prompt)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		prompt, ok := m.prompts[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "prompt not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, prompt)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		prompt, ok := m.prompts[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "prompt not found")
+			return
+		}
+		var update platformclientv2.Prompt
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		prompt.Name = update.Name
+		prompt.Description = update.Description
+		writeJSON(w, http.StatusOK, prompt)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if _, ok := m.prompts[segments[0]]; !ok {
+			writeError(w, http.StatusNotFound, "prompt not found")
+			return
+		}
+		delete(m.prompts, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 2 && segments[1] == "resources" && r.Method == http.MethodPost:
+		m.savePromptResource(w, r, segments[0], "")
+	case len(segments) == 3 && segments[1] == "resources" && r.Method == http.MethodPut:
+		m.savePromptResource(w, r, segments[0], segments[2])
+	case len(segments) == 3 && segments[1] == "resources" && r.Method == http.MethodGet:
+		prompt, ok := m.prompts[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "prompt not found")
+			return
+		}
+		for _, resource := range *prompt.Resources {
+			if *resource.Language == segments[2] {
+				writeJSON(w, http.StatusOK, resource)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "prompt resource not found")
+	default:
+		writeError(w, http.StatusNotFound, "resource not found")
+	}
+}
+
+// savePromptResource creates or replaces the resource of a prompt for a language and hands out its upload URI
+func (m *ArchitectMock) savePromptResource(w http.ResponseWriter, r *http.Request, promptId, language string) {
+	prompt, ok := m.prompts[promptId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "prompt not found")
+		return
+	}
+
+	var resource platformclientv2.Promptasset
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if language != "" {
+		resource.Language = &language
+	}
+	if resource.Language == nil {
+		writeError(w, http.StatusBadRequest, "language is required")
+		return
+	}
+
+	resourceId := promptId + "-" + *resource.Language
+	uploadUri := m.URL + "/uploads/prompts/" + resourceId
+	status := "created"
+	resource.Id = &resourceId
+	resource.PromptId = &promptId
+	resource.UploadUri = &uploadUri
+	resource.UploadStatus = &status
+
+	resources := *prompt.Resources
+	for i, existing := range resources {
+		if *existing.Language == *resource.Language {
+			resource.MediaUri = existing.MediaUri
+			resource.UploadStatus = existing.UploadStatus
+			resources[i] = resource
+			writeJSON(w, http.StatusOK, resource)
+			return
+		}
+	}
+	resources = append(resources, resource)
+	prompt.Resources = &resources
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// uploadPromptResource stores an uploaded audio file. Transcoding completes immediately.
+func (m *ArchitectMock) uploadPromptResource(w http.ResponseWriter, r *http.Request, resourceId string) {
+	if _, _, err := r.FormFile("file"); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, prompt := range m.prompts {
+		for i, resource := range *prompt.Resources {
+			if *resource.Id != resourceId {
+				continue
+			}
+			status := "transcoded"
+			mediaUri := m.URL + "/uploads/prompts/" + resourceId + "/media"
+			(*prompt.Resources)[i].UploadStatus = &status
+			(*prompt.Resources)[i].MediaUri = &mediaUri
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	writeError(w, http.StatusForbidden, "upload uri is not valid")
+}
+
+func (m *ArchitectMock) handleScripts(w http.ResponseWriter, r *http.Request) {
+	segments := pathSegments(r.URL.Path, "/api/v2/scripts")
+	query := r.URL.Query()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		m.listScripts(w, query, false)
+	case len(segments) == 1 && segments[0] == "published" && r.Method == http.MethodGet:
+		m.listScripts(w, query, true)
+	case len(segments) == 1 && segments[0] == "published" && r.Method == http.MethodPost:
+		var body platformclientv2.Publishscriptrequestdata
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.ScriptId == nil {
+			writeError(w, http.StatusBadRequest, "scriptId is required")
+			return
+		}
+		script, ok := m.scripts[*body.ScriptId]
+		if !ok {
+			writeError(w, http.StatusNotFound, "script not found")
+			return
+		}
+		now := time.Now()
+		script.published = true
+		script.script.PublishedDate = &now
+		writeJSON(w, http.StatusOK, script.script)
+	case len(segments) == 2 && segments[0] == "published" && r.Method == http.MethodGet:
+		script, ok := m.scripts[segments[1]]
+		if !ok || !script.published {
+			writeError(w, http.StatusNotFound, "published script not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, script.script)
+	case len(segments) == 3 && segments[0] == "uploads" && segments[2] == "status" && r.Method == http.MethodGet:
+		status, ok := m.uploads[segments[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "upload not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, status)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		script, ok := m.scripts[segments[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "script not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, script.script)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if _, ok := m.scripts[segments[0]]; !ok {
+			writeError(w, http.StatusNotFound, "script not found")
+			return
+		}
+		delete(m.scripts, segments[0])
+		w.WriteHeader(http.StatusOK)
+	case len(segments) == 2 && segments[1] == "export" && r.Method == http.MethodPost:
+		if _, ok := m.scripts[segments[0]]; !ok {
+			writeError(w, http.StatusNotFound, "script not found")
+			return
+		}
+		exportUrl := m.URL + "/uploads/scripts/" + segments[0] + "/export"
+		writeJSON(w, http.StatusOK, platformclientv2.Exportscriptresponse{Url: &exportUrl})
+	default:
+		writeError(w, http.StatusNotFound, "resource not found")
+	}
+}
+
+func (m *ArchitectMock) listScripts(w http.ResponseWriter, query map[string][]string, publishedOnly bool) {
+	var scripts []platformclientv2.Script
+	for _, script := range m.scripts {
+		if publishedOnly && !script.published {
+			continue
+		}
+		if names, ok := query["name"]; ok && names[0] != "" && !strings.Contains(strings.ToLower(*script.script.Name), strings.ToLower(names[0])) {
+			continue
+		}
+		scripts = append(scripts, script.script)
+	}
+	sort.Slice(scripts, func(i, j int) bool { return *scripts[i].Name < *scripts[j].Name })
+
+	entities := paginate(scripts, query)
+	writeJSON(w, http.StatusOK, platformclientv2.Scriptentitylisting{Entities: &entities, PageCount: pageCount(len(scripts), query)})
+}
+
+// uploadScript imports a script sent as multipart form data to the scripter upload endpoint
+func (m *ArchitectMock) uploadScript(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The script may be sent either as a file part or as a plain form field
+	content := []byte(r.FormValue("file"))
+	if file, _, err := r.FormFile("file"); err == nil {
+		defer file.Close()
+		if content, err = io.ReadAll(file); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if len(content) == 0 {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+
+	scriptName := r.FormValue("scriptName")
+	scriptId := r.FormValue("scriptIdToReplace")
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	uploadId := uuid.NewString()
+	succeeded := scriptName != ""
+	m.uploads[uploadId] = &platformclientv2.Importscriptstatusresponse{Succeeded: &succeeded}
+	if succeeded {
+		now := time.Now()
+		script, ok := m.scripts[scriptId]
+		if !ok {
+			scriptId = uuid.NewString()
+			script = &mockScript{script: platformclientv2.Script{Id: &scriptId, CreatedDate: &now}}
+			m.scripts[scriptId] = script
+		}
+		script.script.Name = &scriptName
+		script.script.ModifiedDate = &now
+		script.content = string(content)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"correlationId": uploadId})
+}
+
+// parseFlowConfig reads the flow type and name from a flow configuration file, e.g. inboundCall and its name attribute
+func parseFlowConfig(content string) (string, string, error) {
+	typeMatch := flowTypeRegex.FindStringSubmatchIndex(content)
+	if typeMatch == nil {
+		return "", "", fmt.Errorf("flow configuration does not define a flow type")
+	}
+	flowType := strings.ToLower(content[typeMatch[2]:typeMatch[3]])
+
+	nameMatch := flowNameRegex.FindStringSubmatch(content[typeMatch[1]:])
+	if nameMatch == nil {
+		return "", "", fmt.Errorf("flow configuration does not define a name")
+	}
+	flowName := strings.Trim(nameMatch[1], `"'`)
+	if strings.Contains(flowName, "{{") {
+		return "", "", fmt.Errorf("flow name %s contains an unresolved substitution", flowName)
+	}
+	return flowType, flowName, nil
+}
+
+// pathSegments returns the non-empty path segments after prefix
+func pathSegments(path, prefix string) []string {
+	var segments []string
+	for _, segment := range strings.Split(strings.TrimPrefix(path, prefix), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+func paginate[T any](items []T, query map[string][]string) []T {
+	pageNumber, pageSize := pageParams(query)
+	start := (pageNumber - 1) * pageSize
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+func pageCount(total int, query map[string][]string) *int {
+	_, pageSize := pageParams(query)
+	count := (total + pageSize - 1) / pageSize
+	return &count
+}
+
+func pageParams(query map[string][]string) (int, int) {
+	pageNumber, pageSize := 1, 25
+	if v, ok := query["pageNumber"]; ok {
+		if n, err := strconv.Atoi(v[0]); err == nil && n > 0 {
+			pageNumber = n
+		}
+	}
+	if v, ok := query["pageSize"]; ok {
+		if n, err := strconv.Atoi(v[0]); err == nil && n > 0 {
+			pageSize = n
+		}
+	}
+	return pageNumber, pageSize
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if strings.EqualFold(item, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("Architect mock failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"status":  statusCode,
+		"code":    strings.ToLower(strings.ReplaceAll(http.StatusText(statusCode), " ", ".")),
+		"message": message,
+	})
+}
@@ -0,0 +1,208 @@
+package fileserver_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/scripts"
+	"terraform-provider-genesyscloud/genesyscloud/util/fileserver"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+These acceptance tests run the flow, script and user prompt resources against the architect mock instead of a live
+org, so they need no credentials or network access. The SDK client pool is created once per test binary from the
+first provider configuration, which is why they live here rather than next to the resources, whose tests run
+against a live org.
+*/
+
+const testDataPath = "../../../test/data"
+
+var mockProviderResources = map[string]*schema.Resource{
+	"genesyscloud_flow":                  gcloud.ResourceFlow(),
+	"genesyscloud_architect_user_prompt": gcloud.ResourceArchitectUserPrompt(),
+	"genesyscloud_script":                scripts.ResourceScript(),
+}
+
+// startArchitectMock starts the mock and points the provider at it through GENESYSCLOUD_API_BASE_PATH
+func startArchitectMock(t *testing.T) *fileserver.ArchitectMock {
+	mock := fileserver.StartArchitectMock("")
+	t.Cleanup(mock.Close)
+
+	t.Setenv("GENESYSCLOUD_API_BASE_PATH", mock.URL)
+	t.Setenv("GENESYSCLOUD_OAUTHCLIENT_ID", "mock-client")
+	t.Setenv("GENESYSCLOUD_OAUTHCLIENT_SECRET", "mock-secret")
+	t.Setenv("GENESYSCLOUD_REGION", "us-east-1")
+	return mock
+}
+
+func mockProviderFactories() map[string]func() (*schema.Provider, error) {
+	return gcloud.GetProviderFactories(mockProviderResources, map[string]*schema.Resource{})
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAccResourceArchFlowArchitectMock(t *testing.T) {
+	startArchitectMock(t)
+
+	var (
+		flowResource = "test_flow"
+		flowName     = "Terraform Mock Flow"
+		flowConfig   = "inboundCall:\n  name: %s\n  description: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n"
+		filePath1    = writeTestFile(t, "flow1.yaml", fmt.Sprintf(flowConfig, flowName, "description 1"))
+		filePath2    = writeTestFile(t, "flow2.yaml", fmt.Sprintf(flowConfig, flowName, "description 2"))
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: mockProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Create flow
+				Config: gcloud.GenerateFlowResource(flowResource, filePath1, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_flow."+flowResource, "published_version", "1.0"),
+					resource.TestCheckResourceAttr("genesyscloud_flow."+flowResource, "versions.#", "1"),
+				),
+			},
+			{
+				// Uploading a changed file publishes a new version
+				Config: gcloud.GenerateFlowResource(flowResource, filePath2, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_flow."+flowResource, "published_version", "2.0"),
+					resource.TestCheckResourceAttr("genesyscloud_flow."+flowResource, "versions.#", "2"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_flow." + flowResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filepath", "force_unlock", "file_content_hash"},
+			},
+		},
+		CheckDestroy: verifyMockEntitiesDestroyed("genesyscloud_flow", func(config *platformclientv2.Configuration, id string) (*platformclientv2.APIResponse, error) {
+			_, resp, err := platformclientv2.NewArchitectApiWithConfig(config).GetFlow(id, false)
+			return resp, err
+		}),
+	})
+}
+
+func TestAccResourceScriptArchitectMock(t *testing.T) {
+	startArchitectMock(t)
+
+	var (
+		scriptResource = "script"
+		scriptName     = "Terraform Mock Script"
+		filePath, _    = filepath.Abs(filepath.Join(testDataPath, "resource", "genesyscloud_script", "test_script.json"))
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: mockProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "genesyscloud_script" "%s" {
+	script_name       = "%s"
+	filepath          = "%s"
+	file_content_hash = filesha256("%s")
+}
+`, scriptResource, scriptName, filePath, filePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_script."+scriptResource, "script_name", scriptName),
+					resource.TestCheckResourceAttrSet("genesyscloud_script."+scriptResource, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_script." + scriptResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filepath", "file_content_hash", "substitutions"},
+			},
+		},
+		CheckDestroy: verifyMockEntitiesDestroyed("genesyscloud_script", func(config *platformclientv2.Configuration, id string) (*platformclientv2.APIResponse, error) {
+			_, resp, err := platformclientv2.NewScriptsApiWithConfig(config).GetScript(id)
+			return resp, err
+		}),
+	})
+}
+
+func TestAccResourceUserPromptArchitectMock(t *testing.T) {
+	startArchitectMock(t)
+
+	var (
+		promptResource = "test-user_prompt"
+		promptName     = "TerraformMockPrompt"
+		promptFile     = filepath.Join(testDataPath, "test-prompt-01.wav")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: mockProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Create a prompt with an uploaded audio file
+				Config: gcloud.GenerateUserPromptResource(&gcloud.UserPromptStruct{
+					ResourceID:  promptResource,
+					Name:        promptName,
+					Description: strconv.Quote("Prompt served by the architect mock"),
+					Resources: []*gcloud.UserPromptResourceStruct{
+						{
+							Language:        "en-us",
+							Tts_string:      gcloud.NullValue,
+							Text:            gcloud.NullValue,
+							Filename:        strconv.Quote(promptFile),
+							FileContentHash: promptFile,
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_architect_user_prompt."+promptResource, "name", promptName),
+					resource.TestCheckResourceAttr("genesyscloud_architect_user_prompt."+promptResource, "resources.0.language", "en-us"),
+				),
+			},
+		},
+		CheckDestroy: verifyMockEntitiesDestroyed("genesyscloud_architect_user_prompt", func(config *platformclientv2.Configuration, id string) (*platformclientv2.APIResponse, error) {
+			_, resp, err := platformclientv2.NewArchitectApiWithConfig(config).GetArchitectPrompt(id)
+			return resp, err
+		}),
+	})
+}
+
+// verifyMockEntitiesDestroyed checks that every resource of resourceType in the state is gone from the mock
+func verifyMockEntitiesDestroyed(resourceType string, get func(*platformclientv2.Configuration, string) (*platformclientv2.APIResponse, error)) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		config, err := gcloud.AuthorizeSdk()
+		if err != nil {
+			return err
+		}
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			resp, err := get(config, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if !gcloud.IsStatus404(resp) {
+				return fmt.Errorf("unexpected error reading %s %s: %s", resourceType, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package fileserver
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const mockFlowYaml = `inboundCall:
+  name: {{name}}
+  defaultLanguage: en-us
+  startUpRef: ./menus/menu[mainMenu]
+`
+
+func newMockConfig(t *testing.T, mock *ArchitectMock) *platformclientv2.Configuration {
+	config := platformclientv2.GetDefaultConfiguration()
+	config.BasePath = mock.URL
+	if err := config.AuthorizeClientCredentials("client", "secret"); err != nil {
+		t.Fatalf("failed to authorize against the architect mock: %v", err)
+	}
+	return config
+}
+
+// uploadMockFlow registers a job, uploads the flow through the presigned URL and returns the job state
+func uploadMockFlow(t *testing.T, architectAPI *platformclientv2.ArchitectApi, name string) *platformclientv2.Architectjobstateresponse {
+	job, _, err := architectAPI.PostFlowsJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	substitutions := map[string]interface{}{"name": name}
+	uploader := files.NewS3Uploader(strings.NewReader(mockFlowYaml), nil, substitutions, *job.Headers, http.MethodPut, *job.PresignedUrl)
+	if _, err := uploader.Upload(); err != nil {
+		t.Fatal(err)
+	}
+
+	state, _, err := architectAPI.GetFlowsJob(*job.Id, []string{"messages"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// TestArchitectMockFlowLifecycle drives the flow job, version and publish endpoints through the SDK
+func TestArchitectMockFlowLifecycle(t *testing.T) {
+	mock := StartArchitectMock("")
+	defer mock.Close()
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(newMockConfig(t, mock))
+
+	state := uploadMockFlow(t, architectAPI, "Mock Flow")
+	if *state.Status != "Success" {
+		t.Fatalf("expected job status Success, got %s", *state.Status)
+	}
+	flowId := *state.Flow.Id
+
+	// A second upload of the same flow creates a new version of the existing flow
+	state = uploadMockFlow(t, architectAPI, "Mock Flow")
+	if *state.Flow.Id != flowId {
+		t.Fatalf("expected upload to update flow %s, got %s", flowId, *state.Flow.Id)
+	}
+
+	flow, _, err := architectAPI.GetFlow(flowId, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *flow.VarType != "inboundcall" || *flow.PublishedVersion.Id != "2.0" {
+		t.Errorf("expected published inboundcall version 2.0, got %s version %s", *flow.VarType, *flow.PublishedVersion.Id)
+	}
+
+	if _, _, err := architectAPI.PostFlowsActionsPublish(flowId, "1.0"); err != nil {
+		t.Fatal(err)
+	}
+	versions, _, err := architectAPI.GetFlowVersions(flowId, 1, 25, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*versions.Entities) != 2 {
+		t.Errorf("expected 2 versions, got %d", len(*versions.Entities))
+	}
+
+	flows, _, err := architectAPI.GetFlows(nil, 1, 25, "", "", nil, "Mock Flow", "", "", "", "", "", "", "", false, false, "", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*flows.Entities) != 1 || *(*flows.Entities)[0].PublishedVersion.Id != "1.0" {
+		t.Errorf("expected a single flow with published version 1.0")
+	}
+
+	if _, err := architectAPI.DeleteFlow(flowId); err != nil {
+		t.Fatal(err)
+	}
+	if _, resp, _ := architectAPI.GetFlow(flowId, false); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+// TestArchitectMockFlowJobFailure verifies that an invalid flow configuration fails the job with messages
+func TestArchitectMockFlowJobFailure(t *testing.T) {
+	mock := StartArchitectMock("")
+	defer mock.Close()
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(newMockConfig(t, mock))
+
+	job, _, err := architectAPI.PostFlowsJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uploader := files.NewS3Uploader(strings.NewReader("not a flow"), nil, nil, *job.Headers, http.MethodPut, *job.PresignedUrl)
+	if _, err := uploader.Upload(); err != nil {
+		t.Fatal(err)
+	}
+
+	state, _, err := architectAPI.GetFlowsJob(*job.Id, []string{"messages"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *state.Status != "Failure" || state.Messages == nil || len(*state.Messages) == 0 {
+		t.Errorf("expected failed job with tracing messages")
+	}
+}
+
+// TestArchitectMockPrompt verifies that a prompt resource is transcoded once its audio file is uploaded
+func TestArchitectMockPrompt(t *testing.T) {
+	mock := StartArchitectMock("")
+	defer mock.Close()
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(newMockConfig(t, mock))
+
+	name := "MockPrompt"
+	prompt, _, err := architectAPI.PostArchitectPrompts(platformclientv2.Prompt{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	language := "en-us"
+	resource, _, err := architectAPI.PostArchitectPromptResources(*prompt.Id, platformclientv2.Promptassetcreate{Language: &language})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "prompt.wav")
+	_, _ = io.WriteString(part, "audio")
+	writer.Close()
+	resp, err := http.Post(*resource.UploadUri, writer.FormDataContentType(), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	prompt, _, err = architectAPI.GetArchitectPrompt(*prompt.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := *(*prompt.Resources)[0].UploadStatus; status != "transcoded" {
+		t.Errorf("expected upload status transcoded, got %s", status)
+	}
+}
+
+// TestArchitectMockScript verifies the scripter upload, upload status and publish endpoints
+func TestArchitectMockScript(t *testing.T) {
+	mock := StartArchitectMock("")
+	defer mock.Close()
+	config := newMockConfig(t, mock)
+	scriptsAPI := platformclientv2.NewScriptsApiWithConfig(config)
+
+	formData := map[string]io.Reader{
+		"file":       strings.NewReader(`{"name": "{{name}}"}`),
+		"scriptName": strings.NewReader("Mock Script"),
+	}
+	headers := map[string]string{"Authorization": "Bearer " + config.AccessToken}
+	uploader := files.NewS3Uploader(nil, formData, nil, headers, http.MethodPost, mock.URL+"/uploads/v2/scripter")
+	if _, err := uploader.Upload(); err != nil {
+		t.Fatal(err)
+	}
+
+	scripts, _, err := scriptsAPI.GetScripts(50, 1, "", "Mock Script", "", "", "", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*scripts.Entities) != 1 {
+		t.Fatalf("expected 1 script, got %d", len(*scripts.Entities))
+	}
+	scriptId := *(*scripts.Entities)[0].Id
+
+	if _, resp, _ := scriptsAPI.GetScriptsPublishedScriptId(scriptId, ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected unpublished script to return 404, got %d", resp.StatusCode)
+	}
+	if _, _, err := scriptsAPI.PostScriptsPublished("0", platformclientv2.Publishscriptrequestdata{ScriptId: &scriptId}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := scriptsAPI.GetScriptsPublishedScriptId(scriptId, ""); err != nil {
+		t.Errorf("expected published script, got %v", err)
+	}
+}
@@ -0,0 +1,468 @@
+/*
+proxyfakegen generates an in-memory fake for a resource package's proxy struct, e.g. teamProxy. Every field of
+the proxy that ends in Attr and has a func type declared in the package is given an implementation backed by a
+proxyfake.Store. Operations are classified by name and signature:
+
+  - create<X>(..., body *T) (*T, ...)      stores the body under a new ID
+  - update<X>(..., id string, body *T)     replaces the entity stored under id, or fails with a 404
+  - delete<X>(..., id string)              removes the entity stored under id, or fails with a 404
+  - get<X>IdByName(..., name string)       looks up an entity ID by name, retryable if not found
+  - get<X>(..., id string) (*T, ...)       returns the entity stored under id, or fails with a 404
+  - any operation returning *[]T or []T    lists the stored entities of type T, filtered by a name param if any
+
+Operations on child entities, e.g. the members of a team, are not stored. A list operation that takes an ID is
+taken to list the children of that ID, so it is not supported either. Any other operation returns
+proxyfake.NotSupportedError and should be stubbed by the test. Usage:
+
+	//go:generate go run terraform-provider-genesyscloud/genesyscloud/util/proxyfake/proxyfakegen -type teamProxy
+
+The fake is written to <file>_fake_test.go next to the file containing the directive.
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	sdkPackage       = "platformclientv2"
+	proxyfakePackage = "terraform-provider-genesyscloud/genesyscloud/util/proxyfake"
+)
+
+type param struct {
+	name    string
+	typeStr string
+	expr    ast.Expr
+}
+
+type operation struct {
+	name    string
+	field   string
+	params  []param
+	results []param
+}
+
+type generator struct {
+	fset     *token.FileSet
+	pkgName  string
+	typeName string
+	imports  map[string]string
+	used     map[string]bool
+}
+
+func main() {
+	typeName := flag.String("type", "", "name of the proxy struct, e.g. teamProxy")
+	output := flag.String("output", "", "output file name; defaults to <GOFILE>_fake_test.go")
+	flag.Parse()
+
+	if *typeName == "" {
+		log.Fatal("proxyfakegen: -type is required")
+	}
+
+	outputFile := *output
+	if outputFile == "" {
+		goFile := os.Getenv("GOFILE")
+		if goFile == "" {
+			log.Fatal("proxyfakegen: -output is required when not run through go generate")
+		}
+		outputFile = strings.TrimSuffix(goFile, ".go") + "_fake_test.go"
+	}
+
+	source, err := generate(".", *typeName)
+	if err != nil {
+		log.Fatalf("proxyfakegen: %v", err)
+	}
+	if err := os.WriteFile(outputFile, source, 0644); err != nil {
+		log.Fatalf("proxyfakegen: %v", err)
+	}
+	fmt.Printf("Generated %s\n", outputFile)
+}
+
+// generate parses the package in dir and returns the formatted source of the fake for typeName
+func generate(dir, typeName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %s, found %d", dir, len(pkgs))
+	}
+
+	g := &generator{fset: fset, typeName: typeName, imports: make(map[string]string), used: make(map[string]bool)}
+	var pkg *ast.Package
+	for name, p := range pkgs {
+		g.pkgName, pkg = name, p
+	}
+
+	funcTypes := make(map[string]*ast.FuncType)
+	var proxyStruct *ast.StructType
+	for _, file := range pkg.Files {
+		for _, imp := range file.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			name := filepath.Base(path)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			g.imports[name] = path
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			switch t := spec.Type.(type) {
+			case *ast.FuncType:
+				funcTypes[spec.Name.Name] = t
+			case *ast.StructType:
+				if spec.Name.Name == typeName {
+					proxyStruct = t
+				}
+			}
+			return false
+		})
+	}
+	if proxyStruct == nil {
+		return nil, fmt.Errorf("type %s not found", typeName)
+	}
+
+	var operations []operation
+	for _, field := range proxyStruct.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		funcType, ok := funcTypes[ident.Name]
+		if !ok {
+			continue
+		}
+		for _, name := range field.Names {
+			if !strings.HasSuffix(name.Name, "Attr") {
+				continue
+			}
+			operations = append(operations, operation{
+				name:    strings.TrimSuffix(name.Name, "Attr"),
+				field:   name.Name,
+				params:  g.fieldList(funcType.Params, "arg"),
+				results: g.fieldList(funcType.Results, "result"),
+			})
+		}
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("type %s has no Attr fields with func types", typeName)
+	}
+
+	body := g.generateFake(operations)
+	return g.assemble(body)
+}
+
+func (g *generator) fieldList(fields *ast.FieldList, prefix string) []param {
+	var params []param
+	if fields == nil {
+		return params
+	}
+	for _, field := range fields.List {
+		typeStr := g.exprString(field.Type)
+		if len(field.Names) == 0 {
+			params = append(params, param{name: fmt.Sprintf("%s%d", prefix, len(params)), typeStr: typeStr, expr: field.Type})
+			continue
+		}
+		for _, name := range field.Names {
+			params = append(params, param{name: name.Name, typeStr: typeStr, expr: field.Type})
+		}
+	}
+	return params
+}
+
+// exprString renders a type expression and records the packages it references
+func (g *generator) exprString(expr ast.Expr) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				g.used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	_ = format.Node(&buf, g.fset, expr)
+	return buf.String()
+}
+
+func (g *generator) fakeName() string {
+	return g.typeName + "Fake"
+}
+
+func (g *generator) constructorName() string {
+	runes := []rune(g.typeName)
+	runes[0] = unicode.ToUpper(runes[0])
+	return "new" + string(runes) + "Fake"
+}
+
+func (g *generator) generateFake(operations []operation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s is an in-memory fake of %s. Its Store holds the entities and the simulated errors.\n", g.fakeName(), g.typeName)
+	fmt.Fprintf(&b, "type %s struct {\n\t*proxyfake.Store\n\tproxy *%s\n}\n\n", g.fakeName(), g.typeName)
+	fmt.Fprintf(&b, "// %s returns a fake whose proxy can be assigned to internalProxy in unit tests\n", g.constructorName())
+	fmt.Fprintf(&b, "func %s() *%s {\n", g.constructorName(), g.fakeName())
+	fmt.Fprintf(&b, "\tf := &%s{Store: proxyfake.NewStore(), proxy: &%s{}}\n", g.fakeName(), g.typeName)
+	for _, op := range operations {
+		fmt.Fprintf(&b, "\tf.proxy.%s = f.%s\n", op.field, op.name)
+	}
+	b.WriteString("\treturn f\n}\n")
+
+	for _, op := range operations {
+		b.WriteString("\n")
+		b.WriteString(g.generateOperation(op))
+	}
+	g.used["proxyfake"] = true
+	g.imports["proxyfake"] = proxyfakePackage
+	return b.String()
+}
+
+func (g *generator) generateOperation(op operation) string {
+	var b strings.Builder
+	var params []string
+	for _, p := range op.params {
+		params = append(params, p.name+" "+p.typeStr)
+	}
+	var results []string
+	for _, r := range op.results {
+		results = append(results, r.typeStr)
+	}
+	resultStr := strings.Join(results, ", ")
+	if len(results) > 1 {
+		resultStr = "(" + resultStr + ")"
+	}
+
+	fmt.Fprintf(&b, "func (f *%s) %s(%s) %s {\n", g.fakeName(), op.name, strings.Join(params, ", "), resultStr)
+	statusCodeVar := "_"
+	if returnsStatusCode(op) {
+		statusCodeVar = "statusCode"
+	}
+	fmt.Fprintf(&b, "\tif %s, err := f.NextError(%q); err != nil {\n", statusCodeVar, op.name)
+	fmt.Fprintf(&b, "\t\treturn %s\n\t}\n", g.returnValues(op, "", "statusCode", "err", `""`, "true"))
+
+	// Operations on child entities, e.g. deleteMembers(teamId, memberId) or getMembersById(teamId), are left to the
+	// test to stub
+	idParams := filterParams(op.params, func(p param) bool {
+		return p.typeStr == "string" && strings.HasSuffix(strings.ToLower(p.name), "id")
+	})
+	var idParam *param
+	if len(idParams) == 1 {
+		idParam = &idParams[0]
+	}
+	nameParam := findParam(op.params, func(p param) bool {
+		return p.typeStr == "string" && strings.HasSuffix(strings.ToLower(p.name), "name")
+	})
+	bodyParam := findParam(op.params, func(p param) bool {
+		return strings.HasPrefix(strings.TrimPrefix(p.typeStr, "*"), sdkPackage+".")
+	})
+	entityType, isList, isPointerList := g.entityType(op)
+
+	switch {
+	case isList && len(idParams) == 0:
+		filter := `""`
+		if nameParam != nil {
+			filter = nameParam.name
+		}
+		fmt.Fprintf(&b, "\tfakeEntities := make([]%s, 0)\n", entityType)
+		fmt.Fprintf(&b, "\tfor _, fakeEntity := range f.List(%s) {\n", filter)
+		fmt.Fprintf(&b, "\t\tif fakeValue, isType := fakeEntity.(*%s); isType {\n\t\t\tfakeEntities = append(fakeEntities, *fakeValue)\n\t\t}\n\t}\n", entityType)
+		entityExpr := "fakeEntities"
+		if isPointerList {
+			entityExpr = "&fakeEntities"
+		}
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, entityExpr, "http.StatusOK", "nil", `""`, "false"))
+
+	case strings.HasPrefix(op.name, "get") && strings.HasSuffix(op.name, "IdByName") && nameParam != nil:
+		fmt.Fprintf(&b, "\tfakeId, found := f.IdByName(%s)\n", nameParam.name)
+		fmt.Fprintf(&b, "\tif !found {\n\t\treturn %s\n\t}\n", g.returnValues(op, "", "http.StatusNotFound", fmt.Sprintf("proxyfake.NotFoundError(%s)", nameParam.name), `""`, "true"))
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, "", "http.StatusOK", "nil", "fakeId", "false"))
+
+	case strings.HasPrefix(op.name, "create") && bodyParam != nil && entityType != "" && bodyType(bodyParam) == entityType:
+		fmt.Fprintf(&b, "\tfakeId := f.Create(%s)\n", pointerExpr(bodyParam))
+		fmt.Fprintf(&b, "\tfakeEntity, _ := f.Get(fakeId)\n")
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, fmt.Sprintf("fakeEntity.(*%s)", entityType), "http.StatusOK", "nil", "fakeId", "false"))
+
+	case strings.HasPrefix(op.name, "update") && bodyParam != nil && idParam != nil && entityType != "" && bodyType(bodyParam) == entityType:
+		fmt.Fprintf(&b, "\tif !f.Update(%s, %s) {\n", idParam.name, pointerExpr(bodyParam))
+		fmt.Fprintf(&b, "\t\treturn %s\n\t}\n", g.returnValues(op, "", "http.StatusNotFound", fmt.Sprintf("proxyfake.NotFoundError(%s)", idParam.name), `""`, "false"))
+		fmt.Fprintf(&b, "\tfakeEntity, _ := f.Get(%s)\n", idParam.name)
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, fmt.Sprintf("fakeEntity.(*%s)", entityType), "http.StatusOK", "nil", idParam.name, "false"))
+
+	case strings.HasPrefix(op.name, "delete") && idParam != nil:
+		fmt.Fprintf(&b, "\tif !f.Delete(%s) {\n", idParam.name)
+		fmt.Fprintf(&b, "\t\treturn %s\n\t}\n", g.returnValues(op, "", "http.StatusNotFound", fmt.Sprintf("proxyfake.NotFoundError(%s)", idParam.name), `""`, "false"))
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, "", "http.StatusOK", "nil", idParam.name, "false"))
+
+	case strings.HasPrefix(op.name, "get") && idParam != nil && entityType != "" && !isList:
+		fmt.Fprintf(&b, "\tfakeEntity, found := f.Get(%s)\n", idParam.name)
+		fmt.Fprintf(&b, "\tfakeValue, isType := fakeEntity.(*%s)\n", entityType)
+		fmt.Fprintf(&b, "\tif !found || !isType {\n\t\treturn %s\n\t}\n", g.returnValues(op, "", "http.StatusNotFound", fmt.Sprintf("proxyfake.NotFoundError(%s)", idParam.name), `""`, "true"))
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, "fakeValue", "http.StatusOK", "nil", idParam.name, "false"))
+
+	default:
+		fmt.Fprintf(&b, "\treturn %s\n", g.returnValues(op, "", "http.StatusNotImplemented", fmt.Sprintf("proxyfake.NotSupportedError(%q)", op.name), `""`, "false"))
+	}
+	b.WriteString("}\n")
+	g.used["http"] = true
+	g.imports["http"] = "net/http"
+	return b.String()
+}
+
+// entityType returns the SDK type of the first result, and whether that result is a list
+func (g *generator) entityType(op operation) (typeStr string, isList bool, isPointerList bool) {
+	if len(op.results) == 0 {
+		return "", false, false
+	}
+	expr := op.results[0].expr
+	if star, ok := expr.(*ast.StarExpr); ok {
+		if array, ok := star.X.(*ast.ArrayType); ok {
+			if sel, ok := array.Elt.(*ast.SelectorExpr); ok {
+				return g.exprString(sel), true, true
+			}
+			return "", false, false
+		}
+		if sel, ok := star.X.(*ast.SelectorExpr); ok && isSdkSelector(sel) {
+			return g.exprString(sel), false, false
+		}
+		return "", false, false
+	}
+	if array, ok := expr.(*ast.ArrayType); ok {
+		if sel, ok := array.Elt.(*ast.SelectorExpr); ok {
+			return g.exprString(sel), true, false
+		}
+	}
+	return "", false, false
+}
+
+// returnValues builds the return statement values from the roles of the results of an operation
+func (g *generator) returnValues(op operation, entity, statusCode, err, id, retryable string) string {
+	var values []string
+	for i, r := range op.results {
+		switch {
+		case i == 0 && entity != "" && r.typeStr != "int" && r.typeStr != "error" && r.typeStr != "string" && r.typeStr != "bool":
+			values = append(values, entity)
+		case r.typeStr == "error":
+			values = append(values, err)
+		case r.typeStr == "int":
+			values = append(values, statusCode)
+		case r.typeStr == "*"+sdkPackage+".APIResponse":
+			values = append(values, fmt.Sprintf("&%s.APIResponse{StatusCode: %s}", sdkPackage, statusCode))
+		case r.typeStr == "string":
+			values = append(values, id)
+		case r.typeStr == "bool":
+			values = append(values, retryable)
+		case strings.HasPrefix(r.typeStr, "*") || strings.HasPrefix(r.typeStr, "[]") || strings.HasPrefix(r.typeStr, "map["):
+			values = append(values, "nil")
+		default:
+			values = append(values, fmt.Sprintf("*new(%s)", r.typeStr))
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+func (g *generator) assemble(body string) ([]byte, error) {
+	var names []string
+	for name := range g.used {
+		if _, ok := g.imports[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// Standard library imports go in their own group ahead of the module and third party imports
+	var stdImports, otherImports []string
+	for _, name := range names {
+		path := g.imports[name]
+		spec := fmt.Sprintf("%q", path)
+		if filepath.Base(path) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") || strings.HasPrefix(path, "terraform-provider-genesyscloud") {
+			otherImports = append(otherImports, spec)
+		} else {
+			stdImports = append(stdImports, spec)
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by proxyfakegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport (\n", g.pkgName)
+	for _, spec := range stdImports {
+		fmt.Fprintf(&b, "\t%s\n", spec)
+	}
+	if len(stdImports) > 0 && len(otherImports) > 0 {
+		b.WriteString("\n")
+	}
+	for _, spec := range otherImports {
+		fmt.Fprintf(&b, "\t%s\n", spec)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString(body)
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %v\n%s", err, b.String())
+	}
+	return source, nil
+}
+
+func returnsStatusCode(op operation) bool {
+	for _, r := range op.results {
+		if r.typeStr == "int" || r.typeStr == "*"+sdkPackage+".APIResponse" {
+			return true
+		}
+	}
+	return false
+}
+
+func filterParams(params []param, match func(param) bool) []param {
+	var matched []param
+	for i := range params {
+		// The first two params are the context and the proxy
+		if i >= 2 && match(params[i]) {
+			matched = append(matched, params[i])
+		}
+	}
+	return matched
+}
+
+func findParam(params []param, match func(param) bool) *param {
+	matched := filterParams(params, match)
+	if len(matched) == 0 {
+		return nil
+	}
+	return &matched[0]
+}
+
+func isSdkSelector(sel *ast.SelectorExpr) bool {
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == sdkPackage
+}
+
+func bodyType(p *param) string {
+	return strings.TrimPrefix(p.typeStr, "*")
+}
+
+func pointerExpr(p *param) string {
+	if strings.HasPrefix(p.typeStr, "*") {
+		return p.name
+	}
+	return "&" + p.name
+}
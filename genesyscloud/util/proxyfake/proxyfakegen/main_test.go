@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestGenerateTeamProxyFake verifies the operations generated for the team proxy
+func TestGenerateTeamProxyFake(t *testing.T) {
+	source, err := generate("../../../team", "teamProxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := string(source)
+
+	expected := []string{
+		"type teamProxyFake struct",
+		"func newTeamProxyFake() *teamProxyFake",
+		"fakeId := f.Create(team)",
+		"if !f.Update(id, team) {",
+		"if !f.Delete(id) {",
+		"fakeId, found := f.IdByName(name)",
+		`proxyfake.NotSupportedError("createMembers")`,
+		`proxyfake.NotSupportedError("getMembersById")`,
+		`proxyfake.NotSupportedError("deleteMembers")`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(generated, e) {
+			t.Errorf("expected generated fake to contain %q", e)
+		}
+	}
+}
+
+// TestGenerateUnknownType verifies that an error is returned for a type that does not exist
+func TestGenerateUnknownType(t *testing.T) {
+	if _, err := generate("../../../team", "unknownProxy"); err == nil {
+		t.Error("expected an error for an unknown proxy type")
+	}
+}
@@ -0,0 +1,187 @@
+package proxyfake
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mohae/deepcopy"
+)
+
+/*
+The proxyfake package holds the in-memory state behind the proxy fakes generated by proxyfakegen. Entities are
+stored by ID as deep copies, so a resource under test can never mutate the stored state through a returned
+pointer. Errors such as 404, 409 or 429 can be queued per proxy operation to simulate API failures.
+*/
+
+// Store keeps the entities of a fake proxy and the errors queued for its operations
+type Store struct {
+	mutex    sync.Mutex
+	entities map[string]interface{}
+	failures map[string][]int
+	calls    map[string]int
+}
+
+// NewStore returns an empty store
+func NewStore() *Store {
+	return &Store{
+		entities: make(map[string]interface{}),
+		failures: make(map[string][]int),
+		calls:    make(map[string]int),
+	}
+}
+
+// SimulateError queues statusCode to be returned by the next times calls to operation, e.g. "createTeam"
+func (s *Store) SimulateError(operation string, statusCode int, times int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := 0; i < times; i++ {
+		s.failures[operation] = append(s.failures[operation], statusCode)
+	}
+}
+
+// NextError records a call to operation and returns the next queued error for it, if any
+func (s *Store) NextError(operation string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.calls[operation]++
+	queued := s.failures[operation]
+	if len(queued) == 0 {
+		return http.StatusOK, nil
+	}
+	statusCode := queued[0]
+	s.failures[operation] = queued[1:]
+	return statusCode, fmt.Errorf("simulated %d %s error for %s", statusCode, http.StatusText(statusCode), operation)
+}
+
+// Calls returns the number of times operation has been called
+func (s *Store) Calls(operation string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.calls[operation]
+}
+
+// Create stores a copy of entity and returns its ID. A new ID is assigned if the entity does not have one.
+func (s *Store) Create(entity interface{}) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stored := deepcopy.Copy(entity)
+	id := stringField(stored, "Id")
+	if id == "" {
+		id = uuid.NewString()
+		setStringField(stored, "Id", id)
+	}
+	s.entities[id] = stored
+	return id
+}
+
+// Update replaces the entity stored under id. It returns false if no entity exists with that ID.
+func (s *Store) Update(id string, entity interface{}) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.entities[id]; !ok {
+		return false
+	}
+	stored := deepcopy.Copy(entity)
+	setStringField(stored, "Id", id)
+	s.entities[id] = stored
+	return true
+}
+
+// Get returns a copy of the entity stored under id
+func (s *Store) Get(id string) (interface{}, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entity, ok := s.entities[id]
+	if !ok {
+		return nil, false
+	}
+	return deepcopy.Copy(entity), true
+}
+
+// Delete removes the entity stored under id. It returns false if no entity exists with that ID.
+func (s *Store) Delete(id string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.entities[id]; !ok {
+		return false
+	}
+	delete(s.entities, id)
+	return true
+}
+
+// List returns copies of all stored entities. If name is not empty only entities with that name are returned.
+func (s *Store) List(name string) []interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var entities []interface{}
+	for _, entity := range s.entities {
+		if name != "" && stringField(entity, "Name") != name {
+			continue
+		}
+		entities = append(entities, deepcopy.Copy(entity))
+	}
+	return entities
+}
+
+// IdByName returns the ID of the entity with the given name
+func (s *Store) IdByName(name string) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for id, entity := range s.entities {
+		if stringField(entity, "Name") == name {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// NotFoundError is returned by fakes for IDs that do not exist in the store
+func NotFoundError(id string) error {
+	return fmt.Errorf("resource %s not found", id)
+}
+
+// NotSupportedError is returned by fake operations that proxyfakegen could not classify. Tests should stub these.
+func NotSupportedError(operation string) error {
+	return fmt.Errorf("operation %s is not supported by the fake proxy and must be stubbed", operation)
+}
+
+func stringField(entity interface{}, name string) string {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	field := v.FieldByName(name)
+	if !field.IsValid() {
+		return ""
+	}
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+func setStringField(entity interface{}, name, value string) {
+	v := reflect.ValueOf(entity)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	field := v.Elem().FieldByName(name)
+	if !field.IsValid() || !field.CanSet() {
+		return
+	}
+	switch {
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.String:
+		field.Set(reflect.ValueOf(&value))
+	}
+}
@@ -0,0 +1,65 @@
+package proxyfake
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStoreCrud verifies that entities are stored as copies under generated IDs
+func TestStoreCrud(t *testing.T) {
+	store := NewStore()
+	name := "Test Team"
+	team := &platformclientv2.Team{Name: &name}
+
+	id := store.Create(team)
+	assert.NotEmpty(t, id)
+	assert.Nil(t, team.Id, "the entity passed to Create should not be modified")
+
+	entity, ok := store.Get(id)
+	assert.True(t, ok)
+	stored := entity.(*platformclientv2.Team)
+	assert.Equal(t, id, *stored.Id)
+
+	// Changing a returned entity must not change the stored state
+	changedName := "Changed"
+	stored.Name = &changedName
+	entity, _ = store.Get(id)
+	assert.Equal(t, name, *entity.(*platformclientv2.Team).Name)
+
+	foundId, ok := store.IdByName(name)
+	assert.True(t, ok)
+	assert.Equal(t, id, foundId)
+	assert.Len(t, store.List(name), 1)
+	assert.Len(t, store.List("Unknown"), 0)
+
+	assert.True(t, store.Update(id, &platformclientv2.Team{Name: &changedName}))
+	assert.False(t, store.Update("unknown", team))
+
+	assert.True(t, store.Delete(id))
+	assert.False(t, store.Delete(id))
+	_, ok = store.Get(id)
+	assert.False(t, ok)
+}
+
+// TestStoreSimulateError verifies that queued errors are returned in order and only for their operation
+func TestStoreSimulateError(t *testing.T) {
+	store := NewStore()
+	store.SimulateError("getTeamById", http.StatusTooManyRequests, 2)
+
+	_, err := store.NextError("createTeam")
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		statusCode, err := store.NextError("getTeamById")
+		assert.Equal(t, http.StatusTooManyRequests, statusCode)
+		assert.NotNil(t, err)
+	}
+
+	statusCode, err := store.NextError("getTeamById")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, store.Calls("getTeamById"))
+}
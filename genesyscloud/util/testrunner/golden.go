@@ -0,0 +1,182 @@
+package testrunner
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/*
+This file contains the helpers used to compare the files generated by the exporter with golden files stored under
+test/data/export/<test case>. Run the tests with -update-golden (or UPDATE_GOLDEN=true) to rewrite the golden files
+with the current output, then review the resulting diff before committing it.
+*/
+
+const (
+	ExportTestType = "export"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "Rewrite the golden files under test/data/export with the generated export files")
+
+// GoldenNormalizer removes content that changes between runs (e.g. state lineage) from a generated file before it is
+// compared with its golden file. fileName is the path of the file relative to the export directory.
+type GoldenNormalizer func(fileName string, content []byte) []byte
+
+var tfStateLineage = regexp.MustCompile(`"lineage": "[^"]*"`)
+
+// NormalizeTfStateLineage replaces the random lineage written into terraform.tfstate files with a fixed value
+func NormalizeTfStateLineage(fileName string, content []byte) []byte {
+	if !strings.HasSuffix(fileName, ".tfstate") {
+		return content
+	}
+	return tfStateLineage.ReplaceAll(content, []byte(`"lineage": "GOLDEN-LINEAGE"`))
+}
+
+// IsUpdateGolden returns true when the golden files should be rewritten instead of compared
+func IsUpdateGolden() bool {
+	return *updateGolden || os.Getenv("UPDATE_GOLDEN") == "true"
+}
+
+// GetExportGoldenPath returns the directory holding the golden files for an export test case. The test data directory is
+// located by walking up from the working directory, so the helper can be used from any package in the provider.
+func GetExportGoldenPath(testCaseName string) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return GetTestDataPath(ExportTestType, testCaseName)
+	}
+	for {
+		testDataPath := filepath.Join(dir, "test", "data")
+		if info, err := os.Stat(testDataPath); err == nil && info.IsDir() {
+			return filepath.Join(testDataPath, ExportTestType, testCaseName)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return GetTestDataPath(ExportTestType, testCaseName)
+		}
+		dir = parent
+	}
+}
+
+// CompareExportWithGoldenFiles compares every file written to exportDir with the golden files of testCaseName. Files
+// missing from either side are reported as errors. When golden files are being updated, the golden directory is
+// replaced with the normalized contents of exportDir.
+func CompareExportWithGoldenFiles(t *testing.T, exportDir string, testCaseName string, normalizers ...GoldenNormalizer) {
+	t.Helper()
+	goldenDir := GetExportGoldenPath(testCaseName)
+
+	exported, err := readGoldenDir(exportDir, normalizers)
+	if err != nil {
+		t.Fatalf("Failed to read export directory %s: %v", exportDir, err)
+	}
+
+	if IsUpdateGolden() {
+		if err := writeGoldenDir(goldenDir, exported); err != nil {
+			t.Fatalf("Failed to update golden files in %s: %v", goldenDir, err)
+		}
+		t.Logf("Updated %d golden files in %s", len(exported), goldenDir)
+		return
+	}
+
+	golden, err := readGoldenDir(goldenDir, nil)
+	if err != nil {
+		t.Fatalf("Failed to read golden files in %s: %v. Run the test with -update-golden to create them", goldenDir, err)
+	}
+
+	for _, fileName := range sortedFileNames(golden) {
+		content, ok := exported[fileName]
+		if !ok {
+			t.Errorf("Expected export file %s was not generated", fileName)
+			continue
+		}
+		if diff := diffLines(golden[fileName], content); diff != "" {
+			t.Errorf("Export file %s does not match golden file %s:\n%s", fileName, filepath.Join(goldenDir, fileName), diff)
+		}
+	}
+	for _, fileName := range sortedFileNames(exported) {
+		if _, ok := golden[fileName]; !ok {
+			t.Errorf("Unexpected export file %s has no golden file", fileName)
+		}
+	}
+}
+
+func readGoldenDir(dir string, normalizers []GoldenNormalizer) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fileName, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		fileName = filepath.ToSlash(fileName)
+		content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+		for _, normalize := range normalizers {
+			content = normalize(fileName, content)
+		}
+		files[fileName] = content
+		return nil
+	})
+	return files, err
+}
+
+func writeGoldenDir(dir string, files map[string][]byte) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	for fileName, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(fileName))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedFileNames(files map[string][]byte) []string {
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
+
+// diffLines returns a description of the first line that differs between expected and actual, or an empty string
+// if they are identical
+func diffLines(expected, actual []byte) string {
+	if bytes.Equal(expected, actual) {
+		return ""
+	}
+	expectedLines := strings.Split(string(expected), "\n")
+	actualLines := strings.Split(string(actual), "\n")
+	for i := 0; i < len(expectedLines) || i < len(actualLines); i++ {
+		var expectedLine, actualLine string
+		if i < len(expectedLines) {
+			expectedLine = expectedLines[i]
+		}
+		if i < len(actualLines) {
+			actualLine = actualLines[i]
+		}
+		if expectedLine != actualLine || i >= len(expectedLines) || i >= len(actualLines) {
+			return fmt.Sprintf("line %d:\n  golden: %q\n  actual: %q", i+1, expectedLine, actualLine)
+		}
+	}
+	return ""
+}
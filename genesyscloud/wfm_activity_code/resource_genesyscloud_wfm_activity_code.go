@@ -0,0 +1,189 @@
+package wfm_activity_code
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_activity_code.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllWfmActivityCodes retrieves all of the wfm activity codes via Terraform in the Genesys Cloud and is used for the exporter
+func getAllWfmActivityCodes(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getWfmActivityCodeProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	allActivityCodes, _, err := proxy.getAllWfmActivityCodes(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get wfm activity codes: %v", err)
+	}
+
+	for _, businessUnitCodes := range *allActivityCodes {
+		for _, activityCode := range businessUnitCodes.activityCodes {
+			// Default activity codes are created with the business unit and deleted activity codes are only deactivated
+			if (activityCode.DefaultCode != nil && *activityCode.DefaultCode) || (activityCode.Active != nil && !*activityCode.Active) {
+				continue
+			}
+			id := createActivityCodeId(*businessUnitCodes.businessUnit.Id, *activityCode.Id)
+			resources[id] = &resourceExporter.ResourceMeta{Name: *businessUnitCodes.businessUnit.Name + "_" + *activityCode.Name}
+		}
+	}
+	return resources, nil
+}
+
+// createWfmActivityCode is used by the wfm_activity_code resource to create a Genesys Cloud wfm activity code
+func createWfmActivityCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmActivityCodeProxy(sdkConfig)
+
+	businessUnitId := d.Get("business_unit_id").(string)
+	name := d.Get("name").(string)
+	category := d.Get("category").(string)
+	secondaryPresences := buildSecondaryPresences(d)
+
+	createRequest := platformclientv2.Createactivitycoderequest{
+		Name:                   &name,
+		Category:               &category,
+		LengthInMinutes:        resourcedata.GetNillableValue[int](d, "length_in_minutes"),
+		CountsAsPaidTime:       resourcedata.GetNillableBool(d, "counts_as_paid_time"),
+		CountsAsWorkTime:       resourcedata.GetNillableBool(d, "counts_as_work_time"),
+		AgentTimeOffSelectable: resourcedata.GetNillableBool(d, "agent_time_off_selectable"),
+		CountsTowardShrinkage:  resourcedata.GetNillableBool(d, "counts_toward_shrinkage"),
+		PlannedShrinkage:       resourcedata.GetNillableBool(d, "planned_shrinkage"),
+		Interruptible:          resourcedata.GetNillableBool(d, "interruptible"),
+		SecondaryPresences:     &secondaryPresences,
+	}
+
+	log.Printf("Creating wfm activity code %s", name)
+	activityCode, _, err := proxy.createWfmActivityCode(ctx, businessUnitId, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create wfm activity code %s: %s", name, err)
+	}
+
+	d.SetId(createActivityCodeId(businessUnitId, *activityCode.Id))
+	log.Printf("Created wfm activity code %s %s", name, d.Id())
+	return readWfmActivityCode(ctx, d, meta)
+}
+
+// readWfmActivityCode is used by the wfm_activity_code resource to read a wfm activity code from Genesys Cloud
+func readWfmActivityCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmActivityCodeProxy(sdkConfig)
+
+	businessUnitId, activityCodeId := splitActivityCodeId(d.Id())
+	if activityCodeId == "" {
+		return diag.Errorf("Invalid wfm activity code ID %s", d.Id())
+	}
+
+	log.Printf("Reading wfm activity code %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		activityCode, resp, getErr := proxy.getWfmActivityCode(ctx, businessUnitId, activityCodeId)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wfm activity code %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wfm activity code %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceWfmActivityCode())
+		if activityCode.Active != nil && !*activityCode.Active {
+			d.SetId("")
+			return nil
+		}
+
+		_ = d.Set("business_unit_id", businessUnitId)
+		resourcedata.SetNillableValue(d, "name", activityCode.Name)
+		resourcedata.SetNillableValue(d, "category", activityCode.Category)
+		resourcedata.SetNillableValue(d, "length_in_minutes", activityCode.LengthInMinutes)
+		resourcedata.SetNillableValue(d, "counts_as_paid_time", activityCode.CountsAsPaidTime)
+		resourcedata.SetNillableValue(d, "counts_as_work_time", activityCode.CountsAsWorkTime)
+		resourcedata.SetNillableValue(d, "agent_time_off_selectable", activityCode.AgentTimeOffSelectable)
+		resourcedata.SetNillableValue(d, "counts_toward_shrinkage", activityCode.CountsTowardShrinkage)
+		resourcedata.SetNillableValue(d, "planned_shrinkage", activityCode.PlannedShrinkage)
+		resourcedata.SetNillableValue(d, "interruptible", activityCode.Interruptible)
+		_ = d.Set("secondary_presence_ids", flattenSecondaryPresenceIds(activityCode.SecondaryPresences))
+
+		log.Printf("Read wfm activity code %s %s", d.Id(), *activityCode.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateWfmActivityCode is used by the wfm_activity_code resource to update a wfm activity code in Genesys Cloud
+func updateWfmActivityCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmActivityCodeProxy(sdkConfig)
+
+	businessUnitId, activityCodeId := splitActivityCodeId(d.Id())
+	name := d.Get("name").(string)
+	category := d.Get("category").(string)
+	secondaryPresences := buildSecondaryPresences(d)
+
+	// Updates must include the metadata of the current activity code
+	currentActivityCode, _, err := proxy.getWfmActivityCode(ctx, businessUnitId, activityCodeId)
+	if err != nil {
+		return diag.Errorf("Failed to read wfm activity code %s: %s", d.Id(), err)
+	}
+
+	updateRequest := platformclientv2.Updateactivitycoderequest{
+		Name:                   &name,
+		Category:               &category,
+		LengthInMinutes:        resourcedata.GetNillableValue[int](d, "length_in_minutes"),
+		CountsAsPaidTime:       resourcedata.GetNillableBool(d, "counts_as_paid_time"),
+		CountsAsWorkTime:       resourcedata.GetNillableBool(d, "counts_as_work_time"),
+		AgentTimeOffSelectable: resourcedata.GetNillableBool(d, "agent_time_off_selectable"),
+		CountsTowardShrinkage:  resourcedata.GetNillableBool(d, "counts_toward_shrinkage"),
+		PlannedShrinkage:       resourcedata.GetNillableBool(d, "planned_shrinkage"),
+		Interruptible:          resourcedata.GetNillableBool(d, "interruptible"),
+		SecondaryPresences:     &platformclientv2.Listwrappersecondarypresence{Values: &secondaryPresences},
+		Metadata:               currentActivityCode.Metadata,
+	}
+
+	log.Printf("Updating wfm activity code %s", name)
+	if _, _, err := proxy.updateWfmActivityCode(ctx, businessUnitId, activityCodeId, &updateRequest); err != nil {
+		return diag.Errorf("Failed to update wfm activity code %s: %s", name, err)
+	}
+
+	log.Printf("Updated wfm activity code %s", name)
+	return readWfmActivityCode(ctx, d, meta)
+}
+
+// deleteWfmActivityCode is used by the wfm_activity_code resource to delete a wfm activity code from Genesys Cloud
+func deleteWfmActivityCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmActivityCodeProxy(sdkConfig)
+
+	businessUnitId, activityCodeId := splitActivityCodeId(d.Id())
+	if _, err := proxy.deleteWfmActivityCode(ctx, businessUnitId, activityCodeId); err != nil {
+		return diag.Errorf("Failed to delete wfm activity code %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		activityCode, resp, err := proxy.getWfmActivityCode(ctx, businessUnitId, activityCodeId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted wfm activity code %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting wfm activity code %s: %s", d.Id(), err))
+		}
+		if activityCode.Active != nil && !*activityCode.Active {
+			log.Printf("Deleted wfm activity code %s", d.Id())
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("Wfm activity code %s still exists", d.Id()))
+	})
+}
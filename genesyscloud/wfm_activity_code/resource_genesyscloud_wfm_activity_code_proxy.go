@@ -0,0 +1,152 @@
+package wfm_activity_code
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_activity_code_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *wfmActivityCodeProxy
+
+// businessUnitActivityCodes holds the activity codes of a business unit
+type businessUnitActivityCodes struct {
+	businessUnit  platformclientv2.Businessunitlistitem
+	activityCodes []platformclientv2.Businessunitactivitycode
+}
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllWfmActivityCodesFunc func(ctx context.Context, p *wfmActivityCodeProxy) (*[]businessUnitActivityCodes, *platformclientv2.APIResponse, error)
+type createWfmActivityCodeFunc func(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, body *platformclientv2.Createactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error)
+type getWfmActivityCodeFunc func(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error)
+type updateWfmActivityCodeFunc func(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string, body *platformclientv2.Updateactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error)
+type deleteWfmActivityCodeFunc func(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error)
+
+// wfmActivityCodeProxy contains all of the methods that call genesys cloud APIs.
+type wfmActivityCodeProxy struct {
+	clientConfig               *platformclientv2.Configuration
+	workforceManagementApi     *platformclientv2.WorkforceManagementApi
+	getAllWfmActivityCodesAttr getAllWfmActivityCodesFunc
+	createWfmActivityCodeAttr  createWfmActivityCodeFunc
+	getWfmActivityCodeAttr     getWfmActivityCodeFunc
+	updateWfmActivityCodeAttr  updateWfmActivityCodeFunc
+	deleteWfmActivityCodeAttr  deleteWfmActivityCodeFunc
+}
+
+// newWfmActivityCodeProxy initializes the wfm activity code proxy with all of the data needed to communicate with Genesys Cloud
+func newWfmActivityCodeProxy(clientConfig *platformclientv2.Configuration) *wfmActivityCodeProxy {
+	api := platformclientv2.NewWorkforceManagementApiWithConfig(clientConfig)
+	return &wfmActivityCodeProxy{
+		clientConfig:               clientConfig,
+		workforceManagementApi:     api,
+		getAllWfmActivityCodesAttr: getAllWfmActivityCodesFn,
+		createWfmActivityCodeAttr:  createWfmActivityCodeFn,
+		getWfmActivityCodeAttr:     getWfmActivityCodeFn,
+		updateWfmActivityCodeAttr:  updateWfmActivityCodeFn,
+		deleteWfmActivityCodeAttr:  deleteWfmActivityCodeFn,
+	}
+}
+
+// getWfmActivityCodeProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getWfmActivityCodeProxy(clientConfig *platformclientv2.Configuration) *wfmActivityCodeProxy {
+	if internalProxy == nil {
+		internalProxy = newWfmActivityCodeProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllWfmActivityCodes retrieves the activity codes of all Genesys Cloud wfm business units
+func (p *wfmActivityCodeProxy) getAllWfmActivityCodes(ctx context.Context) (*[]businessUnitActivityCodes, *platformclientv2.APIResponse, error) {
+	return p.getAllWfmActivityCodesAttr(ctx, p)
+}
+
+// createWfmActivityCode creates a Genesys Cloud wfm activity code in a business unit
+func (p *wfmActivityCodeProxy) createWfmActivityCode(ctx context.Context, businessUnitId string, body *platformclientv2.Createactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	return p.createWfmActivityCodeAttr(ctx, p, businessUnitId, body)
+}
+
+// getWfmActivityCode retrieves a Genesys Cloud wfm activity code of a business unit by id
+func (p *wfmActivityCodeProxy) getWfmActivityCode(ctx context.Context, businessUnitId string, id string) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	return p.getWfmActivityCodeAttr(ctx, p, businessUnitId, id)
+}
+
+// updateWfmActivityCode updates a Genesys Cloud wfm activity code of a business unit
+func (p *wfmActivityCodeProxy) updateWfmActivityCode(ctx context.Context, businessUnitId string, id string, body *platformclientv2.Updateactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	return p.updateWfmActivityCodeAttr(ctx, p, businessUnitId, id, body)
+}
+
+// deleteWfmActivityCode deletes a Genesys Cloud wfm activity code of a business unit by id
+func (p *wfmActivityCodeProxy) deleteWfmActivityCode(ctx context.Context, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWfmActivityCodeAttr(ctx, p, businessUnitId, id)
+}
+
+// getAllWfmActivityCodesFn is the implementation for retrieving the activity codes of all wfm business units in Genesys Cloud
+func getAllWfmActivityCodesFn(ctx context.Context, p *wfmActivityCodeProxy) (*[]businessUnitActivityCodes, *platformclientv2.APIResponse, error) {
+	businessUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunits("", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get wfm business units: %s", err)
+	}
+
+	allActivityCodes := make([]businessUnitActivityCodes, 0)
+	if businessUnits.Entities == nil {
+		return &allActivityCodes, resp, nil
+	}
+
+	for _, businessUnit := range *businessUnits.Entities {
+		activityCodes, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitActivitycodes(*businessUnit.Id, false)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get wfm activity codes of business unit %s: %s", *businessUnit.Id, err)
+		}
+
+		codes := businessUnitActivityCodes{businessUnit: businessUnit}
+		if activityCodes.Entities != nil {
+			codes.activityCodes = *activityCodes.Entities
+		}
+		allActivityCodes = append(allActivityCodes, codes)
+	}
+	return &allActivityCodes, resp, nil
+}
+
+// createWfmActivityCodeFn is the implementation for creating a wfm activity code in Genesys Cloud
+func createWfmActivityCodeFn(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, body *platformclientv2.Createactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	activityCode, resp, err := p.workforceManagementApi.PostWorkforcemanagementBusinessunitActivitycodes(businessUnitId, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create wfm activity code: %s", err)
+	}
+	return activityCode, resp, nil
+}
+
+// getWfmActivityCodeFn is the implementation for retrieving a wfm activity code in Genesys Cloud
+func getWfmActivityCodeFn(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	activityCode, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitActivitycode(businessUnitId, id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve wfm activity code by id %s: %s", id, err)
+	}
+	return activityCode, resp, nil
+}
+
+// updateWfmActivityCodeFn is the implementation for updating a wfm activity code in Genesys Cloud
+func updateWfmActivityCodeFn(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string, body *platformclientv2.Updateactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+	activityCode, resp, err := p.workforceManagementApi.PatchWorkforcemanagementBusinessunitActivitycode(businessUnitId, id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update wfm activity code %s: %s", id, err)
+	}
+	return activityCode, resp, nil
+}
+
+// deleteWfmActivityCodeFn is the implementation for deleting a wfm activity code in Genesys Cloud
+func deleteWfmActivityCodeFn(ctx context.Context, p *wfmActivityCodeProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.workforceManagementApi.DeleteWorkforcemanagementBusinessunitActivitycode(businessUnitId, id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete wfm activity code %s: %s", id, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,119 @@
+package wfm_activity_code
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_wfm_activity_code_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the wfm_activity_code resource.
+3.  The resource exporter configuration for the wfm_activity_code exporter.
+*/
+const resourceName = "genesyscloud_wfm_activity_code"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceWfmActivityCode())
+	regInstance.RegisterExporter(resourceName, WfmActivityCodeExporter())
+}
+
+// ResourceWfmActivityCode registers the genesyscloud_wfm_activity_code resource with Terraform
+func ResourceWfmActivityCode() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Workforce Management Activity Code. Activity codes describe the activities that agents of a business unit can be scheduled for.
+The resource ID is structured as {business-unit-id}/{activity-code-id}. Deleting an activity code deactivates it in Genesys Cloud.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createWfmActivityCode),
+		ReadContext:   gcloud.ReadWithPooledClient(readWfmActivityCode),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateWfmActivityCode),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteWfmActivityCode),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"business_unit_id": {
+				Description: "The ID of the business unit to which this activity code belongs. Changing the business unit will cause the activity code to be recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the activity code.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"category": {
+				Description:  "The category of the activity code.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OnQueueWork", "Break", "Meal", "Meeting", "OffQueueWork", "TimeOff", "Training", "Unavailable", "Unscheduled"}, false),
+			},
+			"length_in_minutes": {
+				Description: "The default length of the activity in minutes.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"counts_as_paid_time": {
+				Description: "Whether an agent is paid while performing this activity.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"counts_as_work_time": {
+				Description: "Whether the activity should be counted as work time.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"agent_time_off_selectable": {
+				Description: "Whether an agent can select this activity code when creating or editing a time off request.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"counts_toward_shrinkage": {
+				Description: "Whether this activity code counts toward shrinkage calculations.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"planned_shrinkage": {
+				Description: "Whether this activity code is considered planned or unplanned shrinkage.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"interruptible": {
+				Description: "Whether this activity code is considered interruptible.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"secondary_presence_ids": {
+				Description: "The IDs of the secondary presences of this activity code.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// WfmActivityCodeExporter returns the resourceExporter object used to hold the genesyscloud_wfm_activity_code exporter's config
+func WfmActivityCodeExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllWfmActivityCodes),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"business_unit_id": {RefType: "genesyscloud_wfm_business_unit"},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package wfm_activity_code
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_activity_code_test.go contains all of the test cases for running the resource
+tests for wfm_activity_code. The org running the test needs a workforce management license.
+*/
+
+func TestAccResourceWfmActivityCode(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId             = "test-activity-code"
+		fullName               = resourceName + "." + resourceId
+		name1                  = "Terraform Activity " + uuid.NewString()[:8]
+		name2                  = "Terraform Activity " + uuid.NewString()[:8]
+		businessUnitResourceId = "test-business-unit"
+		businessUnitName       = "Terraform Business Unit " + uuid.NewString()
+		businessUnitRef        = "genesyscloud_wfm_business_unit." + businessUnitResourceId + ".id"
+	)
+
+	businessUnitConfig := wfmBusinessUnit.GenerateWfmBusinessUnitResource(businessUnitResourceId, businessUnitName, "Monday", "America/New_York")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: businessUnitConfig + GenerateWfmActivityCodeResource(
+					resourceId,
+					businessUnitRef,
+					name1,
+					"Training",
+					"length_in_minutes = 30",
+					"counts_as_paid_time = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fullName, "business_unit_id", "genesyscloud_wfm_business_unit."+businessUnitResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "category", "Training"),
+					resource.TestCheckResourceAttr(fullName, "length_in_minutes", "30"),
+					resource.TestCheckResourceAttr(fullName, "counts_as_paid_time", "true"),
+				),
+			},
+			{
+				// Update
+				Config: businessUnitConfig + GenerateWfmActivityCodeResource(
+					resourceId,
+					businessUnitRef,
+					name2,
+					"Meeting",
+					"length_in_minutes = 60",
+					"counts_as_paid_time = false",
+					"interruptible = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "category", "Meeting"),
+					resource.TestCheckResourceAttr(fullName, "length_in_minutes", "60"),
+					resource.TestCheckResourceAttr(fullName, "counts_as_paid_time", "false"),
+					resource.TestCheckResourceAttr(fullName, "interruptible", "true"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyWfmActivityCodeDestroyed,
+	})
+}
+
+func testVerifyWfmActivityCodeDestroyed(state *terraform.State) error {
+	proxy := newWfmActivityCodeProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		businessUnitId, activityCodeId := splitActivityCodeId(rs.Primary.ID)
+		activityCode, resp, err := proxy.getWfmActivityCode(context.Background(), businessUnitId, activityCodeId)
+		if activityCode != nil {
+			if activityCode.Active != nil && !*activityCode.Active {
+				// Deleted activity codes are deactivated
+				continue
+			}
+			return fmt.Errorf("Wfm activity code (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Activity code or its business unit not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All activity codes destroyed
+	return nil
+}
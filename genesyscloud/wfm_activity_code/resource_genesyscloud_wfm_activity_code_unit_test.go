@@ -0,0 +1,98 @@
+package wfm_activity_code
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceWfmActivityCodeCreate(t *testing.T) {
+	businessUnitId := uuid.NewString()
+	activityCodeId := uuid.NewString()
+	presenceId := uuid.NewString()
+	active := true
+	var created *platformclientv2.Createactivitycoderequest
+
+	proxy := &wfmActivityCodeProxy{}
+	proxy.createWfmActivityCodeAttr = func(ctx context.Context, p *wfmActivityCodeProxy, buId string, body *platformclientv2.Createactivitycoderequest) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, buId)
+		created = body
+		return &platformclientv2.Businessunitactivitycode{Id: &activityCodeId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getWfmActivityCodeAttr = func(ctx context.Context, p *wfmActivityCodeProxy, buId string, id string) (*platformclientv2.Businessunitactivitycode, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, buId)
+		assert.Equal(t, activityCodeId, id)
+		return &platformclientv2.Businessunitactivitycode{
+			Id:                 &activityCodeId,
+			Name:               created.Name,
+			Active:             &active,
+			Category:           created.Category,
+			LengthInMinutes:    created.LengthInMinutes,
+			CountsAsPaidTime:   created.CountsAsPaidTime,
+			CountsAsWorkTime:   platformclientv2.Bool(true),
+			Interruptible:      created.Interruptible,
+			SecondaryPresences: created.SecondaryPresences,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmActivityCode().Schema, map[string]interface{}{
+		"business_unit_id":       businessUnitId,
+		"name":                   "Training",
+		"category":               "Training",
+		"length_in_minutes":      30,
+		"counts_as_paid_time":    false,
+		"secondary_presence_ids": []interface{}{presenceId},
+	})
+
+	diagErr := createWfmActivityCode(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, 30, *created.LengthInMinutes)
+	assert.False(t, *created.CountsAsPaidTime)
+	assert.Nil(t, created.Interruptible)
+	assert.Equal(t, presenceId, *(*created.SecondaryPresences)[0].Id)
+
+	assert.Equal(t, businessUnitId+"/"+activityCodeId, d.Id())
+	assert.Equal(t, businessUnitId, d.Get("business_unit_id").(string))
+	assert.True(t, d.Get("counts_as_work_time").(bool))
+	assert.True(t, d.Get("secondary_presence_ids").(*schema.Set).Contains(presenceId))
+}
+
+func TestUnitGetAllWfmActivityCodes(t *testing.T) {
+	businessUnitId := uuid.NewString()
+	businessUnitName := "Business Unit"
+	customCodeId := uuid.NewString()
+	defaultCodeId := uuid.NewString()
+	inactiveCodeId := uuid.NewString()
+
+	proxy := &wfmActivityCodeProxy{}
+	proxy.getAllWfmActivityCodesAttr = func(ctx context.Context, p *wfmActivityCodeProxy) (*[]businessUnitActivityCodes, *platformclientv2.APIResponse, error) {
+		return &[]businessUnitActivityCodes{
+			{
+				businessUnit: platformclientv2.Businessunitlistitem{Id: &businessUnitId, Name: &businessUnitName},
+				activityCodes: []platformclientv2.Businessunitactivitycode{
+					{Id: &customCodeId, Name: platformclientv2.String("Coaching"), Active: platformclientv2.Bool(true), DefaultCode: platformclientv2.Bool(false)},
+					{Id: &defaultCodeId, Name: platformclientv2.String("Break"), Active: platformclientv2.Bool(true), DefaultCode: platformclientv2.Bool(true)},
+					{Id: &inactiveCodeId, Name: platformclientv2.String("Old"), Active: platformclientv2.Bool(false), DefaultCode: platformclientv2.Bool(false)},
+				},
+			},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	resources, diagErr := getAllWfmActivityCodes(context.Background(), &platformclientv2.Configuration{})
+	assert.Nil(t, diagErr)
+
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "Business Unit_Coaching", resources[businessUnitId+"/"+customCodeId].Name)
+}
@@ -0,0 +1,63 @@
+package wfm_activity_code
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_activity_code_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// Activity code IDs structured as {business-unit-id}/{activity-code-id}
+func createActivityCodeId(businessUnitId string, activityCodeId string) string {
+	return strings.Join([]string{businessUnitId, activityCodeId}, "/")
+}
+
+func splitActivityCodeId(id string) (string, string) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+// buildSecondaryPresences maps secondary_presence_ids into a list of Genesys Cloud platformclientv2.Secondarypresence
+func buildSecondaryPresences(d *schema.ResourceData) []platformclientv2.Secondarypresence {
+	presences := make([]platformclientv2.Secondarypresence, 0)
+	for _, presenceId := range *lists.SetToStringList(d.Get("secondary_presence_ids").(*schema.Set)) {
+		id := presenceId
+		presences = append(presences, platformclientv2.Secondarypresence{Id: &id})
+	}
+	return presences
+}
+
+// flattenSecondaryPresenceIds maps a list of Genesys Cloud platformclientv2.Secondarypresence into a set of ids
+func flattenSecondaryPresenceIds(presences *[]platformclientv2.Secondarypresence) *schema.Set {
+	presenceIds := make([]string, 0)
+	if presences != nil {
+		for _, presence := range *presences {
+			if presence.Id != nil {
+				presenceIds = append(presenceIds, *presence.Id)
+			}
+		}
+	}
+	return lists.StringListToSet(presenceIds)
+}
+
+// GenerateWfmActivityCodeResource generates a terraform string for a wfm activity code resource
+func GenerateWfmActivityCodeResource(resourceId string, businessUnitId string, name string, category string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_wfm_activity_code" "%s" {
+	business_unit_id = %s
+	name             = "%s"
+	category         = "%s"
+	%s
+}
+`, resourceId, businessUnitId, name, category, strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,150 @@
+package wfm_business_unit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_business_unit.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllWfmBusinessUnits retrieves all of the wfm business units via Terraform in the Genesys Cloud and is used for the exporter
+func getAllWfmBusinessUnits(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getWfmBusinessUnitProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	businessUnits, _, err := proxy.getAllWfmBusinessUnits(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get wfm business units: %v", err)
+	}
+
+	for _, businessUnit := range *businessUnits {
+		resources[*businessUnit.Id] = &resourceExporter.ResourceMeta{Name: *businessUnit.Name}
+	}
+	return resources, nil
+}
+
+// createWfmBusinessUnit is used by the wfm_business_unit resource to create a Genesys Cloud wfm business unit
+func createWfmBusinessUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmBusinessUnitProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	createRequest := platformclientv2.Createbusinessunitrequest{
+		Name:     &name,
+		Settings: buildCreateBusinessUnitSettings(d),
+	}
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIdStr := divisionId.(string)
+		createRequest.DivisionId = &divisionIdStr
+	}
+
+	log.Printf("Creating wfm business unit %s", name)
+	businessUnit, _, err := proxy.createWfmBusinessUnit(ctx, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create wfm business unit %s: %s", name, err)
+	}
+
+	d.SetId(*businessUnit.Id)
+	log.Printf("Created wfm business unit %s %s", name, *businessUnit.Id)
+	return readWfmBusinessUnit(ctx, d, meta)
+}
+
+// readWfmBusinessUnit is used by the wfm_business_unit resource to read a wfm business unit from Genesys Cloud
+func readWfmBusinessUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmBusinessUnitProxy(sdkConfig)
+
+	log.Printf("Reading wfm business unit %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		businessUnit, resp, getErr := proxy.getWfmBusinessUnit(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wfm business unit %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wfm business unit %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceWfmBusinessUnit())
+
+		resourcedata.SetNillableValue(d, "name", businessUnit.Name)
+		if businessUnit.Division != nil {
+			resourcedata.SetNillableValue(d, "division_id", businessUnit.Division.Id)
+		}
+		flattenBusinessUnitSettings(d, businessUnit.Settings)
+
+		log.Printf("Read wfm business unit %s %s", d.Id(), *businessUnit.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateWfmBusinessUnit is used by the wfm_business_unit resource to update a wfm business unit in Genesys Cloud
+func updateWfmBusinessUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmBusinessUnitProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	// Settings updates must include the metadata of the current settings
+	currentBusinessUnit, _, err := proxy.getWfmBusinessUnit(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to read wfm business unit %s: %s", d.Id(), err)
+	}
+	var metadata *platformclientv2.Wfmversionedentitymetadata
+	if currentBusinessUnit.Settings != nil {
+		metadata = currentBusinessUnit.Settings.Metadata
+	}
+
+	updateRequest := platformclientv2.Updatebusinessunitrequest{
+		Name:     &name,
+		Settings: buildUpdateBusinessUnitSettings(d, metadata),
+	}
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIdStr := divisionId.(string)
+		updateRequest.DivisionId = &divisionIdStr
+	}
+
+	log.Printf("Updating wfm business unit %s", name)
+	if _, _, err := proxy.updateWfmBusinessUnit(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update wfm business unit %s: %s", name, err)
+	}
+
+	log.Printf("Updated wfm business unit %s", name)
+	return readWfmBusinessUnit(ctx, d, meta)
+}
+
+// deleteWfmBusinessUnit is used by the wfm_business_unit resource to delete a wfm business unit from Genesys Cloud
+func deleteWfmBusinessUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmBusinessUnitProxy(sdkConfig)
+
+	if _, err := proxy.deleteWfmBusinessUnit(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete wfm business unit %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getWfmBusinessUnit(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted wfm business unit %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting wfm business unit %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Wfm business unit %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,133 @@
+package wfm_business_unit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_business_unit_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *wfmBusinessUnitProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllWfmBusinessUnitsFunc func(ctx context.Context, p *wfmBusinessUnitProxy) (*[]platformclientv2.Businessunitlistitem, *platformclientv2.APIResponse, error)
+type createWfmBusinessUnitFunc func(ctx context.Context, p *wfmBusinessUnitProxy, body *platformclientv2.Createbusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error)
+type getWfmBusinessUnitFunc func(ctx context.Context, p *wfmBusinessUnitProxy, id string) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error)
+type updateWfmBusinessUnitFunc func(ctx context.Context, p *wfmBusinessUnitProxy, id string, body *platformclientv2.Updatebusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error)
+type deleteWfmBusinessUnitFunc func(ctx context.Context, p *wfmBusinessUnitProxy, id string) (*platformclientv2.APIResponse, error)
+
+// wfmBusinessUnitProxy contains all of the methods that call genesys cloud APIs.
+type wfmBusinessUnitProxy struct {
+	clientConfig               *platformclientv2.Configuration
+	workforceManagementApi     *platformclientv2.WorkforceManagementApi
+	getAllWfmBusinessUnitsAttr getAllWfmBusinessUnitsFunc
+	createWfmBusinessUnitAttr  createWfmBusinessUnitFunc
+	getWfmBusinessUnitAttr     getWfmBusinessUnitFunc
+	updateWfmBusinessUnitAttr  updateWfmBusinessUnitFunc
+	deleteWfmBusinessUnitAttr  deleteWfmBusinessUnitFunc
+}
+
+// newWfmBusinessUnitProxy initializes the wfm business unit proxy with all of the data needed to communicate with Genesys Cloud
+func newWfmBusinessUnitProxy(clientConfig *platformclientv2.Configuration) *wfmBusinessUnitProxy {
+	api := platformclientv2.NewWorkforceManagementApiWithConfig(clientConfig)
+	return &wfmBusinessUnitProxy{
+		clientConfig:               clientConfig,
+		workforceManagementApi:     api,
+		getAllWfmBusinessUnitsAttr: getAllWfmBusinessUnitsFn,
+		createWfmBusinessUnitAttr:  createWfmBusinessUnitFn,
+		getWfmBusinessUnitAttr:     getWfmBusinessUnitFn,
+		updateWfmBusinessUnitAttr:  updateWfmBusinessUnitFn,
+		deleteWfmBusinessUnitAttr:  deleteWfmBusinessUnitFn,
+	}
+}
+
+// getWfmBusinessUnitProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getWfmBusinessUnitProxy(clientConfig *platformclientv2.Configuration) *wfmBusinessUnitProxy {
+	if internalProxy == nil {
+		internalProxy = newWfmBusinessUnitProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllWfmBusinessUnits retrieves all Genesys Cloud wfm business units
+func (p *wfmBusinessUnitProxy) getAllWfmBusinessUnits(ctx context.Context) (*[]platformclientv2.Businessunitlistitem, *platformclientv2.APIResponse, error) {
+	return p.getAllWfmBusinessUnitsAttr(ctx, p)
+}
+
+// createWfmBusinessUnit creates a Genesys Cloud wfm business unit
+func (p *wfmBusinessUnitProxy) createWfmBusinessUnit(ctx context.Context, body *platformclientv2.Createbusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	return p.createWfmBusinessUnitAttr(ctx, p, body)
+}
+
+// getWfmBusinessUnit retrieves a Genesys Cloud wfm business unit, including its settings, by id
+func (p *wfmBusinessUnitProxy) getWfmBusinessUnit(ctx context.Context, id string) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	return p.getWfmBusinessUnitAttr(ctx, p, id)
+}
+
+// updateWfmBusinessUnit updates a Genesys Cloud wfm business unit
+func (p *wfmBusinessUnitProxy) updateWfmBusinessUnit(ctx context.Context, id string, body *platformclientv2.Updatebusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	return p.updateWfmBusinessUnitAttr(ctx, p, id, body)
+}
+
+// deleteWfmBusinessUnit deletes a Genesys Cloud wfm business unit by id
+func (p *wfmBusinessUnitProxy) deleteWfmBusinessUnit(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWfmBusinessUnitAttr(ctx, p, id)
+}
+
+// getAllWfmBusinessUnitsFn is the implementation for retrieving all wfm business units in Genesys Cloud
+func getAllWfmBusinessUnitsFn(ctx context.Context, p *wfmBusinessUnitProxy) (*[]platformclientv2.Businessunitlistitem, *platformclientv2.APIResponse, error) {
+	businessUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunits("", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get wfm business units: %s", err)
+	}
+
+	allBusinessUnits := make([]platformclientv2.Businessunitlistitem, 0)
+	if businessUnits.Entities != nil {
+		allBusinessUnits = append(allBusinessUnits, *businessUnits.Entities...)
+	}
+	return &allBusinessUnits, resp, nil
+}
+
+// createWfmBusinessUnitFn is the implementation for creating a wfm business unit in Genesys Cloud
+func createWfmBusinessUnitFn(ctx context.Context, p *wfmBusinessUnitProxy, body *platformclientv2.Createbusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	businessUnit, resp, err := p.workforceManagementApi.PostWorkforcemanagementBusinessunits(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create wfm business unit: %s", err)
+	}
+	return businessUnit, resp, nil
+}
+
+// getWfmBusinessUnitFn is the implementation for retrieving a wfm business unit in Genesys Cloud
+func getWfmBusinessUnitFn(ctx context.Context, p *wfmBusinessUnitProxy, id string) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	businessUnit, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunit(id, []string{"settings"})
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve wfm business unit by id %s: %s", id, err)
+	}
+	return businessUnit, resp, nil
+}
+
+// updateWfmBusinessUnitFn is the implementation for updating a wfm business unit in Genesys Cloud
+func updateWfmBusinessUnitFn(ctx context.Context, p *wfmBusinessUnitProxy, id string, body *platformclientv2.Updatebusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+	businessUnit, resp, err := p.workforceManagementApi.PatchWorkforcemanagementBusinessunit(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update wfm business unit %s: %s", id, err)
+	}
+	return businessUnit, resp, nil
+}
+
+// deleteWfmBusinessUnitFn is the implementation for deleting a wfm business unit in Genesys Cloud
+func deleteWfmBusinessUnitFn(ctx context.Context, p *wfmBusinessUnitProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.workforceManagementApi.DeleteWorkforcemanagementBusinessunit(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete wfm business unit %s: %s", id, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package wfm_business_unit
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_wfm_business_unit_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the wfm_business_unit resource.
+3.  The resource exporter configuration for the wfm_business_unit exporter.
+*/
+const resourceName = "genesyscloud_wfm_business_unit"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceWfmBusinessUnit())
+	regInstance.RegisterExporter(resourceName, WfmBusinessUnitExporter())
+}
+
+// ResourceWfmBusinessUnit registers the genesyscloud_wfm_business_unit resource with Terraform
+func ResourceWfmBusinessUnit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Workforce Management Business Unit. A business unit groups the management units that share forecasts, scheduling and activity codes.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createWfmBusinessUnit),
+		ReadContext:   gcloud.ReadWithPooledClient(readWfmBusinessUnit),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateWfmBusinessUnit),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteWfmBusinessUnit),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the business unit.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"division_id": {
+				Description: "The division to which this entity belongs.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"start_day_of_week": {
+				Description:  "The start day of week for this business unit.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, false),
+			},
+			"time_zone": {
+				Description: "The time zone for this business unit, using the Olsen tz database format. For example: America/New_York",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"default_history_weeks": {
+				Description: "The number of historical weeks to consider when creating a short term forecast.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"sync_time_off_properties": {
+				Description: "The time off properties to synchronize from scheduled activities to time off requests when the schedule is published.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"PayableMinutes"}, false),
+				},
+			},
+		},
+	}
+}
+
+// WfmBusinessUnitExporter returns the resourceExporter object used to hold the genesyscloud_wfm_business_unit exporter's config
+func WfmBusinessUnitExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllWfmBusinessUnits),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"division_id": {RefType: "genesyscloud_auth_division"},
+		},
+	}
+}
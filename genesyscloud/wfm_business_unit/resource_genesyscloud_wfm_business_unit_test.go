@@ -0,0 +1,93 @@
+package wfm_business_unit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_business_unit_test.go contains all of the test cases for running the resource
+tests for wfm_business_unit. The org running the test needs a workforce management license.
+*/
+
+func TestAccResourceWfmBusinessUnit(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId = "test-business-unit"
+		fullName   = resourceName + "." + resourceId
+		name1      = "Terraform Business Unit " + uuid.NewString()
+		name2      = "Terraform Business Unit " + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateWfmBusinessUnitResource(resourceId, name1, "Monday", "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "start_day_of_week", "Monday"),
+					resource.TestCheckResourceAttr(fullName, "time_zone", "America/New_York"),
+					resource.TestCheckResourceAttrSet(fullName, "division_id"),
+				),
+			},
+			{
+				// Update
+				Config: GenerateWfmBusinessUnitResource(
+					resourceId,
+					name2,
+					"Sunday",
+					"Europe/Dublin",
+					"default_history_weeks = 8",
+					`sync_time_off_properties = ["PayableMinutes"]`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "start_day_of_week", "Sunday"),
+					resource.TestCheckResourceAttr(fullName, "time_zone", "Europe/Dublin"),
+					resource.TestCheckResourceAttr(fullName, "default_history_weeks", "8"),
+					resource.TestCheckTypeSetElemAttr(fullName, "sync_time_off_properties.*", "PayableMinutes"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyWfmBusinessUnitDestroyed,
+	})
+}
+
+func testVerifyWfmBusinessUnitDestroyed(state *terraform.State) error {
+	proxy := newWfmBusinessUnitProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		businessUnit, resp, err := proxy.getWfmBusinessUnit(context.Background(), rs.Primary.ID)
+		if businessUnit != nil {
+			return fmt.Errorf("Wfm business unit (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Business unit not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All business units destroyed
+	return nil
+}
@@ -0,0 +1,77 @@
+package wfm_business_unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceWfmBusinessUnitUpdate(t *testing.T) {
+	businessUnitId := uuid.NewString()
+	divisionId := uuid.NewString()
+	name := "Business Unit"
+	version := 3
+	currentSettings := platformclientv2.Businessunitsettingsresponse{
+		StartDayOfWeek: platformclientv2.String("Monday"),
+		TimeZone:       platformclientv2.String("America/New_York"),
+		Metadata:       &platformclientv2.Wfmversionedentitymetadata{Version: &version},
+	}
+	var updated *platformclientv2.Updatebusinessunitrequest
+
+	proxy := &wfmBusinessUnitProxy{}
+	proxy.getWfmBusinessUnitAttr = func(ctx context.Context, p *wfmBusinessUnitProxy, id string) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, id)
+		businessUnit := &platformclientv2.Businessunitresponse{
+			Id:       &businessUnitId,
+			Name:     &name,
+			Division: &platformclientv2.Divisionreference{Id: &divisionId},
+			Settings: &currentSettings,
+		}
+		if updated != nil {
+			businessUnit.Name = updated.Name
+			businessUnit.Settings = &platformclientv2.Businessunitsettingsresponse{
+				StartDayOfWeek:       updated.Settings.StartDayOfWeek,
+				TimeZone:             updated.Settings.TimeZone,
+				ShortTermForecasting: updated.Settings.ShortTermForecasting,
+				Scheduling:           &platformclientv2.Buschedulingsettingsresponse{SyncTimeOffProperties: updated.Settings.Scheduling.SyncTimeOffProperties.Values},
+			}
+		}
+		return businessUnit, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateWfmBusinessUnitAttr = func(ctx context.Context, p *wfmBusinessUnitProxy, id string, body *platformclientv2.Updatebusinessunitrequest) (*platformclientv2.Businessunitresponse, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, id)
+		updated = body
+		return &platformclientv2.Businessunitresponse{Id: &businessUnitId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmBusinessUnit().Schema, map[string]interface{}{
+		"name":                     name,
+		"start_day_of_week":        "Sunday",
+		"time_zone":                "Europe/Dublin",
+		"default_history_weeks":    8,
+		"sync_time_off_properties": []interface{}{"PayableMinutes"},
+	})
+	d.SetId(businessUnitId)
+
+	diagErr := updateWfmBusinessUnit(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, version, *updated.Settings.Metadata.Version)
+	assert.Nil(t, updated.DivisionId)
+	assert.Equal(t, "Sunday", *updated.Settings.StartDayOfWeek)
+	assert.Equal(t, 8, *updated.Settings.ShortTermForecasting.DefaultHistoryWeeks)
+
+	assert.Equal(t, divisionId, d.Get("division_id").(string))
+	assert.Equal(t, "Europe/Dublin", d.Get("time_zone").(string))
+	assert.Equal(t, 8, d.Get("default_history_weeks").(int))
+	assert.True(t, d.Get("sync_time_off_properties").(*schema.Set).Contains("PayableMinutes"))
+}
@@ -0,0 +1,97 @@
+package wfm_business_unit
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_business_unit_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// buildCreateBusinessUnitSettings maps the settings attributes into a Genesys Cloud *platformclientv2.Createbusinessunitsettingsrequest
+func buildCreateBusinessUnitSettings(d *schema.ResourceData) *platformclientv2.Createbusinessunitsettingsrequest {
+	startDayOfWeek := d.Get("start_day_of_week").(string)
+	timeZone := d.Get("time_zone").(string)
+	return &platformclientv2.Createbusinessunitsettingsrequest{
+		StartDayOfWeek:       &startDayOfWeek,
+		TimeZone:             &timeZone,
+		ShortTermForecasting: buildShortTermForecastingSettings(d),
+		Scheduling:           buildSchedulingSettings(d),
+	}
+}
+
+// buildUpdateBusinessUnitSettings maps the settings attributes into a Genesys Cloud *platformclientv2.Updatebusinessunitsettingsrequest.
+// The metadata of the current settings is required by Genesys Cloud to detect conflicting updates.
+func buildUpdateBusinessUnitSettings(d *schema.ResourceData, metadata *platformclientv2.Wfmversionedentitymetadata) *platformclientv2.Updatebusinessunitsettingsrequest {
+	startDayOfWeek := d.Get("start_day_of_week").(string)
+	timeZone := d.Get("time_zone").(string)
+	return &platformclientv2.Updatebusinessunitsettingsrequest{
+		StartDayOfWeek:       &startDayOfWeek,
+		TimeZone:             &timeZone,
+		ShortTermForecasting: buildShortTermForecastingSettings(d),
+		Scheduling:           buildSchedulingSettings(d),
+		Metadata:             metadata,
+	}
+}
+
+// buildShortTermForecastingSettings maps default_history_weeks into a Genesys Cloud *platformclientv2.Bushorttermforecastingsettings
+func buildShortTermForecastingSettings(d *schema.ResourceData) *platformclientv2.Bushorttermforecastingsettings {
+	historyWeeks, ok := d.GetOk("default_history_weeks")
+	if !ok {
+		return nil
+	}
+	defaultHistoryWeeks := historyWeeks.(int)
+	return &platformclientv2.Bushorttermforecastingsettings{DefaultHistoryWeeks: &defaultHistoryWeeks}
+}
+
+// buildSchedulingSettings maps sync_time_off_properties into a Genesys Cloud *platformclientv2.Buschedulingsettingsrequest
+func buildSchedulingSettings(d *schema.ResourceData) *platformclientv2.Buschedulingsettingsrequest {
+	properties, ok := d.GetOk("sync_time_off_properties")
+	if !ok {
+		return nil
+	}
+	return &platformclientv2.Buschedulingsettingsrequest{
+		SyncTimeOffProperties: &platformclientv2.Setwrappersynctimeoffproperty{
+			Values: lists.SetToStringList(properties.(*schema.Set)),
+		},
+	}
+}
+
+// flattenBusinessUnitSettings sets the settings attributes from a Genesys Cloud *platformclientv2.Businessunitsettingsresponse
+func flattenBusinessUnitSettings(d *schema.ResourceData, settings *platformclientv2.Businessunitsettingsresponse) {
+	if settings == nil {
+		return
+	}
+	if settings.StartDayOfWeek != nil {
+		_ = d.Set("start_day_of_week", *settings.StartDayOfWeek)
+	}
+	if settings.TimeZone != nil {
+		_ = d.Set("time_zone", *settings.TimeZone)
+	}
+	if settings.ShortTermForecasting != nil && settings.ShortTermForecasting.DefaultHistoryWeeks != nil {
+		_ = d.Set("default_history_weeks", *settings.ShortTermForecasting.DefaultHistoryWeeks)
+	}
+	if settings.Scheduling != nil && settings.Scheduling.SyncTimeOffProperties != nil {
+		_ = d.Set("sync_time_off_properties", lists.StringListToSet(*settings.Scheduling.SyncTimeOffProperties))
+	} else {
+		_ = d.Set("sync_time_off_properties", nil)
+	}
+}
+
+// GenerateWfmBusinessUnitResource generates a terraform string for a wfm business unit resource
+func GenerateWfmBusinessUnitResource(resourceId string, name string, startDayOfWeek string, timeZone string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_wfm_business_unit" "%s" {
+	name              = "%s"
+	start_day_of_week = "%s"
+	time_zone         = "%s"
+	%s
+}
+`, resourceId, name, startDayOfWeek, timeZone, strings.Join(extraAttrs, "\n"))
+}
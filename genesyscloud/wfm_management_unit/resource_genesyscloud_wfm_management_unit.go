@@ -0,0 +1,191 @@
+package wfm_management_unit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_management_unit.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllWfmManagementUnits retrieves all of the wfm management units via Terraform in the Genesys Cloud and is used for the exporter
+func getAllWfmManagementUnits(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getWfmManagementUnitProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	managementUnits, _, err := proxy.getAllWfmManagementUnits(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get wfm management units: %v", err)
+	}
+
+	for _, managementUnit := range *managementUnits {
+		resources[*managementUnit.Id] = &resourceExporter.ResourceMeta{Name: *managementUnit.Name}
+	}
+	return resources, nil
+}
+
+// createWfmManagementUnit is used by the wfm_management_unit resource to create a Genesys Cloud wfm management unit
+func createWfmManagementUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmManagementUnitProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	businessUnitId := d.Get("business_unit_id").(string)
+	createRequest := platformclientv2.Createmanagementunitapirequest{
+		Name:           &name,
+		BusinessUnitId: &businessUnitId,
+	}
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIdStr := divisionId.(string)
+		createRequest.DivisionId = &divisionIdStr
+	}
+	if adherenceSettings := buildAdherenceSettings(d); adherenceSettings != nil {
+		createRequest.Settings = &platformclientv2.Createmanagementunitsettingsrequest{Adherence: adherenceSettings}
+	}
+
+	log.Printf("Creating wfm management unit %s", name)
+	managementUnit, _, err := proxy.createWfmManagementUnit(ctx, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create wfm management unit %s: %s", name, err)
+	}
+
+	d.SetId(*managementUnit.Id)
+
+	if diagErr := updateManagementUnitAgents(ctx, proxy, d); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created wfm management unit %s %s", name, *managementUnit.Id)
+	return readWfmManagementUnit(ctx, d, meta)
+}
+
+// readWfmManagementUnit is used by the wfm_management_unit resource to read a wfm management unit from Genesys Cloud
+func readWfmManagementUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmManagementUnitProxy(sdkConfig)
+
+	log.Printf("Reading wfm management unit %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		managementUnit, resp, getErr := proxy.getWfmManagementUnit(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wfm management unit %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wfm management unit %s: %s", d.Id(), getErr))
+		}
+
+		agentIds, _, getErr := proxy.getWfmManagementUnitAgentIds(ctx, d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read agents of wfm management unit %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceWfmManagementUnit())
+
+		resourcedata.SetNillableValue(d, "name", managementUnit.Name)
+		if managementUnit.BusinessUnit != nil {
+			resourcedata.SetNillableValue(d, "business_unit_id", managementUnit.BusinessUnit.Id)
+		}
+		if managementUnit.Division != nil {
+			resourcedata.SetNillableValue(d, "division_id", managementUnit.Division.Id)
+		}
+		if managementUnit.Settings != nil {
+			_ = d.Set("adherence_settings", flattenAdherenceSettings(managementUnit.Settings.Adherence))
+		} else {
+			_ = d.Set("adherence_settings", nil)
+		}
+		_ = d.Set("agent_ids", lists.StringListToSet(agentIds))
+
+		log.Printf("Read wfm management unit %s %s", d.Id(), *managementUnit.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateWfmManagementUnit is used by the wfm_management_unit resource to update a wfm management unit in Genesys Cloud
+func updateWfmManagementUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmManagementUnitProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	updateRequest := platformclientv2.Updatemanagementunitrequest{
+		Name: &name,
+	}
+	if divisionId, ok := d.GetOk("division_id"); ok {
+		divisionIdStr := divisionId.(string)
+		updateRequest.DivisionId = &divisionIdStr
+	}
+	if d.HasChange("adherence_settings") {
+		if adherenceSettings := buildAdherenceSettings(d); adherenceSettings != nil {
+			// Settings updates must include the metadata of the current settings
+			currentManagementUnit, _, err := proxy.getWfmManagementUnit(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("Failed to read wfm management unit %s: %s", d.Id(), err)
+			}
+			updateRequest.Settings = &platformclientv2.Managementunitsettingsrequest{Adherence: adherenceSettings}
+			if currentManagementUnit.Settings != nil {
+				updateRequest.Settings.Metadata = currentManagementUnit.Settings.Metadata
+			}
+		}
+	}
+
+	log.Printf("Updating wfm management unit %s", name)
+	if _, _, err := proxy.updateWfmManagementUnit(ctx, d.Id(), &updateRequest); err != nil {
+		return diag.Errorf("Failed to update wfm management unit %s: %s", name, err)
+	}
+
+	if d.HasChange("agent_ids") {
+		if diagErr := updateManagementUnitAgents(ctx, proxy, d); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated wfm management unit %s", name)
+	return readWfmManagementUnit(ctx, d, meta)
+}
+
+// deleteWfmManagementUnit is used by the wfm_management_unit resource to delete a wfm management unit from Genesys Cloud
+func deleteWfmManagementUnit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmManagementUnitProxy(sdkConfig)
+
+	// A management unit cannot be deleted while agents are assigned to it
+	agentIds, _, err := proxy.getWfmManagementUnitAgentIds(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to get agents of wfm management unit %s: %s", d.Id(), err)
+	}
+	if len(agentIds) > 0 {
+		if _, err := proxy.moveWfmAgents(ctx, agentIds, ""); err != nil {
+			return diag.Errorf("Failed to remove agents from wfm management unit %s: %s", d.Id(), err)
+		}
+	}
+
+	if _, err := proxy.deleteWfmManagementUnit(ctx, d.Id()); err != nil {
+		return diag.Errorf("Failed to delete wfm management unit %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getWfmManagementUnit(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted wfm management unit %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting wfm management unit %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Wfm management unit %s still exists", d.Id()))
+	})
+}
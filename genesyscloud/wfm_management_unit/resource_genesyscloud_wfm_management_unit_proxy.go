@@ -0,0 +1,202 @@
+package wfm_management_unit
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_management_unit_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *wfmManagementUnitProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllWfmManagementUnitsFunc func(ctx context.Context, p *wfmManagementUnitProxy) (*[]platformclientv2.Managementunit, *platformclientv2.APIResponse, error)
+type createWfmManagementUnitFunc func(ctx context.Context, p *wfmManagementUnitProxy, body *platformclientv2.Createmanagementunitapirequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error)
+type getWfmManagementUnitFunc func(ctx context.Context, p *wfmManagementUnitProxy, id string) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error)
+type updateWfmManagementUnitFunc func(ctx context.Context, p *wfmManagementUnitProxy, id string, body *platformclientv2.Updatemanagementunitrequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error)
+type deleteWfmManagementUnitFunc func(ctx context.Context, p *wfmManagementUnitProxy, id string) (*platformclientv2.APIResponse, error)
+type getWfmManagementUnitAgentIdsFunc func(ctx context.Context, p *wfmManagementUnitProxy, id string) ([]string, *platformclientv2.APIResponse, error)
+type moveWfmAgentsFunc func(ctx context.Context, p *wfmManagementUnitProxy, userIds []string, managementUnitId string) (*platformclientv2.APIResponse, error)
+
+// wfmManagementUnitProxy contains all of the methods that call genesys cloud APIs.
+type wfmManagementUnitProxy struct {
+	clientConfig                     *platformclientv2.Configuration
+	workforceManagementApi           *platformclientv2.WorkforceManagementApi
+	getAllWfmManagementUnitsAttr     getAllWfmManagementUnitsFunc
+	createWfmManagementUnitAttr      createWfmManagementUnitFunc
+	getWfmManagementUnitAttr         getWfmManagementUnitFunc
+	updateWfmManagementUnitAttr      updateWfmManagementUnitFunc
+	deleteWfmManagementUnitAttr      deleteWfmManagementUnitFunc
+	getWfmManagementUnitAgentIdsAttr getWfmManagementUnitAgentIdsFunc
+	moveWfmAgentsAttr                moveWfmAgentsFunc
+}
+
+// newWfmManagementUnitProxy initializes the wfm management unit proxy with all of the data needed to communicate with Genesys Cloud
+func newWfmManagementUnitProxy(clientConfig *platformclientv2.Configuration) *wfmManagementUnitProxy {
+	api := platformclientv2.NewWorkforceManagementApiWithConfig(clientConfig)
+	return &wfmManagementUnitProxy{
+		clientConfig:                     clientConfig,
+		workforceManagementApi:           api,
+		getAllWfmManagementUnitsAttr:     getAllWfmManagementUnitsFn,
+		createWfmManagementUnitAttr:      createWfmManagementUnitFn,
+		getWfmManagementUnitAttr:         getWfmManagementUnitFn,
+		updateWfmManagementUnitAttr:      updateWfmManagementUnitFn,
+		deleteWfmManagementUnitAttr:      deleteWfmManagementUnitFn,
+		getWfmManagementUnitAgentIdsAttr: getWfmManagementUnitAgentIdsFn,
+		moveWfmAgentsAttr:                moveWfmAgentsFn,
+	}
+}
+
+// getWfmManagementUnitProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getWfmManagementUnitProxy(clientConfig *platformclientv2.Configuration) *wfmManagementUnitProxy {
+	if internalProxy == nil {
+		internalProxy = newWfmManagementUnitProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllWfmManagementUnits retrieves all Genesys Cloud wfm management units
+func (p *wfmManagementUnitProxy) getAllWfmManagementUnits(ctx context.Context) (*[]platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	return p.getAllWfmManagementUnitsAttr(ctx, p)
+}
+
+// createWfmManagementUnit creates a Genesys Cloud wfm management unit
+func (p *wfmManagementUnitProxy) createWfmManagementUnit(ctx context.Context, body *platformclientv2.Createmanagementunitapirequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	return p.createWfmManagementUnitAttr(ctx, p, body)
+}
+
+// getWfmManagementUnit retrieves a Genesys Cloud wfm management unit and its settings by id
+func (p *wfmManagementUnitProxy) getWfmManagementUnit(ctx context.Context, id string) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	return p.getWfmManagementUnitAttr(ctx, p, id)
+}
+
+// updateWfmManagementUnit updates a Genesys Cloud wfm management unit
+func (p *wfmManagementUnitProxy) updateWfmManagementUnit(ctx context.Context, id string, body *platformclientv2.Updatemanagementunitrequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	return p.updateWfmManagementUnitAttr(ctx, p, id, body)
+}
+
+// deleteWfmManagementUnit deletes a Genesys Cloud wfm management unit by id
+func (p *wfmManagementUnitProxy) deleteWfmManagementUnit(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWfmManagementUnitAttr(ctx, p, id)
+}
+
+// getWfmManagementUnitAgentIds retrieves the ids of the agents assigned to a Genesys Cloud wfm management unit
+func (p *wfmManagementUnitProxy) getWfmManagementUnitAgentIds(ctx context.Context, id string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getWfmManagementUnitAgentIdsAttr(ctx, p, id)
+}
+
+// moveWfmAgents moves agents to a Genesys Cloud wfm management unit. An empty management unit id removes the agents from their management unit.
+func (p *wfmManagementUnitProxy) moveWfmAgents(ctx context.Context, userIds []string, managementUnitId string) (*platformclientv2.APIResponse, error) {
+	return p.moveWfmAgentsAttr(ctx, p, userIds, managementUnitId)
+}
+
+// getAllWfmManagementUnitsFn is the implementation for retrieving all wfm management units in Genesys Cloud
+func getAllWfmManagementUnitsFn(ctx context.Context, p *wfmManagementUnitProxy) (*[]platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	businessUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunits("", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get wfm business units: %s", err)
+	}
+
+	allManagementUnits := make([]platformclientv2.Managementunit, 0)
+	if businessUnits.Entities == nil {
+		return &allManagementUnits, resp, nil
+	}
+
+	for _, businessUnit := range *businessUnits.Entities {
+		managementUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitManagementunits(*businessUnit.Id, "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get wfm management units of business unit %s: %s", *businessUnit.Id, err)
+		}
+		if managementUnits.Entities != nil {
+			allManagementUnits = append(allManagementUnits, *managementUnits.Entities...)
+		}
+	}
+	return &allManagementUnits, resp, nil
+}
+
+// createWfmManagementUnitFn is the implementation for creating a wfm management unit in Genesys Cloud
+func createWfmManagementUnitFn(ctx context.Context, p *wfmManagementUnitProxy, body *platformclientv2.Createmanagementunitapirequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	managementUnit, resp, err := p.workforceManagementApi.PostWorkforcemanagementManagementunits(*body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create wfm management unit: %s", err)
+	}
+	return managementUnit, resp, nil
+}
+
+// getWfmManagementUnitFn is the implementation for retrieving a wfm management unit in Genesys Cloud
+func getWfmManagementUnitFn(ctx context.Context, p *wfmManagementUnitProxy, id string) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	managementUnit, resp, err := p.workforceManagementApi.GetWorkforcemanagementManagementunit(id, []string{"settings"})
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve wfm management unit by id %s: %s", id, err)
+	}
+	return managementUnit, resp, nil
+}
+
+// updateWfmManagementUnitFn is the implementation for updating a wfm management unit in Genesys Cloud
+func updateWfmManagementUnitFn(ctx context.Context, p *wfmManagementUnitProxy, id string, body *platformclientv2.Updatemanagementunitrequest) (*platformclientv2.Managementunit, *platformclientv2.APIResponse, error) {
+	managementUnit, resp, err := p.workforceManagementApi.PatchWorkforcemanagementManagementunit(id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update wfm management unit %s: %s", id, err)
+	}
+	return managementUnit, resp, nil
+}
+
+// deleteWfmManagementUnitFn is the implementation for deleting a wfm management unit in Genesys Cloud
+func deleteWfmManagementUnitFn(ctx context.Context, p *wfmManagementUnitProxy, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.workforceManagementApi.DeleteWorkforcemanagementManagementunit(id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete wfm management unit %s: %s", id, err)
+	}
+	return resp, nil
+}
+
+// getWfmManagementUnitAgentIdsFn is the implementation for retrieving the agents of a wfm management unit in Genesys Cloud
+func getWfmManagementUnitAgentIdsFn(ctx context.Context, p *wfmManagementUnitProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+	users, resp, err := p.workforceManagementApi.GetWorkforcemanagementManagementunitUsers(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get agents of wfm management unit %s: %s", id, err)
+	}
+
+	agentIds := make([]string, 0)
+	if users.Entities != nil {
+		for _, user := range *users.Entities {
+			agentIds = append(agentIds, *user.Id)
+		}
+	}
+	return agentIds, resp, nil
+}
+
+// moveWfmAgentsFn is the implementation for moving agents in and out of a wfm management unit in Genesys Cloud
+func moveWfmAgentsFn(ctx context.Context, p *wfmManagementUnitProxy, userIds []string, managementUnitId string) (*platformclientv2.APIResponse, error) {
+	moveRequest := platformclientv2.Moveagentsrequest{}
+	moveRequest.SetField("UserIds", &userIds)
+	if managementUnitId != "" {
+		moveRequest.SetField("DestinationManagementUnitId", &managementUnitId)
+	} else {
+		// A null destination removes the agents from their management unit
+		moveRequest.SetField("DestinationManagementUnitId", nil)
+	}
+
+	moveResponse, resp, err := p.workforceManagementApi.PostWorkforcemanagementAgents(moveRequest)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to move agents to wfm management unit '%s': %s", managementUnitId, err)
+	}
+
+	if moveResponse != nil && moveResponse.Results != nil {
+		for _, result := range *moveResponse.Results {
+			if result.User != nil && result.User.Id != nil && result.Result != nil {
+				log.Printf("Moved agent %s to wfm management unit '%s': %s", *result.User.Id, managementUnitId, *result.Result)
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package wfm_management_unit
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_wfm_management_unit_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the wfm_management_unit resource.
+3.  The resource exporter configuration for the wfm_management_unit exporter.
+*/
+const resourceName = "genesyscloud_wfm_management_unit"
+
+var (
+	adherenceSettingsResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"severe_alert_threshold_minutes": {
+				Description: "The threshold in minutes where an alert will be triggered when an agent is considered severely out of adherence.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"adherence_target_percent": {
+				Description:  "Target adherence percentage.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"adherence_exception_threshold_seconds": {
+				Description: "The threshold in seconds for which agents should not be penalized for being momentarily out of adherence.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"non_on_queue_activities_equivalent": {
+				Description: "Whether to treat all non-on-queue activities as equivalent for adherence purposes.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"track_on_queue_activity": {
+				Description: "Whether to track on-queue activities.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ignored_activity_categories": {
+				Description: "Activity categories that should be ignored for adherence purposes.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"OnQueueWork", "Break", "Meal", "Meeting", "OffQueueWork", "TimeOff", "Training", "Unavailable", "Unscheduled"}, false),
+				},
+			},
+		},
+	}
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceWfmManagementUnit())
+	regInstance.RegisterExporter(resourceName, WfmManagementUnitExporter())
+}
+
+// ResourceWfmManagementUnit registers the genesyscloud_wfm_management_unit resource with Terraform
+func ResourceWfmManagementUnit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Workforce Management Management Unit. A management unit groups the agents of a business unit that are scheduled together.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createWfmManagementUnit),
+		ReadContext:   gcloud.ReadWithPooledClient(readWfmManagementUnit),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateWfmManagementUnit),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteWfmManagementUnit),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the management unit.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"business_unit_id": {
+				Description: "The ID of the business unit to which this management unit belongs. Changing the business unit will cause the management unit to be recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"division_id": {
+				Description: "The division to which this entity belongs.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"agent_ids": {
+				Description: "The IDs of the users assigned to this management unit as agents. Agents assigned to another management unit are moved to this one. If not set, agent assignments are not managed by this resource.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"adherence_settings": {
+				Description: "The adherence settings of this management unit. If not set, the adherence settings are not managed by this resource.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        adherenceSettingsResource,
+			},
+		},
+	}
+}
+
+// WfmManagementUnitExporter returns the resourceExporter object used to hold the genesyscloud_wfm_management_unit exporter's config
+func WfmManagementUnitExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllWfmManagementUnits),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"business_unit_id": {RefType: "genesyscloud_wfm_business_unit"},
+			"division_id":      {RefType: "genesyscloud_auth_division"},
+			"agent_ids":        {RefType: "genesyscloud_user"},
+		},
+	}
+}
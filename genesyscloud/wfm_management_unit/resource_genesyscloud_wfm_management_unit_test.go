@@ -0,0 +1,105 @@
+package wfm_management_unit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_management_unit_test.go contains all of the test cases for running the resource
+tests for wfm_management_unit. The org running the test needs a workforce management license.
+*/
+
+func TestAccResourceWfmManagementUnit(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId             = "test-management-unit"
+		fullName               = resourceName + "." + resourceId
+		name1                  = "Terraform Management Unit " + uuid.NewString()
+		name2                  = "Terraform Management Unit " + uuid.NewString()
+		businessUnitResourceId = "test-business-unit"
+		businessUnitName       = "Terraform Business Unit " + uuid.NewString()
+		businessUnitRef        = "genesyscloud_wfm_business_unit." + businessUnitResourceId + ".id"
+		userResourceId         = "test-agent"
+		userEmail              = "terraform-" + uuid.NewString() + "@example.com"
+	)
+
+	baseConfig := wfmBusinessUnit.GenerateWfmBusinessUnitResource(businessUnitResourceId, businessUnitName, "Monday", "America/New_York") +
+		gcloud.GenerateBasicUserResource(userResourceId, userEmail, "Terraform WFM Agent")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: baseConfig + GenerateWfmManagementUnitResource(resourceId, name1, businessUnitRef),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttrPair(fullName, "business_unit_id", "genesyscloud_wfm_business_unit."+businessUnitResourceId, "id"),
+					resource.TestCheckResourceAttrSet(fullName, "division_id"),
+				),
+			},
+			{
+				// Update with an agent
+				Config: baseConfig + GenerateWfmManagementUnitResource(
+					resourceId,
+					name2,
+					businessUnitRef,
+					"agent_ids = [genesyscloud_user."+userResourceId+".id]",
+					`adherence_settings {
+						severe_alert_threshold_minutes = 15
+						adherence_target_percent       = 85
+						ignored_activity_categories    = ["Meal", "Break"]
+					}`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "agent_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "agent_ids.*", "genesyscloud_user."+userResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "adherence_settings.0.severe_alert_threshold_minutes", "15"),
+					resource.TestCheckResourceAttr(fullName, "adherence_settings.0.adherence_target_percent", "85"),
+					resource.TestCheckTypeSetElemAttr(fullName, "adherence_settings.0.ignored_activity_categories.*", "Meal"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyWfmManagementUnitDestroyed,
+	})
+}
+
+func testVerifyWfmManagementUnitDestroyed(state *terraform.State) error {
+	proxy := newWfmManagementUnitProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		managementUnit, resp, err := proxy.getWfmManagementUnit(context.Background(), rs.Primary.ID)
+		if managementUnit != nil {
+			return fmt.Errorf("Wfm management unit (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Management unit not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All management units destroyed
+	return nil
+}
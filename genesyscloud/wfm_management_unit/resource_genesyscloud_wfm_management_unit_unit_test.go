@@ -0,0 +1,96 @@
+package wfm_management_unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitUpdateManagementUnitAgents(t *testing.T) {
+	managementUnitId := uuid.NewString()
+	keptAgentId := uuid.NewString()
+	removedAgentId := uuid.NewString()
+	addedAgentId := uuid.NewString()
+	moves := make(map[string][]string)
+
+	proxy := &wfmManagementUnitProxy{}
+	proxy.getWfmManagementUnitAgentIdsAttr = func(ctx context.Context, p *wfmManagementUnitProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, managementUnitId, id)
+		return []string{keptAgentId, removedAgentId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.moveWfmAgentsAttr = func(ctx context.Context, p *wfmManagementUnitProxy, userIds []string, destinationId string) (*platformclientv2.APIResponse, error) {
+		moves[destinationId] = append(moves[destinationId], userIds...)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmManagementUnit().Schema, map[string]interface{}{
+		"name":             "Management Unit",
+		"business_unit_id": uuid.NewString(),
+		"agent_ids":        []interface{}{keptAgentId, addedAgentId},
+	})
+	d.SetId(managementUnitId)
+
+	diagErr := updateManagementUnitAgents(context.Background(), proxy, d)
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, []string{removedAgentId}, moves[""])
+	assert.Equal(t, []string{addedAgentId}, moves[managementUnitId])
+	assert.Len(t, moves, 2)
+}
+
+func TestUnitUpdateManagementUnitAgentsNotManaged(t *testing.T) {
+	proxy := &wfmManagementUnitProxy{}
+	proxy.getWfmManagementUnitAgentIdsAttr = func(ctx context.Context, p *wfmManagementUnitProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		t.Fatal("Agents should not be read when agent_ids is not set")
+		return nil, nil, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmManagementUnit().Schema, map[string]interface{}{
+		"name":             "Management Unit",
+		"business_unit_id": uuid.NewString(),
+	})
+	d.SetId(uuid.NewString())
+
+	assert.Nil(t, updateManagementUnitAgents(context.Background(), proxy, d))
+}
+
+func TestUnitBuildAdherenceSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceWfmManagementUnit().Schema, map[string]interface{}{
+		"name":             "Management Unit",
+		"business_unit_id": uuid.NewString(),
+		"adherence_settings": []interface{}{
+			map[string]interface{}{
+				"severe_alert_threshold_minutes":        15,
+				"adherence_target_percent":              90,
+				"adherence_exception_threshold_seconds": 60,
+				"non_on_queue_activities_equivalent":    true,
+				"track_on_queue_activity":               true,
+				"ignored_activity_categories":           []interface{}{"Meal", "Break"},
+			},
+		},
+	})
+
+	settings := buildAdherenceSettings(d)
+	assert.NotNil(t, settings)
+	assert.Equal(t, 15, *settings.SevereAlertThresholdMinutes)
+	assert.Equal(t, 90, *settings.AdherenceTargetPercent)
+	assert.Equal(t, 60, *settings.AdherenceExceptionThresholdSeconds)
+	assert.True(t, *settings.NonOnQueueActivitiesEquivalent)
+	assert.True(t, *settings.TrackOnQueueActivity)
+	assert.ElementsMatch(t, []string{"Meal", "Break"}, *settings.IgnoredActivityCategories.Values)
+
+	flattened := flattenAdherenceSettings(settings)[0].(map[string]interface{})
+	assert.Equal(t, 90, flattened["adherence_target_percent"])
+	assert.True(t, flattened["ignored_activity_categories"].(*schema.Set).Contains("Meal"))
+
+	d = schema.TestResourceDataRaw(t, ResourceWfmManagementUnit().Schema, map[string]interface{}{
+		"name":             "Management Unit",
+		"business_unit_id": uuid.NewString(),
+	})
+	assert.Nil(t, buildAdherenceSettings(d))
+}
@@ -0,0 +1,106 @@
+package wfm_management_unit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_management_unit_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// updateManagementUnitAgents moves the agents in agent_ids into the management unit and removes the agents that are no longer in it
+func updateManagementUnitAgents(ctx context.Context, proxy *wfmManagementUnitProxy, d *schema.ResourceData) diag.Diagnostics {
+	agentIds, ok := d.GetOk("agent_ids")
+	if !ok {
+		return nil
+	}
+	targetAgentIds := *lists.SetToStringList(agentIds.(*schema.Set))
+
+	currentAgentIds, _, err := proxy.getWfmManagementUnitAgentIds(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to get agents of wfm management unit %s: %s", d.Id(), err)
+	}
+
+	if removeAgentIds := lists.SliceDifference(currentAgentIds, targetAgentIds); len(removeAgentIds) > 0 {
+		log.Printf("Removing %d agents from wfm management unit %s", len(removeAgentIds), d.Id())
+		if _, err := proxy.moveWfmAgents(ctx, removeAgentIds, ""); err != nil {
+			return diag.Errorf("Failed to remove agents from wfm management unit %s: %s", d.Id(), err)
+		}
+	}
+	if addAgentIds := lists.SliceDifference(targetAgentIds, currentAgentIds); len(addAgentIds) > 0 {
+		log.Printf("Adding %d agents to wfm management unit %s", len(addAgentIds), d.Id())
+		if _, err := proxy.moveWfmAgents(ctx, addAgentIds, d.Id()); err != nil {
+			return diag.Errorf("Failed to add agents to wfm management unit %s: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// buildAdherenceSettings maps the adherence_settings block into a Genesys Cloud platformclientv2.Adherencesettings
+func buildAdherenceSettings(d *schema.ResourceData) *platformclientv2.Adherencesettings {
+	settingsList, ok := d.Get("adherence_settings").([]interface{})
+	if !ok || len(settingsList) == 0 || settingsList[0] == nil {
+		return nil
+	}
+	settingsMap := settingsList[0].(map[string]interface{})
+
+	var settings platformclientv2.Adherencesettings
+	if severeAlertThresholdMinutes, ok := settingsMap["severe_alert_threshold_minutes"].(int); ok {
+		settings.SevereAlertThresholdMinutes = &severeAlertThresholdMinutes
+	}
+	if adherenceTargetPercent, ok := settingsMap["adherence_target_percent"].(int); ok {
+		settings.AdherenceTargetPercent = &adherenceTargetPercent
+	}
+	if adherenceExceptionThresholdSeconds, ok := settingsMap["adherence_exception_threshold_seconds"].(int); ok {
+		settings.AdherenceExceptionThresholdSeconds = &adherenceExceptionThresholdSeconds
+	}
+	if nonOnQueueActivitiesEquivalent, ok := settingsMap["non_on_queue_activities_equivalent"].(bool); ok {
+		settings.NonOnQueueActivitiesEquivalent = &nonOnQueueActivitiesEquivalent
+	}
+	if trackOnQueueActivity, ok := settingsMap["track_on_queue_activity"].(bool); ok {
+		settings.TrackOnQueueActivity = &trackOnQueueActivity
+	}
+	if categories, ok := settingsMap["ignored_activity_categories"].(*schema.Set); ok {
+		settings.IgnoredActivityCategories = &platformclientv2.Ignoredactivitycategories{Values: lists.SetToStringList(categories)}
+	}
+	return &settings
+}
+
+// flattenAdherenceSettings maps a Genesys Cloud platformclientv2.Adherencesettings into the adherence_settings block
+func flattenAdherenceSettings(settings *platformclientv2.Adherencesettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	settingsMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(settingsMap, "severe_alert_threshold_minutes", settings.SevereAlertThresholdMinutes)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "adherence_target_percent", settings.AdherenceTargetPercent)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "adherence_exception_threshold_seconds", settings.AdherenceExceptionThresholdSeconds)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "non_on_queue_activities_equivalent", settings.NonOnQueueActivitiesEquivalent)
+	resourcedata.SetMapValueIfNotNil(settingsMap, "track_on_queue_activity", settings.TrackOnQueueActivity)
+	if settings.IgnoredActivityCategories != nil && settings.IgnoredActivityCategories.Values != nil {
+		settingsMap["ignored_activity_categories"] = lists.StringListToSet(*settings.IgnoredActivityCategories.Values)
+	}
+	return []interface{}{settingsMap}
+}
+
+// GenerateWfmManagementUnitResource generates a terraform string for a wfm management unit resource
+func GenerateWfmManagementUnitResource(resourceId string, name string, businessUnitId string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_wfm_management_unit" "%s" {
+	name             = "%s"
+	business_unit_id = %s
+	%s
+}
+`, resourceId, name, businessUnitId, strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,160 @@
+package wfm_planning_group
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_planning_group.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllWfmPlanningGroups retrieves all of the wfm planning groups via Terraform in the Genesys Cloud and is used for the exporter
+func getAllWfmPlanningGroups(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getWfmPlanningGroupProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	allPlanningGroups, _, err := proxy.getAllWfmPlanningGroups(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get wfm planning groups: %v", err)
+	}
+
+	for _, businessUnitGroups := range *allPlanningGroups {
+		for _, planningGroup := range businessUnitGroups.planningGroups {
+			id := createPlanningGroupId(*businessUnitGroups.businessUnit.Id, *planningGroup.Id)
+			resources[id] = &resourceExporter.ResourceMeta{Name: *businessUnitGroups.businessUnit.Name + "_" + *planningGroup.Name}
+		}
+	}
+	return resources, nil
+}
+
+// createWfmPlanningGroup is used by the wfm_planning_group resource to create a Genesys Cloud wfm planning group
+func createWfmPlanningGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmPlanningGroupProxy(sdkConfig)
+
+	businessUnitId := d.Get("business_unit_id").(string)
+	name := d.Get("name").(string)
+	serviceGoalTemplateId := getServiceGoalTemplateId(d)
+	routePaths := buildRoutePaths(d)
+
+	createRequest := platformclientv2.Createplanninggrouprequest{
+		Name:                  &name,
+		ServiceGoalTemplateId: &serviceGoalTemplateId,
+		RoutePaths:            &routePaths,
+	}
+
+	log.Printf("Creating wfm planning group %s", name)
+	planningGroup, _, err := proxy.createWfmPlanningGroup(ctx, businessUnitId, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create wfm planning group %s: %s", name, err)
+	}
+
+	d.SetId(createPlanningGroupId(businessUnitId, *planningGroup.Id))
+	log.Printf("Created wfm planning group %s %s", name, d.Id())
+	return readWfmPlanningGroup(ctx, d, meta)
+}
+
+// readWfmPlanningGroup is used by the wfm_planning_group resource to read a wfm planning group from Genesys Cloud
+func readWfmPlanningGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmPlanningGroupProxy(sdkConfig)
+
+	businessUnitId, planningGroupId := splitPlanningGroupId(d.Id())
+	if planningGroupId == "" {
+		return diag.Errorf("Invalid wfm planning group ID %s", d.Id())
+	}
+
+	log.Printf("Reading wfm planning group %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		planningGroup, resp, getErr := proxy.getWfmPlanningGroup(ctx, businessUnitId, planningGroupId)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wfm planning group %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wfm planning group %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceWfmPlanningGroup())
+
+		_ = d.Set("business_unit_id", businessUnitId)
+		resourcedata.SetNillableValue(d, "name", planningGroup.Name)
+		if planningGroup.ServiceGoalTemplate != nil && planningGroup.ServiceGoalTemplate.Id != nil {
+			_ = d.Set("service_goal_template_id", createPlanningGroupId(businessUnitId, *planningGroup.ServiceGoalTemplate.Id))
+		} else {
+			_ = d.Set("service_goal_template_id", nil)
+		}
+		_ = d.Set("route_paths", flattenRoutePaths(planningGroup.RoutePaths))
+
+		log.Printf("Read wfm planning group %s %s", d.Id(), *planningGroup.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateWfmPlanningGroup is used by the wfm_planning_group resource to update a wfm planning group in Genesys Cloud
+func updateWfmPlanningGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmPlanningGroupProxy(sdkConfig)
+
+	businessUnitId, planningGroupId := splitPlanningGroupId(d.Id())
+	name := d.Get("name").(string)
+	serviceGoalTemplateId := getServiceGoalTemplateId(d)
+	routePaths := buildRoutePaths(d)
+
+	// Updates must include the metadata of the current planning group
+	currentPlanningGroup, _, err := proxy.getWfmPlanningGroup(ctx, businessUnitId, planningGroupId)
+	if err != nil {
+		return diag.Errorf("Failed to read wfm planning group %s: %s", d.Id(), err)
+	}
+
+	updateRequest := platformclientv2.Updateplanninggrouprequest{
+		Name:                  &name,
+		ServiceGoalTemplateId: &serviceGoalTemplateId,
+		RoutePaths:            &platformclientv2.Setwrapperroutepathrequest{Values: &routePaths},
+		Metadata:              currentPlanningGroup.Metadata,
+	}
+
+	log.Printf("Updating wfm planning group %s", name)
+	if _, _, err := proxy.updateWfmPlanningGroup(ctx, businessUnitId, planningGroupId, &updateRequest); err != nil {
+		return diag.Errorf("Failed to update wfm planning group %s: %s", name, err)
+	}
+
+	log.Printf("Updated wfm planning group %s", name)
+	return readWfmPlanningGroup(ctx, d, meta)
+}
+
+// deleteWfmPlanningGroup is used by the wfm_planning_group resource to delete a wfm planning group from Genesys Cloud
+func deleteWfmPlanningGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmPlanningGroupProxy(sdkConfig)
+
+	businessUnitId, planningGroupId := splitPlanningGroupId(d.Id())
+	if _, err := proxy.deleteWfmPlanningGroup(ctx, businessUnitId, planningGroupId); err != nil {
+		return diag.Errorf("Failed to delete wfm planning group %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getWfmPlanningGroup(ctx, businessUnitId, planningGroupId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted wfm planning group %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting wfm planning group %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Wfm planning group %s still exists", d.Id()))
+	})
+}
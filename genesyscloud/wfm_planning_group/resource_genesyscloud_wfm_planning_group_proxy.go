@@ -0,0 +1,152 @@
+package wfm_planning_group
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_planning_group_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *wfmPlanningGroupProxy
+
+// businessUnitPlanningGroups holds the planning groups of a business unit
+type businessUnitPlanningGroups struct {
+	businessUnit   platformclientv2.Businessunitlistitem
+	planningGroups []platformclientv2.Planninggroup
+}
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllWfmPlanningGroupsFunc func(ctx context.Context, p *wfmPlanningGroupProxy) (*[]businessUnitPlanningGroups, *platformclientv2.APIResponse, error)
+type createWfmPlanningGroupFunc func(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, body *platformclientv2.Createplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error)
+type getWfmPlanningGroupFunc func(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error)
+type updateWfmPlanningGroupFunc func(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string, body *platformclientv2.Updateplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error)
+type deleteWfmPlanningGroupFunc func(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error)
+
+// wfmPlanningGroupProxy contains all of the methods that call genesys cloud APIs.
+type wfmPlanningGroupProxy struct {
+	clientConfig                *platformclientv2.Configuration
+	workforceManagementApi      *platformclientv2.WorkforceManagementApi
+	getAllWfmPlanningGroupsAttr getAllWfmPlanningGroupsFunc
+	createWfmPlanningGroupAttr  createWfmPlanningGroupFunc
+	getWfmPlanningGroupAttr     getWfmPlanningGroupFunc
+	updateWfmPlanningGroupAttr  updateWfmPlanningGroupFunc
+	deleteWfmPlanningGroupAttr  deleteWfmPlanningGroupFunc
+}
+
+// newWfmPlanningGroupProxy initializes the wfm planning group proxy with all of the data needed to communicate with Genesys Cloud
+func newWfmPlanningGroupProxy(clientConfig *platformclientv2.Configuration) *wfmPlanningGroupProxy {
+	api := platformclientv2.NewWorkforceManagementApiWithConfig(clientConfig)
+	return &wfmPlanningGroupProxy{
+		clientConfig:                clientConfig,
+		workforceManagementApi:      api,
+		getAllWfmPlanningGroupsAttr: getAllWfmPlanningGroupsFn,
+		createWfmPlanningGroupAttr:  createWfmPlanningGroupFn,
+		getWfmPlanningGroupAttr:     getWfmPlanningGroupFn,
+		updateWfmPlanningGroupAttr:  updateWfmPlanningGroupFn,
+		deleteWfmPlanningGroupAttr:  deleteWfmPlanningGroupFn,
+	}
+}
+
+// getWfmPlanningGroupProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getWfmPlanningGroupProxy(clientConfig *platformclientv2.Configuration) *wfmPlanningGroupProxy {
+	if internalProxy == nil {
+		internalProxy = newWfmPlanningGroupProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllWfmPlanningGroups retrieves the planning groups of all Genesys Cloud wfm business units
+func (p *wfmPlanningGroupProxy) getAllWfmPlanningGroups(ctx context.Context) (*[]businessUnitPlanningGroups, *platformclientv2.APIResponse, error) {
+	return p.getAllWfmPlanningGroupsAttr(ctx, p)
+}
+
+// createWfmPlanningGroup creates a Genesys Cloud wfm planning group in a business unit
+func (p *wfmPlanningGroupProxy) createWfmPlanningGroup(ctx context.Context, businessUnitId string, body *platformclientv2.Createplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	return p.createWfmPlanningGroupAttr(ctx, p, businessUnitId, body)
+}
+
+// getWfmPlanningGroup retrieves a Genesys Cloud wfm planning group of a business unit by id
+func (p *wfmPlanningGroupProxy) getWfmPlanningGroup(ctx context.Context, businessUnitId string, id string) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	return p.getWfmPlanningGroupAttr(ctx, p, businessUnitId, id)
+}
+
+// updateWfmPlanningGroup updates a Genesys Cloud wfm planning group of a business unit
+func (p *wfmPlanningGroupProxy) updateWfmPlanningGroup(ctx context.Context, businessUnitId string, id string, body *platformclientv2.Updateplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	return p.updateWfmPlanningGroupAttr(ctx, p, businessUnitId, id, body)
+}
+
+// deleteWfmPlanningGroup deletes a Genesys Cloud wfm planning group of a business unit by id
+func (p *wfmPlanningGroupProxy) deleteWfmPlanningGroup(ctx context.Context, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWfmPlanningGroupAttr(ctx, p, businessUnitId, id)
+}
+
+// getAllWfmPlanningGroupsFn is the implementation for retrieving the planning groups of all wfm business units in Genesys Cloud
+func getAllWfmPlanningGroupsFn(ctx context.Context, p *wfmPlanningGroupProxy) (*[]businessUnitPlanningGroups, *platformclientv2.APIResponse, error) {
+	businessUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunits("", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get wfm business units: %s", err)
+	}
+
+	allPlanningGroups := make([]businessUnitPlanningGroups, 0)
+	if businessUnits.Entities == nil {
+		return &allPlanningGroups, resp, nil
+	}
+
+	for _, businessUnit := range *businessUnits.Entities {
+		planningGroups, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitPlanninggroups(*businessUnit.Id)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get wfm planning groups of business unit %s: %s", *businessUnit.Id, err)
+		}
+
+		templates := businessUnitPlanningGroups{businessUnit: businessUnit}
+		if planningGroups.Entities != nil {
+			templates.planningGroups = *planningGroups.Entities
+		}
+		allPlanningGroups = append(allPlanningGroups, templates)
+	}
+	return &allPlanningGroups, resp, nil
+}
+
+// createWfmPlanningGroupFn is the implementation for creating a wfm planning group in Genesys Cloud
+func createWfmPlanningGroupFn(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, body *platformclientv2.Createplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	planningGroup, resp, err := p.workforceManagementApi.PostWorkforcemanagementBusinessunitPlanninggroups(businessUnitId, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create wfm planning group: %s", err)
+	}
+	return planningGroup, resp, nil
+}
+
+// getWfmPlanningGroupFn is the implementation for retrieving a wfm planning group in Genesys Cloud
+func getWfmPlanningGroupFn(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	planningGroup, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitPlanninggroup(businessUnitId, id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve wfm planning group by id %s: %s", id, err)
+	}
+	return planningGroup, resp, nil
+}
+
+// updateWfmPlanningGroupFn is the implementation for updating a wfm planning group in Genesys Cloud
+func updateWfmPlanningGroupFn(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string, body *platformclientv2.Updateplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+	planningGroup, resp, err := p.workforceManagementApi.PatchWorkforcemanagementBusinessunitPlanninggroup(businessUnitId, id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update wfm planning group %s: %s", id, err)
+	}
+	return planningGroup, resp, nil
+}
+
+// deleteWfmPlanningGroupFn is the implementation for deleting a wfm planning group in Genesys Cloud
+func deleteWfmPlanningGroupFn(ctx context.Context, p *wfmPlanningGroupProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.workforceManagementApi.DeleteWorkforcemanagementBusinessunitPlanninggroup(businessUnitId, id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete wfm planning group %s: %s", id, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+package wfm_planning_group
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_wfm_planning_group_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the wfm_planning_group resource.
+3.  The resource exporter configuration for the wfm_planning_group exporter.
+*/
+const resourceName = "genesyscloud_wfm_planning_group"
+
+var (
+	routePathResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "The ID of the queue of the route path.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"media_type": {
+				Description:  "The media type of the queue of the route path.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Voice", "Chat", "Email", "Callback", "Message"}, false),
+			},
+			"language_id": {
+				Description: "The ID of the language of the route path.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"skill_ids": {
+				Description: "The IDs of the skills of the route path.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceWfmPlanningGroup())
+	regInstance.RegisterExporter(resourceName, WfmPlanningGroupExporter())
+}
+
+// ResourceWfmPlanningGroup registers the genesyscloud_wfm_planning_group resource with Terraform
+func ResourceWfmPlanningGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Workforce Management Planning Group. Planning groups route the interactions of a combination of queue, media type, language and skills to the forecasts and schedules of a business unit.
+The resource ID is structured as {business-unit-id}/{planning-group-id}.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createWfmPlanningGroup),
+		ReadContext:   gcloud.ReadWithPooledClient(readWfmPlanningGroup),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateWfmPlanningGroup),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteWfmPlanningGroup),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"business_unit_id": {
+				Description: "The ID of the business unit to which this planning group belongs. Changing the business unit will cause the planning group to be recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the planning group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"service_goal_template_id": {
+				Description: "The ID of the genesyscloud_wfm_service_goal_template resource associated with this planning group, structured as {business-unit-id}/{service-goal-template-id}.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"route_paths": {
+				Description: "The route paths associated with this planning group. A route path can only belong to one planning group of a business unit.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        routePathResource,
+			},
+		},
+	}
+}
+
+// WfmPlanningGroupExporter returns the resourceExporter object used to hold the genesyscloud_wfm_planning_group exporter's config
+func WfmPlanningGroupExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllWfmPlanningGroups),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"business_unit_id":         {RefType: "genesyscloud_wfm_business_unit"},
+			"service_goal_template_id": {RefType: "genesyscloud_wfm_service_goal_template"},
+			"route_paths.queue_id":     {RefType: "genesyscloud_routing_queue"},
+			"route_paths.language_id":  {RefType: "genesyscloud_routing_language"},
+			"route_paths.skill_ids":    {RefType: "genesyscloud_routing_skill"},
+		},
+	}
+}
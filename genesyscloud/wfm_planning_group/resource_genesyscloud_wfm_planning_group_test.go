@@ -0,0 +1,132 @@
+package wfm_planning_group
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+	wfmServiceGoalTemplate "terraform-provider-genesyscloud/genesyscloud/wfm_service_goal_template"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_planning_group_test.go contains all of the test cases for running the resource
+tests for wfm_planning_group. The org running the test needs a workforce management license.
+*/
+
+func TestAccResourceWfmPlanningGroup(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId             = "test-planning-group"
+		fullName               = resourceName + "." + resourceId
+		name1                  = "Terraform Planning Group " + uuid.NewString()[:8]
+		name2                  = "Terraform Planning Group " + uuid.NewString()[:8]
+		businessUnitResourceId = "test-business-unit"
+		businessUnitName       = "Terraform Business Unit " + uuid.NewString()
+		businessUnitRef        = "genesyscloud_wfm_business_unit." + businessUnitResourceId + ".id"
+		templateResourceId     = "test-service-goal-template"
+		templateRef            = "genesyscloud_wfm_service_goal_template." + templateResourceId + ".id"
+		queueResourceId        = "test-queue"
+		queueRef               = "genesyscloud_routing_queue." + queueResourceId + ".id"
+		languageResourceId     = "test-language"
+		skillResourceId        = "test-skill"
+	)
+
+	baseConfig := wfmBusinessUnit.GenerateWfmBusinessUnitResource(businessUnitResourceId, businessUnitName, "Monday", "America/New_York") +
+		wfmServiceGoalTemplate.GenerateWfmServiceGoalTemplateResource(
+			templateResourceId,
+			businessUnitRef,
+			"Terraform Service Goals "+uuid.NewString()[:8],
+			`service_level {
+				include = true
+				percent = 80
+				seconds = 20
+			}`,
+		) +
+		gcloud.GenerateRoutingQueueResourceBasic(queueResourceId, "Terraform WFM Queue "+uuid.NewString()) +
+		gcloud.GenerateRoutingLanguageResource(languageResourceId, "Terraform WFM Language "+uuid.NewString()) +
+		gcloud.GenerateRoutingSkillResource(skillResourceId, "Terraform WFM Skill "+uuid.NewString())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: baseConfig + GenerateWfmPlanningGroupResource(
+					resourceId,
+					businessUnitRef,
+					name1,
+					templateRef,
+					GenerateRoutePath(queueRef, "Voice"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fullName, "business_unit_id", "genesyscloud_wfm_business_unit."+businessUnitResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttrPair(fullName, "service_goal_template_id", "genesyscloud_wfm_service_goal_template."+templateResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "route_paths.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "route_paths.*.queue_id", "genesyscloud_routing_queue."+queueResourceId, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "route_paths.*", map[string]string{"media_type": "Voice"}),
+				),
+			},
+			{
+				// Update
+				Config: baseConfig + GenerateWfmPlanningGroupResource(
+					resourceId,
+					businessUnitRef,
+					name2,
+					templateRef,
+					GenerateRoutePath(queueRef, "Voice"),
+					GenerateRoutePath(
+						queueRef,
+						"Chat",
+						"language_id = genesyscloud_routing_language."+languageResourceId+".id",
+						"skill_ids = [genesyscloud_routing_skill."+skillResourceId+".id]",
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "route_paths.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "route_paths.*.language_id", "genesyscloud_routing_language."+languageResourceId, "id"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "route_paths.*.skill_ids.*", "genesyscloud_routing_skill."+skillResourceId, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyWfmPlanningGroupDestroyed,
+	})
+}
+
+func testVerifyWfmPlanningGroupDestroyed(state *terraform.State) error {
+	proxy := newWfmPlanningGroupProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		businessUnitId, planningGroupId := splitPlanningGroupId(rs.Primary.ID)
+		planningGroup, resp, err := proxy.getWfmPlanningGroup(context.Background(), businessUnitId, planningGroupId)
+		if planningGroup != nil {
+			return fmt.Errorf("Wfm planning group (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Planning group or its business unit not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All planning groups destroyed
+	return nil
+}
@@ -0,0 +1,97 @@
+package wfm_planning_group
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceWfmPlanningGroupCreate(t *testing.T) {
+	businessUnitId := uuid.NewString()
+	planningGroupId := uuid.NewString()
+	serviceGoalTemplateId := uuid.NewString()
+	queueId := uuid.NewString()
+	languageId := uuid.NewString()
+	skillId := uuid.NewString()
+	var created *platformclientv2.Createplanninggrouprequest
+
+	proxy := &wfmPlanningGroupProxy{}
+	proxy.createWfmPlanningGroupAttr = func(ctx context.Context, p *wfmPlanningGroupProxy, buId string, body *platformclientv2.Createplanninggrouprequest) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, buId)
+		created = body
+		return &platformclientv2.Planninggroup{Id: &planningGroupId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getWfmPlanningGroupAttr = func(ctx context.Context, p *wfmPlanningGroupProxy, buId string, id string) (*platformclientv2.Planninggroup, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, buId)
+		assert.Equal(t, planningGroupId, id)
+		routePaths := make([]platformclientv2.Routepathresponse, 0)
+		for _, routePath := range *created.RoutePaths {
+			routePathResponse := platformclientv2.Routepathresponse{
+				Queue:     &platformclientv2.Queuereference{Id: routePath.QueueId},
+				MediaType: routePath.MediaType,
+			}
+			if routePath.LanguageId != nil {
+				routePathResponse.Language = &platformclientv2.Languagereference{Id: routePath.LanguageId}
+			}
+			if routePath.SkillIds != nil {
+				skills := make([]platformclientv2.Routingskillreference, 0)
+				for _, id := range *routePath.SkillIds {
+					skillId := id
+					skills = append(skills, platformclientv2.Routingskillreference{Id: &skillId})
+				}
+				routePathResponse.Skills = &skills
+			}
+			routePaths = append(routePaths, routePathResponse)
+		}
+		return &platformclientv2.Planninggroup{
+			Id:                  &planningGroupId,
+			Name:                created.Name,
+			ServiceGoalTemplate: &platformclientv2.Servicegoaltemplatereference{Id: created.ServiceGoalTemplateId},
+			RoutePaths:          &routePaths,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmPlanningGroup().Schema, map[string]interface{}{
+		"business_unit_id":         businessUnitId,
+		"name":                     "Planning Group",
+		"service_goal_template_id": createPlanningGroupId(businessUnitId, serviceGoalTemplateId),
+		"route_paths": []interface{}{
+			map[string]interface{}{
+				"queue_id":    queueId,
+				"media_type":  "Chat",
+				"language_id": languageId,
+				"skill_ids":   []interface{}{skillId},
+			},
+		},
+	})
+
+	diagErr := createWfmPlanningGroup(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	// The Genesys Cloud ID of the template is sent, not the resource ID
+	assert.Equal(t, serviceGoalTemplateId, *created.ServiceGoalTemplateId)
+	assert.Len(t, *created.RoutePaths, 1)
+	routePath := (*created.RoutePaths)[0]
+	assert.Equal(t, queueId, *routePath.QueueId)
+	assert.Equal(t, "Chat", *routePath.MediaType)
+	assert.Equal(t, languageId, *routePath.LanguageId)
+	assert.Equal(t, []string{skillId}, *routePath.SkillIds)
+
+	assert.Equal(t, createPlanningGroupId(businessUnitId, planningGroupId), d.Id())
+	assert.Equal(t, createPlanningGroupId(businessUnitId, serviceGoalTemplateId), d.Get("service_goal_template_id").(string))
+	routePathsSet := d.Get("route_paths").(*schema.Set)
+	assert.Equal(t, 1, routePathsSet.Len())
+	routePathMap := routePathsSet.List()[0].(map[string]interface{})
+	assert.Equal(t, queueId, routePathMap["queue_id"])
+	assert.Equal(t, languageId, routePathMap["language_id"])
+	assert.True(t, routePathMap["skill_ids"].(*schema.Set).Contains(skillId))
+}
@@ -0,0 +1,114 @@
+package wfm_planning_group
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_planning_group_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// Planning group and service goal template IDs structured as {business-unit-id}/{id}
+func createPlanningGroupId(businessUnitId string, id string) string {
+	return strings.Join([]string{businessUnitId, id}, "/")
+}
+
+func splitPlanningGroupId(id string) (string, string) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+// getServiceGoalTemplateId returns the Genesys Cloud ID of the service goal template from service_goal_template_id
+func getServiceGoalTemplateId(d *schema.ResourceData) string {
+	serviceGoalTemplateId := d.Get("service_goal_template_id").(string)
+	if _, id := splitPlanningGroupId(serviceGoalTemplateId); id != "" {
+		return id
+	}
+	return serviceGoalTemplateId
+}
+
+// buildRoutePaths maps the route_paths blocks into a list of Genesys Cloud platformclientv2.Routepathrequest
+func buildRoutePaths(d *schema.ResourceData) []platformclientv2.Routepathrequest {
+	routePaths := make([]platformclientv2.Routepathrequest, 0)
+	for _, routePath := range d.Get("route_paths").(*schema.Set).List() {
+		routePathMap := routePath.(map[string]interface{})
+		queueId := routePathMap["queue_id"].(string)
+		mediaType := routePathMap["media_type"].(string)
+
+		routePathRequest := platformclientv2.Routepathrequest{
+			QueueId:   &queueId,
+			MediaType: &mediaType,
+		}
+		if languageId, ok := routePathMap["language_id"].(string); ok && languageId != "" {
+			routePathRequest.LanguageId = &languageId
+		}
+		if skillIds, ok := routePathMap["skill_ids"].(*schema.Set); ok && skillIds.Len() > 0 {
+			routePathRequest.SkillIds = lists.SetToStringList(skillIds)
+		}
+		routePaths = append(routePaths, routePathRequest)
+	}
+	return routePaths
+}
+
+// flattenRoutePaths maps a list of Genesys Cloud platformclientv2.Routepathresponse into route_paths blocks
+func flattenRoutePaths(routePaths *[]platformclientv2.Routepathresponse) []interface{} {
+	if routePaths == nil {
+		return nil
+	}
+
+	routePathList := make([]interface{}, 0)
+	for _, routePath := range *routePaths {
+		routePathMap := make(map[string]interface{})
+		if routePath.Queue != nil && routePath.Queue.Id != nil {
+			routePathMap["queue_id"] = *routePath.Queue.Id
+		}
+		if routePath.MediaType != nil {
+			routePathMap["media_type"] = *routePath.MediaType
+		}
+		if routePath.Language != nil && routePath.Language.Id != nil {
+			routePathMap["language_id"] = *routePath.Language.Id
+		}
+		if routePath.Skills != nil {
+			skillIds := make([]string, 0)
+			for _, skill := range *routePath.Skills {
+				if skill.Id != nil {
+					skillIds = append(skillIds, *skill.Id)
+				}
+			}
+			routePathMap["skill_ids"] = lists.StringListToSet(skillIds)
+		}
+		routePathList = append(routePathList, routePathMap)
+	}
+	return routePathList
+}
+
+// GenerateWfmPlanningGroupResource generates a terraform string for a wfm planning group resource
+func GenerateWfmPlanningGroupResource(resourceId string, businessUnitId string, name string, serviceGoalTemplateId string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_wfm_planning_group" "%s" {
+	business_unit_id         = %s
+	name                     = "%s"
+	service_goal_template_id = %s
+	%s
+}
+`, resourceId, businessUnitId, name, serviceGoalTemplateId, strings.Join(extraAttrs, "\n"))
+}
+
+// GenerateRoutePath generates a terraform string for a route_paths block
+func GenerateRoutePath(queueId string, mediaType string, extraAttrs ...string) string {
+	return fmt.Sprintf(`route_paths {
+		queue_id   = %s
+		media_type = "%s"
+		%s
+	}
+	`, queueId, mediaType, strings.Join(extraAttrs, "\n"))
+}
@@ -0,0 +1,155 @@
+package wfm_service_goal_template
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_service_goal_template.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllWfmServiceGoalTemplates retrieves all of the wfm service goal templates via Terraform in the Genesys Cloud and is used for the exporter
+func getAllWfmServiceGoalTemplates(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getWfmServiceGoalTemplateProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	allServiceGoalTemplates, _, err := proxy.getAllWfmServiceGoalTemplates(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get wfm service goal templates: %v", err)
+	}
+
+	for _, businessUnitTemplates := range *allServiceGoalTemplates {
+		for _, serviceGoalTemplate := range businessUnitTemplates.serviceGoalTemplates {
+			id := createServiceGoalTemplateId(*businessUnitTemplates.businessUnit.Id, *serviceGoalTemplate.Id)
+			resources[id] = &resourceExporter.ResourceMeta{Name: *businessUnitTemplates.businessUnit.Name + "_" + *serviceGoalTemplate.Name}
+		}
+	}
+	return resources, nil
+}
+
+// createWfmServiceGoalTemplate is used by the wfm_service_goal_template resource to create a Genesys Cloud wfm service goal template
+func createWfmServiceGoalTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmServiceGoalTemplateProxy(sdkConfig)
+
+	businessUnitId := d.Get("business_unit_id").(string)
+	name := d.Get("name").(string)
+
+	createRequest := platformclientv2.Createservicegoaltemplate{
+		Name:                 &name,
+		ServiceLevel:         buildServiceLevel(d),
+		AverageSpeedOfAnswer: buildAverageSpeedOfAnswer(d),
+		AbandonRate:          buildAbandonRate(d),
+	}
+
+	log.Printf("Creating wfm service goal template %s", name)
+	serviceGoalTemplate, _, err := proxy.createWfmServiceGoalTemplate(ctx, businessUnitId, &createRequest)
+	if err != nil {
+		return diag.Errorf("Failed to create wfm service goal template %s: %s", name, err)
+	}
+
+	d.SetId(createServiceGoalTemplateId(businessUnitId, *serviceGoalTemplate.Id))
+	log.Printf("Created wfm service goal template %s %s", name, d.Id())
+	return readWfmServiceGoalTemplate(ctx, d, meta)
+}
+
+// readWfmServiceGoalTemplate is used by the wfm_service_goal_template resource to read a wfm service goal template from Genesys Cloud
+func readWfmServiceGoalTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmServiceGoalTemplateProxy(sdkConfig)
+
+	businessUnitId, serviceGoalTemplateId := splitServiceGoalTemplateId(d.Id())
+	if serviceGoalTemplateId == "" {
+		return diag.Errorf("Invalid wfm service goal template ID %s", d.Id())
+	}
+
+	log.Printf("Reading wfm service goal template %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		serviceGoalTemplate, resp, getErr := proxy.getWfmServiceGoalTemplate(ctx, businessUnitId, serviceGoalTemplateId)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wfm service goal template %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wfm service goal template %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceWfmServiceGoalTemplate())
+
+		_ = d.Set("business_unit_id", businessUnitId)
+		resourcedata.SetNillableValue(d, "name", serviceGoalTemplate.Name)
+		_ = d.Set("service_level", flattenServiceLevel(serviceGoalTemplate.ServiceLevel))
+		_ = d.Set("average_speed_of_answer", flattenAverageSpeedOfAnswer(serviceGoalTemplate.AverageSpeedOfAnswer))
+		_ = d.Set("abandon_rate", flattenAbandonRate(serviceGoalTemplate.AbandonRate))
+
+		log.Printf("Read wfm service goal template %s %s", d.Id(), *serviceGoalTemplate.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateWfmServiceGoalTemplate is used by the wfm_service_goal_template resource to update a wfm service goal template in Genesys Cloud
+func updateWfmServiceGoalTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmServiceGoalTemplateProxy(sdkConfig)
+
+	businessUnitId, serviceGoalTemplateId := splitServiceGoalTemplateId(d.Id())
+	name := d.Get("name").(string)
+
+	// Updates must include the metadata of the current service goal template
+	currentServiceGoalTemplate, _, err := proxy.getWfmServiceGoalTemplate(ctx, businessUnitId, serviceGoalTemplateId)
+	if err != nil {
+		return diag.Errorf("Failed to read wfm service goal template %s: %s", d.Id(), err)
+	}
+
+	updateRequest := platformclientv2.Updateservicegoaltemplate{
+		Name:                 &name,
+		ServiceLevel:         buildServiceLevel(d),
+		AverageSpeedOfAnswer: buildAverageSpeedOfAnswer(d),
+		AbandonRate:          buildAbandonRate(d),
+		Metadata:             currentServiceGoalTemplate.Metadata,
+	}
+
+	log.Printf("Updating wfm service goal template %s", name)
+	if _, _, err := proxy.updateWfmServiceGoalTemplate(ctx, businessUnitId, serviceGoalTemplateId, &updateRequest); err != nil {
+		return diag.Errorf("Failed to update wfm service goal template %s: %s", name, err)
+	}
+
+	log.Printf("Updated wfm service goal template %s", name)
+	return readWfmServiceGoalTemplate(ctx, d, meta)
+}
+
+// deleteWfmServiceGoalTemplate is used by the wfm_service_goal_template resource to delete a wfm service goal template from Genesys Cloud
+func deleteWfmServiceGoalTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getWfmServiceGoalTemplateProxy(sdkConfig)
+
+	businessUnitId, serviceGoalTemplateId := splitServiceGoalTemplateId(d.Id())
+	if _, err := proxy.deleteWfmServiceGoalTemplate(ctx, businessUnitId, serviceGoalTemplateId); err != nil {
+		return diag.Errorf("Failed to delete wfm service goal template %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getWfmServiceGoalTemplate(ctx, businessUnitId, serviceGoalTemplateId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted wfm service goal template %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting wfm service goal template %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Wfm service goal template %s still exists", d.Id()))
+	})
+}
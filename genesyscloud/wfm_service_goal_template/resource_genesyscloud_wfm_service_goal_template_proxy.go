@@ -0,0 +1,152 @@
+package wfm_service_goal_template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_service_goal_template_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *wfmServiceGoalTemplateProxy
+
+// businessUnitServiceGoalTemplates holds the service goal templates of a business unit
+type businessUnitServiceGoalTemplates struct {
+	businessUnit         platformclientv2.Businessunitlistitem
+	serviceGoalTemplates []platformclientv2.Servicegoaltemplate
+}
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllWfmServiceGoalTemplatesFunc func(ctx context.Context, p *wfmServiceGoalTemplateProxy) (*[]businessUnitServiceGoalTemplates, *platformclientv2.APIResponse, error)
+type createWfmServiceGoalTemplateFunc func(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, body *platformclientv2.Createservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error)
+type getWfmServiceGoalTemplateFunc func(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error)
+type updateWfmServiceGoalTemplateFunc func(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string, body *platformclientv2.Updateservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error)
+type deleteWfmServiceGoalTemplateFunc func(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error)
+
+// wfmServiceGoalTemplateProxy contains all of the methods that call genesys cloud APIs.
+type wfmServiceGoalTemplateProxy struct {
+	clientConfig                      *platformclientv2.Configuration
+	workforceManagementApi            *platformclientv2.WorkforceManagementApi
+	getAllWfmServiceGoalTemplatesAttr getAllWfmServiceGoalTemplatesFunc
+	createWfmServiceGoalTemplateAttr  createWfmServiceGoalTemplateFunc
+	getWfmServiceGoalTemplateAttr     getWfmServiceGoalTemplateFunc
+	updateWfmServiceGoalTemplateAttr  updateWfmServiceGoalTemplateFunc
+	deleteWfmServiceGoalTemplateAttr  deleteWfmServiceGoalTemplateFunc
+}
+
+// newWfmServiceGoalTemplateProxy initializes the wfm service goal template proxy with all of the data needed to communicate with Genesys Cloud
+func newWfmServiceGoalTemplateProxy(clientConfig *platformclientv2.Configuration) *wfmServiceGoalTemplateProxy {
+	api := platformclientv2.NewWorkforceManagementApiWithConfig(clientConfig)
+	return &wfmServiceGoalTemplateProxy{
+		clientConfig:                      clientConfig,
+		workforceManagementApi:            api,
+		getAllWfmServiceGoalTemplatesAttr: getAllWfmServiceGoalTemplatesFn,
+		createWfmServiceGoalTemplateAttr:  createWfmServiceGoalTemplateFn,
+		getWfmServiceGoalTemplateAttr:     getWfmServiceGoalTemplateFn,
+		updateWfmServiceGoalTemplateAttr:  updateWfmServiceGoalTemplateFn,
+		deleteWfmServiceGoalTemplateAttr:  deleteWfmServiceGoalTemplateFn,
+	}
+}
+
+// getWfmServiceGoalTemplateProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getWfmServiceGoalTemplateProxy(clientConfig *platformclientv2.Configuration) *wfmServiceGoalTemplateProxy {
+	if internalProxy == nil {
+		internalProxy = newWfmServiceGoalTemplateProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllWfmServiceGoalTemplates retrieves the service goal templates of all Genesys Cloud wfm business units
+func (p *wfmServiceGoalTemplateProxy) getAllWfmServiceGoalTemplates(ctx context.Context) (*[]businessUnitServiceGoalTemplates, *platformclientv2.APIResponse, error) {
+	return p.getAllWfmServiceGoalTemplatesAttr(ctx, p)
+}
+
+// createWfmServiceGoalTemplate creates a Genesys Cloud wfm service goal template in a business unit
+func (p *wfmServiceGoalTemplateProxy) createWfmServiceGoalTemplate(ctx context.Context, businessUnitId string, body *platformclientv2.Createservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	return p.createWfmServiceGoalTemplateAttr(ctx, p, businessUnitId, body)
+}
+
+// getWfmServiceGoalTemplate retrieves a Genesys Cloud wfm service goal template of a business unit by id
+func (p *wfmServiceGoalTemplateProxy) getWfmServiceGoalTemplate(ctx context.Context, businessUnitId string, id string) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	return p.getWfmServiceGoalTemplateAttr(ctx, p, businessUnitId, id)
+}
+
+// updateWfmServiceGoalTemplate updates a Genesys Cloud wfm service goal template of a business unit
+func (p *wfmServiceGoalTemplateProxy) updateWfmServiceGoalTemplate(ctx context.Context, businessUnitId string, id string, body *platformclientv2.Updateservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	return p.updateWfmServiceGoalTemplateAttr(ctx, p, businessUnitId, id, body)
+}
+
+// deleteWfmServiceGoalTemplate deletes a Genesys Cloud wfm service goal template of a business unit by id
+func (p *wfmServiceGoalTemplateProxy) deleteWfmServiceGoalTemplate(ctx context.Context, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteWfmServiceGoalTemplateAttr(ctx, p, businessUnitId, id)
+}
+
+// getAllWfmServiceGoalTemplatesFn is the implementation for retrieving the service goal templates of all wfm business units in Genesys Cloud
+func getAllWfmServiceGoalTemplatesFn(ctx context.Context, p *wfmServiceGoalTemplateProxy) (*[]businessUnitServiceGoalTemplates, *platformclientv2.APIResponse, error) {
+	businessUnits, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunits("", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to get wfm business units: %s", err)
+	}
+
+	allServiceGoalTemplates := make([]businessUnitServiceGoalTemplates, 0)
+	if businessUnits.Entities == nil {
+		return &allServiceGoalTemplates, resp, nil
+	}
+
+	for _, businessUnit := range *businessUnits.Entities {
+		serviceGoalTemplates, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitServicegoaltemplates(*businessUnit.Id, nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Failed to get wfm service goal templates of business unit %s: %s", *businessUnit.Id, err)
+		}
+
+		templates := businessUnitServiceGoalTemplates{businessUnit: businessUnit}
+		if serviceGoalTemplates.Entities != nil {
+			templates.serviceGoalTemplates = *serviceGoalTemplates.Entities
+		}
+		allServiceGoalTemplates = append(allServiceGoalTemplates, templates)
+	}
+	return &allServiceGoalTemplates, resp, nil
+}
+
+// createWfmServiceGoalTemplateFn is the implementation for creating a wfm service goal template in Genesys Cloud
+func createWfmServiceGoalTemplateFn(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, body *platformclientv2.Createservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	serviceGoalTemplate, resp, err := p.workforceManagementApi.PostWorkforcemanagementBusinessunitServicegoaltemplates(businessUnitId, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to create wfm service goal template: %s", err)
+	}
+	return serviceGoalTemplate, resp, nil
+}
+
+// getWfmServiceGoalTemplateFn is the implementation for retrieving a wfm service goal template in Genesys Cloud
+func getWfmServiceGoalTemplateFn(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	serviceGoalTemplate, resp, err := p.workforceManagementApi.GetWorkforcemanagementBusinessunitServicegoaltemplate(businessUnitId, id, nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve wfm service goal template by id %s: %s", id, err)
+	}
+	return serviceGoalTemplate, resp, nil
+}
+
+// updateWfmServiceGoalTemplateFn is the implementation for updating a wfm service goal template in Genesys Cloud
+func updateWfmServiceGoalTemplateFn(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string, body *platformclientv2.Updateservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+	serviceGoalTemplate, resp, err := p.workforceManagementApi.PatchWorkforcemanagementBusinessunitServicegoaltemplate(businessUnitId, id, *body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to update wfm service goal template %s: %s", id, err)
+	}
+	return serviceGoalTemplate, resp, nil
+}
+
+// deleteWfmServiceGoalTemplateFn is the implementation for deleting a wfm service goal template in Genesys Cloud
+func deleteWfmServiceGoalTemplateFn(ctx context.Context, p *wfmServiceGoalTemplateProxy, businessUnitId string, id string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.workforceManagementApi.DeleteWorkforcemanagementBusinessunitServicegoaltemplate(businessUnitId, id)
+	if err != nil {
+		return resp, fmt.Errorf("Failed to delete wfm service goal template %s: %s", id, err)
+	}
+	return resp, nil
+}
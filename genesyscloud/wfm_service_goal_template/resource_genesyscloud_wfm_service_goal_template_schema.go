@@ -0,0 +1,143 @@
+package wfm_service_goal_template
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_wfm_service_goal_template_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the wfm_service_goal_template resource.
+3.  The resource exporter configuration for the wfm_service_goal_template exporter.
+*/
+const resourceName = "genesyscloud_wfm_service_goal_template"
+
+var (
+	serviceLevelResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"include": {
+				Description: "Whether to include service level targets in the associated configuration.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"percent": {
+				Description:  "Service level target percent answered. Required if include is true.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"seconds": {
+				Description: "Service level target answer time. Required if include is true.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+
+	averageSpeedOfAnswerResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"include": {
+				Description: "Whether to include average speed of answer (ASA) in the associated configuration.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"seconds": {
+				Description: "The target average speed of answer (ASA) in seconds. Required if include is true.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+
+	abandonRateResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"include": {
+				Description: "Whether to include abandon rate in the associated configuration.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"percent": {
+				Description:  "Abandon rate percent goal. Required if include is true.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceWfmServiceGoalTemplate())
+	regInstance.RegisterExporter(resourceName, WfmServiceGoalTemplateExporter())
+}
+
+// ResourceWfmServiceGoalTemplate registers the genesyscloud_wfm_service_goal_template resource with Terraform
+func ResourceWfmServiceGoalTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Workforce Management Service Goal Template. Service goal templates hold the service level, average speed of answer and abandon rate goals of the planning groups of a business unit.
+The resource ID is structured as {business-unit-id}/{service-goal-template-id}.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createWfmServiceGoalTemplate),
+		ReadContext:   gcloud.ReadWithPooledClient(readWfmServiceGoalTemplate),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateWfmServiceGoalTemplate),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteWfmServiceGoalTemplate),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"business_unit_id": {
+				Description: "The ID of the business unit to which this service goal template belongs. Changing the business unit will cause the service goal template to be recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the service goal template.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"service_level": {
+				Description: "Service level targets for this service goal template.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        serviceLevelResource,
+			},
+			"average_speed_of_answer": {
+				Description: "Average speed of answer targets for this service goal template.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        averageSpeedOfAnswerResource,
+			},
+			"abandon_rate": {
+				Description: "Abandon rate targets for this service goal template.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        abandonRateResource,
+			},
+		},
+	}
+}
+
+// WfmServiceGoalTemplateExporter returns the resourceExporter object used to hold the genesyscloud_wfm_service_goal_template exporter's config
+func WfmServiceGoalTemplateExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllWfmServiceGoalTemplates),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"business_unit_id": {RefType: "genesyscloud_wfm_business_unit"},
+		},
+	}
+}
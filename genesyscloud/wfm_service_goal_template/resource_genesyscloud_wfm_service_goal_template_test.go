@@ -0,0 +1,130 @@
+package wfm_service_goal_template
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_service_goal_template_test.go contains all of the test cases for running the resource
+tests for wfm_service_goal_template. The org running the test needs a workforce management license.
+*/
+
+func TestAccResourceWfmServiceGoalTemplate(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceId             = "test-service-goal-template"
+		fullName               = resourceName + "." + resourceId
+		name1                  = "Terraform Service Goals " + uuid.NewString()[:8]
+		name2                  = "Terraform Service Goals " + uuid.NewString()[:8]
+		businessUnitResourceId = "test-business-unit"
+		businessUnitName       = "Terraform Business Unit " + uuid.NewString()
+		businessUnitRef        = "genesyscloud_wfm_business_unit." + businessUnitResourceId + ".id"
+	)
+
+	businessUnitConfig := wfmBusinessUnit.GenerateWfmBusinessUnitResource(businessUnitResourceId, businessUnitName, "Monday", "America/New_York")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: businessUnitConfig + GenerateWfmServiceGoalTemplateResource(
+					resourceId,
+					businessUnitRef,
+					name1,
+					`service_level {
+						include = true
+						percent = 80
+						seconds = 20
+					}`,
+					`average_speed_of_answer {
+						include = false
+					}`,
+					`abandon_rate {
+						include = false
+					}`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fullName, "business_unit_id", "genesyscloud_wfm_business_unit."+businessUnitResourceId, "id"),
+					resource.TestCheckResourceAttr(fullName, "name", name1),
+					resource.TestCheckResourceAttr(fullName, "service_level.0.include", "true"),
+					resource.TestCheckResourceAttr(fullName, "service_level.0.percent", "80"),
+					resource.TestCheckResourceAttr(fullName, "service_level.0.seconds", "20"),
+					resource.TestCheckResourceAttr(fullName, "average_speed_of_answer.0.include", "false"),
+					resource.TestCheckResourceAttr(fullName, "abandon_rate.0.include", "false"),
+				),
+			},
+			{
+				// Update
+				Config: businessUnitConfig + GenerateWfmServiceGoalTemplateResource(
+					resourceId,
+					businessUnitRef,
+					name2,
+					`service_level {
+						include = true
+						percent = 90
+						seconds = 30
+					}`,
+					`average_speed_of_answer {
+						include = true
+						seconds = 25
+					}`,
+					`abandon_rate {
+						include = true
+						percent = 5
+					}`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "name", name2),
+					resource.TestCheckResourceAttr(fullName, "service_level.0.percent", "90"),
+					resource.TestCheckResourceAttr(fullName, "service_level.0.seconds", "30"),
+					resource.TestCheckResourceAttr(fullName, "average_speed_of_answer.0.include", "true"),
+					resource.TestCheckResourceAttr(fullName, "average_speed_of_answer.0.seconds", "25"),
+					resource.TestCheckResourceAttr(fullName, "abandon_rate.0.include", "true"),
+					resource.TestCheckResourceAttr(fullName, "abandon_rate.0.percent", "5"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyWfmServiceGoalTemplateDestroyed,
+	})
+}
+
+func testVerifyWfmServiceGoalTemplateDestroyed(state *terraform.State) error {
+	proxy := newWfmServiceGoalTemplateProxy(platformclientv2.GetDefaultConfiguration())
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		businessUnitId, serviceGoalTemplateId := splitServiceGoalTemplateId(rs.Primary.ID)
+		serviceGoalTemplate, resp, err := proxy.getWfmServiceGoalTemplate(context.Background(), businessUnitId, serviceGoalTemplateId)
+		if serviceGoalTemplate != nil {
+			return fmt.Errorf("Wfm service goal template (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Service goal template or its business unit not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All service goal templates destroyed
+	return nil
+}
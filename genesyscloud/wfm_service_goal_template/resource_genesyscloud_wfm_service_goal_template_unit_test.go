@@ -0,0 +1,72 @@
+package wfm_service_goal_template
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceWfmServiceGoalTemplateUpdate(t *testing.T) {
+	businessUnitId := uuid.NewString()
+	serviceGoalTemplateId := uuid.NewString()
+	version := 3
+	current := &platformclientv2.Servicegoaltemplate{
+		Id:       &serviceGoalTemplateId,
+		Name:     platformclientv2.String("Old Name"),
+		Metadata: &platformclientv2.Wfmversionedentitymetadata{Version: &version},
+	}
+	var updated *platformclientv2.Updateservicegoaltemplate
+
+	proxy := &wfmServiceGoalTemplateProxy{}
+	proxy.getWfmServiceGoalTemplateAttr = func(ctx context.Context, p *wfmServiceGoalTemplateProxy, buId string, id string) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, businessUnitId, buId)
+		assert.Equal(t, serviceGoalTemplateId, id)
+		return current, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateWfmServiceGoalTemplateAttr = func(ctx context.Context, p *wfmServiceGoalTemplateProxy, buId string, id string, body *platformclientv2.Updateservicegoaltemplate) (*platformclientv2.Servicegoaltemplate, *platformclientv2.APIResponse, error) {
+		updated = body
+		current = &platformclientv2.Servicegoaltemplate{
+			Id:                   &serviceGoalTemplateId,
+			Name:                 body.Name,
+			ServiceLevel:         body.ServiceLevel,
+			AverageSpeedOfAnswer: body.AverageSpeedOfAnswer,
+			AbandonRate:          body.AbandonRate,
+		}
+		return current, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceWfmServiceGoalTemplate().Schema, map[string]interface{}{
+		"business_unit_id": businessUnitId,
+		"name":             "New Name",
+		"service_level": []interface{}{
+			map[string]interface{}{"include": true, "percent": 80, "seconds": 20},
+		},
+		"abandon_rate": []interface{}{
+			map[string]interface{}{"include": false},
+		},
+	})
+	d.SetId(createServiceGoalTemplateId(businessUnitId, serviceGoalTemplateId))
+
+	diagErr := updateWfmServiceGoalTemplate(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, version, *updated.Metadata.Version)
+	assert.Equal(t, 80, *updated.ServiceLevel.Percent)
+	assert.Equal(t, 20, *updated.ServiceLevel.Seconds)
+	assert.False(t, *updated.AbandonRate.Include)
+	assert.Nil(t, updated.AbandonRate.Percent)
+	assert.Nil(t, updated.AverageSpeedOfAnswer)
+
+	assert.Equal(t, "New Name", d.Get("name").(string))
+	assert.Equal(t, 80, d.Get("service_level.0.percent").(int))
+	assert.Equal(t, false, d.Get("abandon_rate.0.include").(bool))
+}
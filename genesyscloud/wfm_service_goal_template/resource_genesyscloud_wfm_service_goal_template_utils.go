@@ -0,0 +1,130 @@
+package wfm_service_goal_template
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_wfm_service_goal_template_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// Service goal template IDs structured as {business-unit-id}/{service-goal-template-id}
+func createServiceGoalTemplateId(businessUnitId string, serviceGoalTemplateId string) string {
+	return strings.Join([]string{businessUnitId, serviceGoalTemplateId}, "/")
+}
+
+func splitServiceGoalTemplateId(id string) (string, string) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+// getFirstBlock returns the attributes of the first element of a single item block
+func getFirstBlock(d *schema.ResourceData, key string) map[string]interface{} {
+	blockList, ok := d.Get(key).([]interface{})
+	if !ok || len(blockList) == 0 || blockList[0] == nil {
+		return nil
+	}
+	return blockList[0].(map[string]interface{})
+}
+
+// getNonZeroInt returns a pointer to an int attribute of a block if it is set to a non-zero value
+func getNonZeroInt(blockMap map[string]interface{}, key string) *int {
+	if value, ok := blockMap[key].(int); ok && value != 0 {
+		return &value
+	}
+	return nil
+}
+
+// buildServiceLevel maps the service_level block into a Genesys Cloud platformclientv2.Buservicelevel
+func buildServiceLevel(d *schema.ResourceData) *platformclientv2.Buservicelevel {
+	serviceLevelMap := getFirstBlock(d, "service_level")
+	if serviceLevelMap == nil {
+		return nil
+	}
+	include := serviceLevelMap["include"].(bool)
+	return &platformclientv2.Buservicelevel{
+		Include: &include,
+		Percent: getNonZeroInt(serviceLevelMap, "percent"),
+		Seconds: getNonZeroInt(serviceLevelMap, "seconds"),
+	}
+}
+
+// buildAverageSpeedOfAnswer maps the average_speed_of_answer block into a Genesys Cloud platformclientv2.Buaveragespeedofanswer
+func buildAverageSpeedOfAnswer(d *schema.ResourceData) *platformclientv2.Buaveragespeedofanswer {
+	averageSpeedOfAnswerMap := getFirstBlock(d, "average_speed_of_answer")
+	if averageSpeedOfAnswerMap == nil {
+		return nil
+	}
+	include := averageSpeedOfAnswerMap["include"].(bool)
+	return &platformclientv2.Buaveragespeedofanswer{
+		Include: &include,
+		Seconds: getNonZeroInt(averageSpeedOfAnswerMap, "seconds"),
+	}
+}
+
+// buildAbandonRate maps the abandon_rate block into a Genesys Cloud platformclientv2.Buabandonrate
+func buildAbandonRate(d *schema.ResourceData) *platformclientv2.Buabandonrate {
+	abandonRateMap := getFirstBlock(d, "abandon_rate")
+	if abandonRateMap == nil {
+		return nil
+	}
+	include := abandonRateMap["include"].(bool)
+	return &platformclientv2.Buabandonrate{
+		Include: &include,
+		Percent: getNonZeroInt(abandonRateMap, "percent"),
+	}
+}
+
+// flattenServiceLevel maps a Genesys Cloud platformclientv2.Buservicelevel into the service_level block
+func flattenServiceLevel(serviceLevel *platformclientv2.Buservicelevel) []interface{} {
+	if serviceLevel == nil {
+		return nil
+	}
+	serviceLevelMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(serviceLevelMap, "include", serviceLevel.Include)
+	resourcedata.SetMapValueIfNotNil(serviceLevelMap, "percent", serviceLevel.Percent)
+	resourcedata.SetMapValueIfNotNil(serviceLevelMap, "seconds", serviceLevel.Seconds)
+	return []interface{}{serviceLevelMap}
+}
+
+// flattenAverageSpeedOfAnswer maps a Genesys Cloud platformclientv2.Buaveragespeedofanswer into the average_speed_of_answer block
+func flattenAverageSpeedOfAnswer(averageSpeedOfAnswer *platformclientv2.Buaveragespeedofanswer) []interface{} {
+	if averageSpeedOfAnswer == nil {
+		return nil
+	}
+	averageSpeedOfAnswerMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(averageSpeedOfAnswerMap, "include", averageSpeedOfAnswer.Include)
+	resourcedata.SetMapValueIfNotNil(averageSpeedOfAnswerMap, "seconds", averageSpeedOfAnswer.Seconds)
+	return []interface{}{averageSpeedOfAnswerMap}
+}
+
+// flattenAbandonRate maps a Genesys Cloud platformclientv2.Buabandonrate into the abandon_rate block
+func flattenAbandonRate(abandonRate *platformclientv2.Buabandonrate) []interface{} {
+	if abandonRate == nil {
+		return nil
+	}
+	abandonRateMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(abandonRateMap, "include", abandonRate.Include)
+	resourcedata.SetMapValueIfNotNil(abandonRateMap, "percent", abandonRate.Percent)
+	return []interface{}{abandonRateMap}
+}
+
+// GenerateWfmServiceGoalTemplateResource generates a terraform string for a wfm service goal template resource
+func GenerateWfmServiceGoalTemplateResource(resourceId string, businessUnitId string, name string, extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_wfm_service_goal_template" "%s" {
+	business_unit_id = %s
+	name             = "%s"
+	%s
+}
+`, resourceId, businessUnitId, name, strings.Join(extraAttrs, "\n"))
+}
@@ -6,6 +6,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	dtr "terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	dtrs "terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
 	grammarLanguage "terraform-provider-genesyscloud/genesyscloud/architect_grammar_language"
@@ -23,6 +24,7 @@ import (
 	obCampaign "terraform-provider-genesyscloud/genesyscloud/outbound_campaign"
 	obCampaignRule "terraform-provider-genesyscloud/genesyscloud/outbound_campaignrule"
 	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
+	obDigitalRuleset "terraform-provider-genesyscloud/genesyscloud/outbound_digitalruleset"
 	obs "terraform-provider-genesyscloud/genesyscloud/outbound_ruleset"
 	obSequence "terraform-provider-genesyscloud/genesyscloud/outbound_sequence"
 	obwm "terraform-provider-genesyscloud/genesyscloud/outbound_wrapupcode_mappings"
@@ -30,8 +32,17 @@ import (
 	recMediaRetPolicy "terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	directRoutingSettings "terraform-provider-genesyscloud/genesyscloud/routing_direct_routing_settings"
+	messageAddress "terraform-provider-genesyscloud/genesyscloud/routing_message_address"
+	predictor "terraform-provider-genesyscloud/genesyscloud/routing_predictor"
+	queueMember "terraform-provider-genesyscloud/genesyscloud/routing_queue_member"
 	smsAddresses "terraform-provider-genesyscloud/genesyscloud/routing_sms_addresses"
 	"terraform-provider-genesyscloud/genesyscloud/scripts"
+	staDictionaryFeedback "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_dictionaryfeedback"
+	staProgram "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_program"
+	staSentimentFeedback "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_sentimentfeedback"
+	staSettings "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_settings"
+	staTopic "terraform-provider-genesyscloud/genesyscloud/speechandtextanalytics_topic"
 	"terraform-provider-genesyscloud/genesyscloud/station"
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
 	workitem "terraform-provider-genesyscloud/genesyscloud/task_management_workitem"
@@ -49,8 +60,14 @@ import (
 	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
 	edgesTrunk "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_trunk"
 	tfexp "terraform-provider-genesyscloud/genesyscloud/tfexporter"
+	userBulk "terraform-provider-genesyscloud/genesyscloud/user_bulk"
 	webDeployConfig "terraform-provider-genesyscloud/genesyscloud/webdeployments_configuration"
 	webDeployDeploy "terraform-provider-genesyscloud/genesyscloud/webdeployments_deployment"
+	wfmActivityCode "terraform-provider-genesyscloud/genesyscloud/wfm_activity_code"
+	wfmBusinessUnit "terraform-provider-genesyscloud/genesyscloud/wfm_business_unit"
+	wfmManagementUnit "terraform-provider-genesyscloud/genesyscloud/wfm_management_unit"
+	wfmPlanningGroup "terraform-provider-genesyscloud/genesyscloud/wfm_planning_group"
+	wfmServiceGoalTemplate "terraform-provider-genesyscloud/genesyscloud/wfm_service_goal_template"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -113,6 +130,7 @@ func registerResources() {
 	regInstance := &RegisterInstance{}
 	dt.SetRegistrar(regInstance)                            //Registering architect data table
 	dtr.SetRegistrar(regInstance)                           //Registering architect data table row
+	dtrs.SetRegistrar(regInstance)                          //Registering architect data table rows
 	emergencyGroup.SetRegistrar(regInstance)                //Registering architect emergency group
 	grammar.SetRegistrar(regInstance)                       //Registering architect grammar
 	grammarLanguage.SetRegistrar(regInstance)               //Registering architect grammar language
@@ -131,8 +149,13 @@ func registerResources() {
 	obContactList.SetRegistrar(regInstance)                 //Registering outbound contact list
 	obSequence.SetRegistrar(regInstance)                    //Registering outbound sequence
 	obCampaignRule.SetRegistrar(regInstance)                //Registering outbound campaignrule
+	obDigitalRuleset.SetRegistrar(regInstance)              //Registering outbound digital ruleset
 	scripts.SetRegistrar(regInstance)                       //Registering Scripts
 	smsAddresses.SetRegistrar(regInstance)                  //Registering routing sms addresses
+	queueMember.SetRegistrar(regInstance)                   //Registering routing queue member
+	messageAddress.SetRegistrar(regInstance)                //Registering routing message addresses
+	predictor.SetRegistrar(regInstance)                     //Registering routing predictor
+	directRoutingSettings.SetRegistrar(regInstance)         //Registering routing direct routing settings
 	integration.SetRegistrar(regInstance)                   //Registering integrations
 	integrationCustomAuth.SetRegistrar(regInstance)         //Registering integrations custom auth actions
 	integrationAction.SetRegistrar(regInstance)             //Registering integrations actions
@@ -156,6 +179,17 @@ func registerResources() {
 	phoneBaseSettings.SetRegistrar(regInstance)             //Registering Phone Base Settings
 	lineBaseSettings.SetRegistrar(regInstance)              //Registering Line Base Settings
 	edgesTrunk.SetRegistrar(regInstance)                    //Registering Edges Trunk Settings
+	userBulk.SetRegistrar(regInstance)                      //Registering user bulk
+	wfmBusinessUnit.SetRegistrar(regInstance)               //Registering wfm business unit
+	wfmManagementUnit.SetRegistrar(regInstance)             //Registering wfm management unit
+	wfmActivityCode.SetRegistrar(regInstance)               //Registering wfm activity code
+	wfmServiceGoalTemplate.SetRegistrar(regInstance)        //Registering wfm service goal template
+	wfmPlanningGroup.SetRegistrar(regInstance)              //Registering wfm planning group
+	staTopic.SetRegistrar(regInstance)                      //Registering speech and text analytics topic
+	staProgram.SetRegistrar(regInstance)                    //Registering speech and text analytics program
+	staDictionaryFeedback.SetRegistrar(regInstance)         //Registering speech and text analytics dictionary feedback
+	staSettings.SetRegistrar(regInstance)                   //Registering speech and text analytics settings
+	staSentimentFeedback.SetRegistrar(regInstance)          //Registering speech and text analytics sentiment feedback
 	resourceExporter.SetRegisterExporter(resourceExporters) //Registering register exporters
 	// setting resources for Use cases  like TF export where provider is used in resource classes.
 	tfexp.SetRegistrar(regInstance) //Registering tf exporter
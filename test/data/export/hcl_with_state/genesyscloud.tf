@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    genesyscloud = {
+      source  = "genesys.com/mypurecloud/genesyscloud"
+      version = "0.1.0"
+    }
+  }
+}
+
+resource "genesyscloud_golden_division" "Sales" {
+  description = "Sales division"
+  name        = "Sales"
+}
+
+resource "genesyscloud_golden_division" "Support___Service" {
+  name = "Support & Service"
+}
+
+resource "genesyscloud_golden_team" "Escalations" {
+  division_id  = "d1000000-0000-0000-0000-000000000099"
+  edge_id      = "${var.genesyscloud_golden_team_Escalations_edge_id}"
+  enabled      = false
+  max_members  = 10
+  name         = "Escalations"
+  phone_number = ""
+}
+
+resource "genesyscloud_golden_team" "Inbound_Sales" {
+  auto_assignment {
+    division_id = "${genesyscloud_golden_division.Support___Service.id}"
+    enabled     = true
+  }
+  description   = "Handles $${region} inbound calls"
+  division_id   = "${genesyscloud_golden_division.Sales.id}"
+  edge_id       = "${var.genesyscloud_golden_team_Inbound_Sales_edge_id}"
+  enabled       = true
+  member_ids    = ["${genesyscloud_golden_division.Support___Service.id}", "u1000000-0000-0000-0000-000000000099"]
+  name          = "Inbound Sales"
+  phone_number  = "+13175550100"
+  settings_json = jsonencode({
+	  "priority" = 5,
+	  "tags" = [
+	  	  "inbound",
+	  	  "sales"
+	  ]
+  })
+}
+
+variable "genesyscloud_golden_team_Escalations_edge_id" {
+  description = "Edge the team is homed on."
+}
+variable "genesyscloud_golden_team_Inbound_Sales_edge_id" {
+  description = "Edge the team is homed on."
+}
+